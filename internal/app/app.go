@@ -1,8 +1,19 @@
 package app
 
 import (
+	"context"
+	"crypto/rand"
+	"os"
+	"strconv"
+	"time"
 	"web/example/internal/db"
+	"web/example/internal/filter"
 	"web/example/internal/http"
+	"web/example/internal/notify"
+	"web/example/internal/services"
+	"web/example/internal/signedurl"
+	"web/example/internal/storage"
+	"web/example/internal/views"
 
 	"go.uber.org/zap"
 )
@@ -15,5 +26,150 @@ func Launch() {
 		zap.S().Errorln("Could not connect to DB: ", err.Error())
 	}
 
-	http.StartServer(db_conn)
+	if err := services.NewPostService(db_conn).BackfillSlugs(); err != nil {
+		zap.S().Errorln("Could not backfill post slugs: ", err.Error())
+	}
+
+	store, err := storage.NewFromEnv()
+	if err != nil {
+		zap.S().Fatalln("Could not open blob store: ", err.Error())
+	}
+
+	accountDeletionGrace := durationFromEnv("ACCOUNT_DELETION_GRACE", services.DefaultAccountDeletionGrace)
+	services.NewTrashPurger(db_conn, store,
+		durationFromEnv("TRASH_RETENTION", services.DefaultTrashRetention),
+		accountDeletionGrace,
+	).Start(context.Background())
+
+	signer := signedurl.New(urlSecret())
+
+	attachments := services.NewAttachmentService(db_conn, store, signer)
+	attachments.MaxSize = sizeFromEnv("ATTACHMENT_MAX_SIZE", services.DefaultAttachmentMaxSize)
+	attachments.URLTTL = durationFromEnv("ATTACHMENT_URL_TTL", services.DefaultAttachmentURLTTL)
+
+	notifications := services.NewNotificationService(db_conn, notify.NewHub())
+
+	moderation := services.NewModerationService(db_conn)
+	moderation.HideThreshold = countFromEnv("REPORT_HIDE_THRESHOLD", services.DefaultReportHideThreshold)
+
+	filterConfig, err := filter.LoadConfig(os.Getenv("FILTER_CONFIG"))
+	if err != nil {
+		zap.S().Fatalln("Could not read content filter config: ", err.Error())
+	}
+
+	filters, err := services.NewContentFilters(db_conn, filterConfig)
+	if err != nil {
+		zap.S().Fatalln("Could not set up content filters: ", err.Error())
+	}
+
+	posts := services.NewPostService(db_conn)
+	posts.Notifications = notifications
+	posts.Filters = filters
+	posts.RequireVersion = boolFromEnv("REQUIRE_IF_MATCH", false)
+	posts.Views = views.NewCounter(durationFromEnv("VIEW_DEDUP_WINDOW", views.DefaultWindow))
+
+	scheduler := services.NewPostScheduler(db_conn)
+	scheduler.Posts = posts
+	scheduler.Start(context.Background())
+
+	stats := services.NewStatsService(db_conn, posts.Views)
+	stats.Interval = durationFromEnv("VIEW_FLUSH_INTERVAL", services.DefaultViewFlushInterval)
+	stats.Start(context.Background())
+
+	personalData := services.NewPersonalDataService(db_conn, store, signer)
+	personalData.TTL = durationFromEnv("DATA_EXPORT_TTL", services.DefaultDataExportTTL)
+	personalData.AccountDeletionGrace = accountDeletionGrace
+	personalData.Start(context.Background())
+
+	pins := services.NewPinService(db_conn)
+	pins.PinLimit = countFromEnv("PIN_LIMIT", services.DefaultPinLimit)
+	pins.FeatureLimit = countFromEnv("FEATURE_LIMIT", services.DefaultFeatureLimit)
+
+	// imports go through the same content filters as new posts
+	exports := services.NewExportService(db_conn)
+	exports.Posts = posts
+
+	http.StartServer(db_conn, posts, attachments, notifications, moderation, stats, personalData, pins, exports)
+}
+
+// urlSecret is the key signing download URLs. Without ATTACHMENT_URL_SECRET a
+// random one is used, links then stop working on restart.
+func urlSecret() []byte {
+	if secret := os.Getenv("ATTACHMENT_URL_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+
+	zap.S().Warn("ATTACHMENT_URL_SECRET is not set, using a random key")
+	secret := make([]byte, 32)
+	rand.Read(secret)
+
+	return secret
+}
+
+// sizeFromEnv reads a size in bytes from the environment, falling back to def
+// when it is unset or invalid
+func sizeFromEnv(name string, def int64) int64 {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return def
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n <= 0 {
+		zap.S().Warnf("Invalid %s %q, using %d", name, value, def)
+		return def
+	}
+
+	return n
+}
+
+// countFromEnv reads a non negative number from the environment, falling
+// back to def when it is unset or invalid
+func countFromEnv(name string, def int) int {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return def
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		zap.S().Warnf("Invalid %s %q, using %d", name, value, def)
+		return def
+	}
+
+	return n
+}
+
+// boolFromEnv reads true or false from the environment, falling back to def
+// when it is unset or invalid
+func boolFromEnv(name string, def bool) bool {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return def
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		zap.S().Warnf("Invalid %s %q, using %t", name, value, def)
+		return def
+	}
+
+	return b
+}
+
+// durationFromEnv reads a duration like "720h" from the environment, falling
+// back to def when it is unset or invalid
+func durationFromEnv(name string, def time.Duration) time.Duration {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return def
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		zap.S().Warnf("Invalid %s %q, using %s", name, value, def)
+		return def
+	}
+
+	return d
 }
@@ -0,0 +1,200 @@
+// Package diff computes line and word level differences between two texts.
+// Posts are small (a few KB at most) so a plain LCS table is good enough.
+package diff
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type Op string
+
+const (
+	Equal  Op = "equal"
+	Insert Op = "insert"
+	Delete Op = "delete"
+)
+
+// Segment is a run of text that is kept, inserted or deleted
+type Segment struct {
+	Op   Op     `json:"op"`
+	Text string `json:"text"`
+}
+
+// edit is a single token level operation
+type edit struct {
+	op  Op
+	tok string
+}
+
+// compute returns the edit script turning a into b using the longest common subsequence
+func compute(a, b []string) []edit {
+	n, m := len(a), len(b)
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	edits := make([]edit, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			edits = append(edits, edit{Equal, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			edits = append(edits, edit{Delete, a[i]})
+			i++
+		default:
+			edits = append(edits, edit{Insert, b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		edits = append(edits, edit{Delete, a[i]})
+	}
+	for ; j < m; j++ {
+		edits = append(edits, edit{Insert, b[j]})
+	}
+
+	return edits
+}
+
+// Words diffs a and b word by word, whitespace is kept attached to the
+// tokens so joining all segments of one side gives back the original text
+func Words(a, b string) []Segment {
+	var segments []Segment
+
+	for _, e := range compute(splitWords(a), splitWords(b)) {
+		if last := len(segments) - 1; last >= 0 && segments[last].Op == e.op {
+			segments[last].Text += e.tok
+			continue
+		}
+		segments = append(segments, Segment{Op: e.op, Text: e.tok})
+	}
+
+	return segments
+}
+
+// splitWords splits s into alternating word and whitespace tokens
+func splitWords(s string) []string {
+	var tokens []string
+
+	start, prevSpace := 0, false
+	for i, r := range s {
+		space := unicode.IsSpace(r)
+		if i > start && space != prevSpace {
+			tokens = append(tokens, s[start:i])
+			start = i
+		}
+		prevSpace = space
+	}
+	if start < len(s) {
+		tokens = append(tokens, s[start:])
+	}
+
+	return tokens
+}
+
+// Unified renders a unified diff of a and b with the given lines of context
+// around each change, fromName and toName label the two sides
+func Unified(fromName, toName, a, b string, context int) string {
+	edits := compute(splitLines(a), splitLines(b))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+
+	// walk the edits collecting hunks, aLine and bLine track the
+	// 1-based line numbers on each side
+	aLine, bLine := 1, 1
+	for i := 0; i < len(edits); {
+		if edits[i].op == Equal {
+			aLine++
+			bLine++
+			i++
+			continue
+		}
+
+		// start the hunk `context` lines before the first change
+		start := max(i-context, 0)
+		aLine -= i - start
+		bLine -= i - start
+		hunkA, hunkB := aLine, bLine
+
+		// extend the hunk until there are more than 2*context equal lines in a row
+		end := i
+		for end < len(edits) {
+			if edits[end].op != Equal {
+				end++
+				continue
+			}
+			run := end
+			for run < len(edits) && edits[run].op == Equal {
+				run++
+			}
+			if run == len(edits) || run-end > 2*context {
+				end = min(end+context, len(edits))
+				break
+			}
+			end = run
+		}
+
+		var body strings.Builder
+		countA, countB := 0, 0
+		for _, e := range edits[start:end] {
+			switch e.op {
+			case Equal:
+				body.WriteString(" " + e.tok + "\n")
+				countA++
+				countB++
+			case Delete:
+				body.WriteString("-" + e.tok + "\n")
+				countA++
+			case Insert:
+				body.WriteString("+" + e.tok + "\n")
+				countB++
+			}
+		}
+
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(hunkA, countA), hunkRange(hunkB, countB))
+		sb.WriteString(body.String())
+
+		aLine += countA
+		bLine += countB
+		i = end
+	}
+
+	return sb.String()
+}
+
+// hunkRange formats a hunk side the way diff -u does
+func hunkRange(line, count int) string {
+	if count == 0 {
+		// an empty side points at the line before the change
+		return fmt.Sprintf("%d,0", line-1)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", line)
+	}
+	return fmt.Sprintf("%d,%d", line, count)
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,17 @@
+package domain
+
+// Attachment is a file uploaded to a post, the bytes live in the blob store
+type Attachment struct {
+	Id           int     `json:"id"`
+	PostId       int     `json:"postId"`
+	Filename     string  `json:"filename"`
+	ContentType  string  `json:"contentType"` // Sniffed from the content, not taken from the client
+	Size         int64   `json:"size"`
+	Width        *int    `json:"width,omitempty"` // Images only
+	Height       *int    `json:"height,omitempty"`
+	StorageKey   string  `json:"-"`
+	ThumbnailKey *string `json:"-"` // Images only
+	CreatedAt    string  `json:"createdAt"`
+	URL          string  `json:"url,omitempty"`          // Signed, expiring download URL
+	ThumbnailURL string  `json:"thumbnailUrl,omitempty"` // Signed, expiring thumbnail URL
+}
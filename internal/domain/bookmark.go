@@ -0,0 +1,32 @@
+package domain
+
+// Bookmark is a post a user saved for later
+type Bookmark struct {
+	Id        int    `json:"id"`
+	PostId    int    `json:"postId"`
+	Note      string `json:"note"`
+	CreatedAt string `json:"createdAt"`
+	Post      *Post  `json:"post"` // nil once the post is deleted or no longer visible to the user
+}
+
+// ReadingList is a named, ordered list of posts
+type ReadingList struct {
+	Id          int               `json:"id"`
+	UserId      int               `json:"-"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	ShareToken  *string           `json:"-"`                   // Set while the list is public
+	SharePath   string            `json:"sharePath,omitempty"` // Public URL path of the list, only shown to the owner
+	ItemCount   int               `json:"itemCount"`
+	CreatedAt   string            `json:"createdAt"`
+	Items       []ReadingListItem `json:"items,omitempty"`
+}
+
+// ReadingListItem is a post in a reading list
+type ReadingListItem struct {
+	PostId   int    `json:"postId"`
+	Position int    `json:"position"` // 1 based
+	Note     string `json:"note"`
+	AddedAt  string `json:"addedAt"`
+	Post     *Post  `json:"post"` // nil once the post is deleted or no longer visible to the reader
+}
@@ -0,0 +1,7 @@
+package domain
+
+// FeedPost is a published post as listed in feeds, with its author's name
+type FeedPost struct {
+	Post
+	AuthorName string
+}
@@ -0,0 +1,15 @@
+package domain
+
+// Follow is a user in a follower or following list, the lists are public so
+// they carry no email
+type Follow struct {
+	Id         int    `json:"-"` // Row of the follow, lists are paged by it
+	Username   string `json:"username"`
+	FollowedAt string `json:"followedAt"`
+}
+
+// FollowCounts of a user
+type FollowCounts struct {
+	Followers int `json:"followers"`
+	Following int `json:"following"`
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "time"
+
+// ImportResult is what became of an imported item, or would have in a dry
+// run or an import that failed
+type ImportResult string
+
+const (
+	ImportCreated   ImportResult = "created"
+	ImportDuplicate ImportResult = "duplicate" // Same title and content as a post the user has, nothing was stored
+	ImportFailed    ImportResult = "failed"
+)
+
+// ImportItem reports on a post or series of an import. SourceId is the post
+// id in the export, PostId the post it maps to in this account, unset when
+// nothing was stored (a dry run or a failed import).
+type ImportItem struct {
+	Source   string       `json:"source"`         // Line or file of the export
+	Kind     string       `json:"kind,omitempty"` // post or series, unset for items that could not be read
+	SourceId int          `json:"sourceId,omitempty"`
+	PostId   int          `json:"postId,omitempty"`
+	Result   ImportResult `json:"result"`
+	Error    string       `json:"error,omitempty"`
+}
+
+// ImportReport sums up an import. Nothing is stored when any item failed.
+type ImportReport struct {
+	DryRun     bool         `json:"dryRun"`
+	Imported   bool         `json:"imported"`
+	Created    int          `json:"created"`
+	Duplicates int          `json:"duplicates"`
+	Failed     int          `json:"failed"`
+	Series     int          `json:"series"` // Series created
+	Items      []ImportItem `json:"items"`
+	PostIds    map[int]int  `json:"postIds,omitempty"` // Export id to post id, once imported
+}
+
+// PostImport is a post to store with the times it carried in the export,
+// nil PublishedAt and PublishAt are left unset
+type PostImport struct {
+	Post        *Post
+	CreatedAt   time.Time
+	PublishedAt *time.Time
+	PublishAt   *time.Time
+}
+
+// SeriesImport is a series to store with its posts in order, posts are
+// either stored in the same import or already existed
+type SeriesImport struct {
+	Series *Series
+	Posts  []*Post
+}
@@ -0,0 +1,11 @@
+package domain
+
+// Mention is an @username in a post's content resolved to a user. Start and
+// End are offsets in Unicode code points, Username is the user's current
+// username which differs from the text once they rename.
+type Mention struct {
+	UserId   int    `json:"-"`
+	Username string `json:"username"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+}
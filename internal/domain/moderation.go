@@ -0,0 +1,79 @@
+package domain
+
+// ReportReason is why a post was reported
+type ReportReason string
+
+const (
+	ReportSpam           ReportReason = "spam"
+	ReportHarassment     ReportReason = "harassment"
+	ReportHate           ReportReason = "hate"
+	ReportViolence       ReportReason = "violence"
+	ReportSexual         ReportReason = "sexual"
+	ReportMisinformation ReportReason = "misinformation"
+	ReportOther          ReportReason = "other" // Details should explain
+)
+
+// ReportReasons lists every valid reason, for binding tags see
+// handlermodel.ReportPostRequest
+var ReportReasons = []ReportReason{ReportSpam, ReportHarassment, ReportHate, ReportViolence, ReportSexual, ReportMisinformation, ReportOther}
+
+// PostReport is a user flagging a post, a user reports a post once
+type PostReport struct {
+	Id         int          `json:"id"`
+	PostId     int          `json:"postId"`
+	ReporterId int          `json:"-"`
+	Reason     ReportReason `json:"reason"`
+	Details    *string      `json:"details,omitempty"`
+	ResolvedAt *string      `json:"resolvedAt,omitempty"`
+	CreatedAt  string       `json:"createdAt"`
+}
+
+// ModerationAction is what a moderator (or the report threshold) did to a post
+type ModerationAction string
+
+const (
+	ModerationHide     ModerationAction = "hide"      // Reports upheld, the post is hidden
+	ModerationRestore  ModerationAction = "restore"   // The post is visible again
+	ModerationDismiss  ModerationAction = "dismiss"   // Reports rejected, undoes an automatic hide
+	ModerationAutoHide ModerationAction = "auto_hide" // Hidden by the report threshold pending review
+)
+
+// ModerationLogEntry records one moderation action
+type ModerationLogEntry struct {
+	Id                int              `json:"id"`
+	PostId            int              `json:"postId"`
+	ModeratorUsername *string          `json:"moderatorUsername,omitempty"` // Unset for automatic actions
+	Action            ModerationAction `json:"action"`
+	Note              *string          `json:"note,omitempty"`
+	CreatedAt         string           `json:"createdAt"`
+}
+
+// PostFlag is a content filter holding a post for review
+type PostFlag struct {
+	Id         int     `json:"id"`
+	PostId     int     `json:"postId"`
+	Filter     string  `json:"filter"`
+	Reason     string  `json:"reason"`
+	ResolvedAt *string `json:"resolvedAt,omitempty"`
+	CreatedAt  string  `json:"createdAt"`
+}
+
+// ModerationQueueItem is a reported or flagged post with a summary of its
+// open reports and flags
+type ModerationQueueItem struct {
+	PostId         int                  `json:"postId"`
+	Slug           string               `json:"slug"`
+	Title          string               `json:"title"`
+	AuthorUsername string               `json:"authorUsername"`
+	HiddenAt       *string              `json:"hiddenAt,omitempty"`
+	OpenReports    int                  `json:"openReports"`
+	Reasons        map[ReportReason]int `json:"reasons"`         // Open reports by reason
+	Flags          []string             `json:"flags,omitempty"` // Open filter flags as "filter: reason"
+	LastActivityAt string               `json:"lastActivityAt"`  // Newest open report or flag
+}
+
+// TokenCount is how many spam and ham training documents contain a token
+type TokenCount struct {
+	Spam int
+	Ham  int
+}
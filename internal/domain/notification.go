@@ -0,0 +1,22 @@
+package domain
+
+// NotificationType is what happened to the notified user
+type NotificationType string
+
+const (
+	NotificationFollow       NotificationType = "follow"        // Actor followed the user
+	NotificationMention      NotificationType = "mention"       // Actor mentioned the user in a post
+	NotificationAuthorInvite NotificationType = "author_invite" // Actor invited the user to co-author a post
+)
+
+// Notification tells a user about something another user did
+type Notification struct {
+	Id            int              `json:"id"`
+	UserId        int              `json:"-"`
+	Type          NotificationType `json:"type"`
+	ActorId       int              `json:"-"`
+	ActorUsername string           `json:"actorUsername"`
+	PostId        *int             `json:"postId,omitempty"`
+	ReadAt        *string          `json:"readAt,omitempty"` // Unset while unread
+	CreatedAt     string           `json:"createdAt"`
+}
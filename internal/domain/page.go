@@ -0,0 +1,8 @@
+package domain
+
+// Page is one page of a cursor paginated list, NextCursor is passed back to
+// get the following page and is empty on the last one
+type Page[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
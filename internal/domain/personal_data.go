@@ -0,0 +1,56 @@
+package domain
+
+// DataExportStatus is where a personal data export is in its life:
+// pending -> ready -> downloaded or expired, or failed
+type DataExportStatus string
+
+const (
+	DataExportPending    DataExportStatus = "pending"
+	DataExportReady      DataExportStatus = "ready"
+	DataExportFailed     DataExportStatus = "failed"
+	DataExportDownloaded DataExportStatus = "downloaded"
+	DataExportExpired    DataExportStatus = "expired"
+)
+
+// DataExport is an archive of everything stored about a user
+type DataExport struct {
+	Id           int              `json:"id"`
+	UserId       int              `json:"-"`
+	Status       DataExportStatus `json:"status"`
+	StorageKey   *string          `json:"-"` // nil once the archive is deleted
+	Size         *int64           `json:"size,omitempty"`
+	Error        *string          `json:"error,omitempty"`
+	CreatedAt    string           `json:"createdAt"`
+	ReadyAt      *string          `json:"readyAt,omitempty"`
+	ExpiresAt    *string          `json:"expiresAt,omitempty"`
+	DownloadedAt *string          `json:"downloadedAt,omitempty"`
+	DownloadURL  string           `json:"downloadUrl,omitempty"` // Signed, only while ready
+}
+
+// DataSection is one kind of data in a personal data export, rows as stored
+type DataSection struct {
+	Name string
+	Rows []map[string]any
+}
+
+// ErasureAction is what erasing an account does to a kind of data
+type ErasureAction string
+
+const (
+	ErasureDelete    ErasureAction = "delete"
+	ErasureAnonymize ErasureAction = "anonymize" // Kept, but no longer tied to a person
+)
+
+// ErasureStep is one step of the erasure policy with the rows it applies to
+type ErasureStep struct {
+	Data   string        `json:"data"`
+	Action ErasureAction `json:"action"`
+	Rows   int           `json:"rows"`
+}
+
+// ErasurePlan is what erasing the account will do, EraseAt is unset for a
+// preview
+type ErasurePlan struct {
+	EraseAt string        `json:"eraseAt,omitempty"`
+	Steps   []ErasureStep `json:"steps"`
+}
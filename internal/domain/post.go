@@ -1,9 +1,95 @@
 package domain
 
+// PostStatus is the lifecycle state of a post
+type PostStatus string
+
+const (
+	PostStatusDraft     PostStatus = "draft"
+	PostStatusPublished PostStatus = "published"
+	PostStatusArchived  PostStatus = "archived"
+)
+
+// postTransitions lists the states reachable from each state:
+// draft -> published -> archived, and published -> draft (unpublish)
+var postTransitions = map[PostStatus][]PostStatus{
+	PostStatusDraft:     {PostStatusPublished},
+	PostStatusPublished: {PostStatusDraft, PostStatusArchived},
+	PostStatusArchived:  {},
+}
+
+// CanTransitionTo reports whether a post in status s may move to next
+func (s PostStatus) CanTransitionTo(next PostStatus) bool {
+	for _, allowed := range postTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// PostVisibility is who may read a published post besides its author
+type PostVisibility string
+
+const (
+	VisibilityPublic    PostVisibility = "public"
+	VisibilityUnlisted  PostVisibility = "unlisted"  // Readable by id or slug, never listed
+	VisibilityFollowers PostVisibility = "followers" // Only the author's followers
+	VisibilityPrivate   PostVisibility = "private"   // Only the author
+)
+
 type Post struct {
-	Id        int    `json:"id"`
-	UserId    int    `json:"-"`
-	Title     string `json:"title" binding:"required"`
-	Content   string `json:"content" binding:"required"`
-	CreatedAt string `json:"createdAt"`
+	Id            int            `json:"id"`
+	UserId        int            `json:"-"`
+	Slug          string         `json:"slug"`
+	Title         string         `json:"title" binding:"required"`
+	Content       string         `json:"content" binding:"required"`
+	ContentFormat string         `json:"contentFormat"`         // plain or markdown
+	ContentHTML   *string        `json:"contentHtml,omitempty"` // Sanitized HTML rendered from Content, cached in the db
+	Language      string         `json:"language"`              // Of Title and Content, the original's unless a translation was picked
+	Languages     []string       `json:"languages"`             // Every variant of the post, the original first
+	Status        PostStatus     `json:"status"`
+	Visibility    PostVisibility `json:"visibility"`
+	Version       int            `json:"version"` // Incremented by every write, sent as the ETag
+	CreatedAt     string         `json:"createdAt"`
+	PublishedAt   *string        `json:"publishedAt,omitempty"`
+	PublishAt     *string        `json:"publishAt,omitempty"` // Set while a draft is scheduled for publishing
+	DeletedAt     *string        `json:"deletedAt,omitempty"` // Set while the post is in the trash
+	HiddenAt      *string        `json:"hiddenAt,omitempty"`  // Set while hidden by moderation
+	Mentions      []Mention      `json:"mentions"`            // Ordered by Start
+	Authors       []PostAuthor   `json:"authors"`             // Accepted authors, the owner first
+	Tags          []string       `json:"tags"`                // In the order the author gave them
+	Flags         []PostFlag     `json:"-"`                   // Filter flags stored with a new post, which is then created hidden
+	Series        *SeriesContext `json:"series,omitempty"`    // Only filled in on single post reads
+	Pinned        bool           `json:"pinned,omitempty"`    // Only set in the author's listing
+	Featured      bool           `json:"featured,omitempty"`  // Only set in the global listing
+}
+
+// PostUpdate is an edit of a post, written at once with a single version
+// increment. Empty ContentFormat, Visibility and Slug and nil Tags keep the
+// current ones.
+type PostUpdate struct {
+	Title         string
+	Content       string
+	ContentFormat string
+	Visibility    PostVisibility
+	Slug          string     // The new current slug, the previous ones keep resolving
+	Mentions      []Mention  // Replace the mentions of the post
+	Tags          []string   // Replace the tags of the post, empty clears them
+	Flags         []PostFlag // Hide the post pending review
+}
+
+// AuthorRole is the role of the user among the post's authors, "" when they
+// are not one. UserId is always the owner.
+func (p *Post) AuthorRole(userId int) AuthorRole {
+	if p.UserId == userId {
+		return AuthorOwner
+	}
+
+	for _, a := range p.Authors {
+		if a.UserId == userId {
+			return a.Role
+		}
+	}
+
+	return ""
 }
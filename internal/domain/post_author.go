@@ -0,0 +1,29 @@
+package domain
+
+// AuthorRole is what an author may do with a post
+type AuthorRole string
+
+const (
+	AuthorOwner  AuthorRole = "owner"  // Everything, one per post
+	AuthorEditor AuthorRole = "editor" // Edits the content, can't delete, publish or manage authors
+)
+
+// Allows reports whether the role may do what required may
+func (r AuthorRole) Allows(required AuthorRole) bool {
+	switch r {
+	case AuthorOwner:
+		return true
+	case AuthorEditor:
+		return required == AuthorEditor
+	}
+	return false
+}
+
+// PostAuthor is a user writing a post, invited authors are authors once
+// they accept
+type PostAuthor struct {
+	UserId     int        `json:"-"`
+	Username   string     `json:"username"`
+	Role       AuthorRole `json:"role"`
+	AcceptedAt *string    `json:"acceptedAt,omitempty"` // Unset while the invite is pending
+}
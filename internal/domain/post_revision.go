@@ -0,0 +1,22 @@
+package domain
+
+import "web/example/internal/diff"
+
+// PostRevision is a snapshot of a post's title and content after a write
+type PostRevision struct {
+	Id        int    `json:"-"`
+	PostId    int    `json:"postId"`
+	Revision  int    `json:"revision"`
+	Title     string `json:"title"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// PostRevisionDiff holds either a unified or a word level diff between two revisions
+type PostRevisionDiff struct {
+	From    int            `json:"from"`
+	To      int            `json:"to"`
+	Mode    string         `json:"mode"`
+	Unified string         `json:"unified,omitempty"`
+	Words   []diff.Segment `json:"words,omitempty"`
+}
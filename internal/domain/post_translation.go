@@ -0,0 +1,14 @@
+package domain
+
+// PostTranslation is a localized variant of a post's title and content, the
+// original variant is the post itself
+type PostTranslation struct {
+	PostId      int     `json:"-"`
+	Language    string  `json:"language"` // BCP 47 tag, e.g. pt-BR
+	Title       string  `json:"title"`
+	Content     string  `json:"content"`
+	ContentHTML *string `json:"contentHtml,omitempty"`
+	Original    bool    `json:"original"`
+	CreatedAt   string  `json:"createdAt"`
+	UpdatedAt   string  `json:"updatedAt"`
+}
@@ -0,0 +1,30 @@
+package domain
+
+// Series is an ordered set of posts of one author, like the parts of a
+// multi-part article
+type Series struct {
+	Id          int          `json:"id"`
+	UserId      int          `json:"-"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	CreatedAt   string       `json:"createdAt"`
+	Posts       []SeriesPost `json:"posts"` // Parts the reader can see, in order
+}
+
+// SeriesPost is a part of a series
+type SeriesPost struct {
+	Id       int    `json:"id"`
+	Slug     string `json:"slug"`
+	Title    string `json:"title"`
+	Position int    `json:"position"` // 1 based, among the parts the reader can see
+}
+
+// SeriesContext places a post within its series
+type SeriesContext struct {
+	Id       int         `json:"id"`
+	Title    string      `json:"title"`
+	Position int         `json:"position"`
+	Total    int         `json:"total"`
+	Prev     *SeriesPost `json:"prev"` // nil for the first part
+	Next     *SeriesPost `json:"next"` // nil for the last part
+}
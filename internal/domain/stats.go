@@ -0,0 +1,30 @@
+package domain
+
+// ViewCount is a number of views of a post on a day (UTC, YYYY-MM-DD) from
+// one referrer host, "" for direct visits
+type ViewCount struct {
+	PostId   int
+	Day      string
+	Referrer string
+	Views    int
+}
+
+// DayViews is the views of a post on one day
+type DayViews struct {
+	Day   string `json:"day"`
+	Views int    `json:"views"`
+}
+
+// ReferrerViews is the views of a post coming from one referrer host
+type ReferrerViews struct {
+	Referrer string `json:"referrer"`
+	Views    int    `json:"views"`
+}
+
+// PostStats is how often a post was read over the last days
+type PostStats struct {
+	PostId    int             `json:"postId"`
+	Total     int             `json:"total"`
+	Days      []DayViews      `json:"days"`      // Oldest first, days without views included
+	Referrers []ReferrerViews `json:"referrers"` // Most views first, direct visits left out
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Lengths of user text in characters, the schema declares them as VARCHAR
+// but SQLite does not enforce it
+const (
+	MaxUsernameLength = 16
+	MaxEmailLength    = 64
+	MaxTitleLength    = 512
+	MaxContentLength  = 2048
+	MaxTagLength      = 32
+)
+
+// MaxTags is how many tags a post can have
+const MaxTags = 10
+
+// CleanText normalizes s to NFC, trimming surrounding white space when trim
+// is set. Lengths are counted on the cleaned text.
+func CleanText(s string, trim bool) string {
+	if trim {
+		s = strings.TrimSpace(s)
+	}
+	return norm.NFC.String(s)
+}
+
+// HasControl reports whether s holds control characters, tabs and line
+// breaks are allowed in multiline text
+func HasControl(s string, multiline bool) bool {
+	return strings.ContainsFunc(s, func(r rune) bool {
+		if multiline && (r == '\n' || r == '\r' || r == '\t') {
+			return false
+		}
+		return unicode.IsControl(r)
+	})
+}
+
+// IsTag reports whether s is a tag: lowercase letters, digits and inner
+// hyphens, up to MaxTagLength characters
+func IsTag(s string) bool {
+	if s == "" || utf8.RuneCountInString(s) > MaxTagLength || s[0] == '-' || s[len(s)-1] == '-' {
+		return false
+	}
+	return !strings.ContainsFunc(s, func(r rune) bool {
+		return r != '-' && !unicode.IsDigit(r) && !(unicode.IsLetter(r) && !unicode.IsUpper(r))
+	})
+}
+
+// CleanTag trims, normalizes and lowercases a tag
+func CleanTag(tag string) string {
+	return strings.ToLower(CleanText(tag, true))
+}
+
+// CleanTags cleans tags and drops the empty and repeated ones, keeping the
+// order they were given in
+func CleanTags(tags []string) []string {
+	cleaned := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = CleanTag(t)
+		if t != "" && !slices.Contains(cleaned, t) {
+			cleaned = append(cleaned, t)
+		}
+	}
+	return cleaned
+}
+
+// CheckPostText checks cleaned title and content against the limits the
+// handler models enforce, for posts that don't come through them
+func CheckPostText(title string, content string) error {
+	switch {
+	case utf8.RuneCountInString(title) > MaxTitleLength:
+		return fmt.Errorf("title must be at most %d characters", MaxTitleLength)
+	case utf8.RuneCountInString(content) > MaxContentLength:
+		return fmt.Errorf("content must be at most %d characters", MaxContentLength)
+	case HasControl(title, false):
+		return fmt.Errorf("title must be a single line without control characters")
+	case HasControl(content, true):
+		return fmt.Errorf("content must not contain control characters")
+	}
+	return nil
+}
@@ -1,8 +1,72 @@
 package domain
 
+import (
+	"net/mail"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
 type User struct {
-	Id            int    `json:"-"`
-	Email         string `json:"email"`
-	Username      string `json:"username"`
-	Password_hash string `json:"-"`
+	Id            int     `json:"-"`
+	Email         string  `json:"email"`
+	Username      string  `json:"username"`
+	Password_hash string  `json:"-"`
+	DeletedAt     *string `json:"deletedAt,omitempty"` // Set while the account deletion grace period runs
+	Role          Role    `json:"role"`
+}
+
+// Role of a user, granted directly in the db
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+// IsAdmin reports whether the user may feature posts
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
+// IsModerator reports whether the user may review reported posts
+func (u *User) IsModerator() bool {
+	return u.Role == RoleModerator
+}
+
+// Passwords are hashed with bcrypt, which ignores bytes past the maximum
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 72
+)
+
+// IsUsername reports whether s is a username that can be mentioned: 1 to
+// MaxUsernameLength letters, digits, '_', '.' or '-'
+func IsUsername(s string) bool {
+	if s == "" || utf8.RuneCountInString(s) > MaxUsernameLength {
+		return false
+	}
+	return !strings.ContainsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '.' && r != '-'
+	})
+}
+
+// IsEmail reports whether s is a bare email address of at most
+// MaxEmailLength characters, without a display name or angle brackets
+func IsEmail(s string) bool {
+	if utf8.RuneCountInString(s) > MaxEmailLength {
+		return false
+	}
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
+
+// IsStrongPassword reports whether s meets the password policy: a letter and
+// a digit in MinPasswordLength characters to MaxPasswordLength bytes
+func IsStrongPassword(s string) bool {
+	if utf8.RuneCountInString(s) < MinPasswordLength || len(s) > MaxPasswordLength {
+		return false
+	}
+	return strings.ContainsFunc(s, unicode.IsLetter) && strings.ContainsFunc(s, unicode.IsDigit)
 }
@@ -0,0 +1,68 @@
+// Package export reads and writes the portable format of a user's posts:
+// JSON Lines, or a ZIP of Markdown files with YAML front matter.
+package export
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// Post is an exported post. Id is the post's id where it was exported from,
+// series refer to posts by it.
+type Post struct {
+	Id            int        `json:"id" yaml:"id"`
+	Slug          string     `json:"slug" yaml:"slug"`
+	Title         string     `json:"title" yaml:"title"`
+	ContentFormat string     `json:"contentFormat" yaml:"contentFormat"`
+	Status        string     `json:"status" yaml:"status"`
+	Visibility    string     `json:"visibility" yaml:"visibility"`
+	Tags          []string   `json:"tags,omitempty" yaml:"tags,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt" yaml:"createdAt"`
+	PublishedAt   *time.Time `json:"publishedAt,omitempty" yaml:"publishedAt,omitempty"`
+	PublishAt     *time.Time `json:"publishAt,omitempty" yaml:"publishAt,omitempty"` // Scheduled drafts
+	ContentHash   string     `json:"contentHash" yaml:"contentHash"`                 // See Hash, informative only
+	Content       string     `json:"content" yaml:"-"`                               // The Markdown file's body
+
+	Source string `json:"-" yaml:"-"` // Line or file it was read from
+}
+
+// Series is an exported series, PostIds are the Ids of its posts in order
+type Series struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	PostIds     []int  `json:"postIds" yaml:"postIds"`
+
+	Source string `json:"-" yaml:"-"`
+}
+
+// Invalid is a line or file that could not be read
+type Invalid struct {
+	Source string
+	Err    error
+}
+
+// Archive is everything exported for a user. Invalid is only set when
+// reading, one bad item doesn't stop the others from being read.
+type Archive struct {
+	Posts   []Post
+	Series  []Series
+	Invalid []Invalid
+}
+
+// Hash identifies the content of a post by its title and content, imports
+// skip posts with the hash of one the user already has
+func Hash(title string, content string) string {
+	sum := sha256.Sum256([]byte(title + "\n" + content))
+	return hex.EncodeToString(sum[:])
+}
+
+// Read reads either format, a ZIP is told apart by its signature
+func Read(b []byte) (*Archive, error) {
+	if bytes.HasPrefix(b, []byte("PK\x03\x04")) {
+		return ReadZip(bytes.NewReader(b), int64(len(b)))
+	}
+
+	return ReadJSONL(bytes.NewReader(b))
+}
@@ -0,0 +1,91 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// maxLine caps a JSON Lines record, a post's content included
+const maxLine = 4 << 20
+
+// postRecord and seriesRecord are the lines of the JSON Lines format, told
+// apart by their type
+type postRecord struct {
+	Type string `json:"type"`
+	Post
+}
+
+type seriesRecord struct {
+	Type string `json:"type"`
+	Series
+}
+
+// WriteJSONL writes the posts and then the series, one JSON object per line
+func WriteJSONL(w io.Writer, a *Archive) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+
+	for _, p := range a.Posts {
+		if err := enc.Encode(postRecord{Type: "post", Post: p}); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range a.Series {
+		if err := enc.Encode(seriesRecord{Type: "series", Series: s}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadJSONL reads the JSON Lines format, blank lines are skipped and bad
+// lines end up in Invalid. The error is only for failing to read r.
+func ReadJSONL(r io.Reader) (*Archive, error) {
+	a := &Archive{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLine)
+
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		source := fmt.Sprintf("line %d", n)
+
+		var head struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(line, &head); err != nil {
+			a.Invalid = append(a.Invalid, Invalid{Source: source, Err: err})
+			continue
+		}
+
+		switch head.Type {
+		case "post":
+			var rec postRecord
+			if err := json.Unmarshal(line, &rec); err != nil {
+				a.Invalid = append(a.Invalid, Invalid{Source: source, Err: err})
+				continue
+			}
+			rec.Post.Source = source
+			a.Posts = append(a.Posts, rec.Post)
+		case "series":
+			var rec seriesRecord
+			if err := json.Unmarshal(line, &rec); err != nil {
+				a.Invalid = append(a.Invalid, Invalid{Source: source, Err: err})
+				continue
+			}
+			rec.Series.Source = source
+			a.Series = append(a.Series, rec.Series)
+		default:
+			a.Invalid = append(a.Invalid, Invalid{Source: source, Err: fmt.Errorf("unknown record type %q", head.Type)})
+		}
+	}
+
+	return a, scanner.Err()
+}
@@ -0,0 +1,156 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// seriesFile holds the series in a ZIP export, posts are the other files
+const seriesFile = "series.yaml"
+
+// maxFile caps a file read from a ZIP, against zip bombs
+const maxFile = 4 << 20
+
+var errNoFrontMatter = errors.New("missing YAML front matter")
+
+// WriteZip writes every post as posts/<id>-<slug>.md, its metadata as YAML
+// front matter and its content as the body, and the series to series.yaml
+func WriteZip(w io.Writer, a *Archive) error {
+	zw := zip.NewWriter(w)
+
+	for _, p := range a.Posts {
+		f, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     fmt.Sprintf("posts/%04d-%s.md", p.Id, p.Slug),
+			Method:   zip.Deflate,
+			Modified: p.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := writeMarkdown(f, &p); err != nil {
+			return err
+		}
+	}
+
+	if len(a.Series) > 0 {
+		f, err := zw.CreateHeader(&zip.FileHeader{Name: seriesFile, Method: zip.Deflate, Modified: time.Now()})
+		if err != nil {
+			return err
+		}
+
+		if err := yaml.NewEncoder(f).Encode(a.Series); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func writeMarkdown(w io.Writer, p *Post) error {
+	front, err := yaml.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "---\n%s---\n%s", front, p.Content)
+	return err
+}
+
+// readMarkdown splits a post file into front matter and body, the body is
+// the content as is
+func readMarkdown(b []byte) (*Post, error) {
+	text := strings.ReplaceAll(string(b), "\r\n", "\n")
+
+	rest, ok := strings.CutPrefix(text, "---\n")
+	if !ok {
+		return nil, errNoFrontMatter
+	}
+
+	front, body, ok := strings.Cut(rest, "\n---\n")
+	if !ok {
+		return nil, errNoFrontMatter
+	}
+
+	var p Post
+	if err := yaml.Unmarshal([]byte(front), &p); err != nil {
+		return nil, err
+	}
+	p.Content = body
+
+	return &p, nil
+}
+
+// ReadZip reads a ZIP export. Every .md file is a post and series.yaml holds
+// the series, other files are ignored. Bad files end up in Invalid, the
+// error is only for a ZIP that can't be opened.
+func ReadZip(r io.ReaderAt, size int64) (*Archive, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Archive{}
+
+	for _, f := range zr.File {
+		isPost := strings.EqualFold(path.Ext(f.Name), ".md")
+		if !isPost && f.Name != seriesFile {
+			continue
+		}
+
+		b, err := readFile(f)
+		if err != nil {
+			a.Invalid = append(a.Invalid, Invalid{Source: f.Name, Err: err})
+			continue
+		}
+
+		if !isPost {
+			var series []Series
+			if err := yaml.Unmarshal(b, &series); err != nil {
+				a.Invalid = append(a.Invalid, Invalid{Source: f.Name, Err: err})
+				continue
+			}
+			for i := range series {
+				series[i].Source = fmt.Sprintf("%s #%d", f.Name, i+1)
+			}
+			a.Series = append(a.Series, series...)
+			continue
+		}
+
+		p, err := readMarkdown(b)
+		if err != nil {
+			a.Invalid = append(a.Invalid, Invalid{Source: f.Name, Err: err})
+			continue
+		}
+		p.Source = f.Name
+		a.Posts = append(a.Posts, *p)
+	}
+
+	return a, nil
+}
+
+func readFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, io.LimitReader(rc, maxFile+1)); err != nil {
+		return nil, err
+	}
+	if buf.Len() > maxFile {
+		return nil, fmt.Errorf("file is larger than %d bytes", maxFile)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,125 @@
+package filter
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+	"web/example/internal/domain"
+)
+
+const (
+	// MaxTokens caps the distinct tokens taken from one text
+	MaxTokens = 500
+	// interestingTokens is how many of the most telling tokens decide
+	interestingTokens = 15
+	// tokenStrength weighs a token's counts against the neutral 0.5, rare
+	// tokens stay close to neutral
+	tokenStrength = 1.0
+)
+
+// Tokenize splits text into distinct lowercase words of 3 to 40 letters or
+// digits, in order of appearance
+func Tokenize(text string) []string {
+	seen := map[string]bool{}
+	var tokens []string
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for _, w := range words {
+		if n := utf8.RuneCountInString(w); n < 3 || n > 40 || seen[w] {
+			continue
+		}
+		seen[w] = true
+		tokens = append(tokens, w)
+
+		if len(tokens) == MaxTokens {
+			break
+		}
+	}
+
+	return tokens
+}
+
+// BayesStore reads what the classifier learned from moderator decisions
+type BayesStore interface {
+	ReadDocumentCounts() (int, int, error)
+	ReadTokenCounts(tokens []string) (map[string]domain.TokenCount, error)
+}
+
+// Bayes flags content a naive Bayes classifier finds likely to be spam. It
+// allows everything until MinDocuments spam and ham posts were trained.
+type Bayes struct {
+	Store        BayesStore
+	FlagAt       float64 // Spam probability flagging the content
+	MinDocuments int
+}
+
+func (b *Bayes) Name() string { return "bayes" }
+
+func (b *Bayes) Check(c *Content) (Verdict, string, error) {
+	p, ok, err := b.SpamProbability(c.Title + "\n" + c.Body)
+	if err != nil || !ok {
+		return Allow, "", err
+	}
+
+	if p >= b.FlagAt {
+		return Flag, fmt.Sprintf("spam probability %.2f", p), nil
+	}
+
+	return Allow, "", nil
+}
+
+// SpamProbability classifies text, ok is false while the classifier is not
+// trained enough to tell
+func (b *Bayes) SpamProbability(text string) (float64, bool, error) {
+	spamDocs, hamDocs, err := b.Store.ReadDocumentCounts()
+	if err != nil {
+		return 0, false, err
+	}
+
+	if spamDocs < max(b.MinDocuments, 1) || hamDocs < max(b.MinDocuments, 1) {
+		return 0, false, nil
+	}
+
+	counts, err := b.Store.ReadTokenCounts(Tokenize(text))
+	if err != nil {
+		return 0, false, err
+	}
+
+	return Classify(counts, spamDocs, hamDocs), true, nil
+}
+
+// Classify combines the token counts into a spam probability. Each token's
+// probability is smoothed towards 0.5 by how often it was seen and the most
+// telling tokens are combined assuming independence.
+func Classify(counts map[string]domain.TokenCount, spamDocs int, hamDocs int) float64 {
+	var probs []float64
+
+	for _, c := range counts {
+		spamRate := float64(c.Spam) / float64(spamDocs)
+		hamRate := float64(c.Ham) / float64(hamDocs)
+		if spamRate+hamRate == 0 {
+			continue
+		}
+
+		n := float64(c.Spam + c.Ham)
+		p := (tokenStrength*0.5 + n*spamRate/(spamRate+hamRate)) / (tokenStrength + n)
+		probs = append(probs, min(max(p, 0.01), 0.99))
+	}
+
+	sort.Slice(probs, func(i, j int) bool {
+		return math.Abs(probs[i]-0.5) > math.Abs(probs[j]-0.5)
+	})
+
+	var logOdds float64
+	for _, p := range probs[:min(len(probs), interestingTokens)] {
+		logOdds += math.Log(p / (1 - p))
+	}
+
+	return 1 / (1 + math.Exp(-logOdds))
+}
@@ -0,0 +1,77 @@
+package filter
+
+import (
+	"encoding/json"
+	"os"
+	"web/example/internal/domain"
+)
+
+// Config sets up the filters, a zero limit or an empty list turns the filter
+// off
+type Config struct {
+	RejectWords      []string `json:"rejectWords"`
+	FlagWords        []string `json:"flagWords"`
+	RejectPatterns   []string `json:"rejectPatterns"` // Go regular expressions
+	FlagPatterns     []string `json:"flagPatterns"`
+	FlagLinksAbove   int      `json:"flagLinksAbove"`
+	RejectLinksAbove int      `json:"rejectLinksAbove"`
+	DuplicateWindow  int      `json:"duplicateWindow"` // Latest posts of the user compared
+	BayesFlagAt      float64  `json:"bayesFlagAt"`     // Spam probability between 0 and 1
+	BayesMinDocs     int      `json:"bayesMinDocuments"`
+}
+
+// DefaultConfig has no word lists, the other filters are on
+func DefaultConfig() Config {
+	return Config{
+		FlagLinksAbove:   5,
+		RejectLinksAbove: 20,
+		DuplicateWindow:  10,
+		BayesFlagAt:      0.9,
+		BayesMinDocs:     10,
+	}
+}
+
+// LoadConfig reads a JSON config over the defaults, an empty path is the
+// defaults
+func LoadConfig(path string) (Config, error) {
+	cfg := DefaultConfig()
+	if path == "" {
+		return cfg, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+
+	err = json.Unmarshal(data, &cfg)
+	return cfg, err
+}
+
+// Build creates the chain, cheapest filters first. recent lists a user's
+// latest posts for the duplicate filter, store is the classifier training.
+func (cfg Config) Build(recent func(userId int, limit int) ([]domain.Post, error), store BayesStore) (Chain, error) {
+	var chain Chain
+
+	words, err := NewWordlist(cfg.RejectWords, cfg.FlagWords, cfg.RejectPatterns, cfg.FlagPatterns)
+	if err != nil {
+		return nil, err
+	}
+	if !words.Empty() {
+		chain = append(chain, words)
+	}
+
+	if cfg.FlagLinksAbove > 0 || cfg.RejectLinksAbove > 0 {
+		chain = append(chain, &LinkLimit{FlagAbove: cfg.FlagLinksAbove, RejectAbove: cfg.RejectLinksAbove})
+	}
+
+	if cfg.DuplicateWindow > 0 {
+		chain = append(chain, &Duplicate{Recent: recent, Window: cfg.DuplicateWindow})
+	}
+
+	if cfg.BayesFlagAt > 0 {
+		chain = append(chain, &Bayes{Store: store, FlagAt: cfg.BayesFlagAt, MinDocuments: cfg.BayesMinDocs})
+	}
+
+	return chain, nil
+}
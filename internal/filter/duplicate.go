@@ -0,0 +1,45 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+	"web/example/internal/domain"
+)
+
+// DuplicateMinLength is the shortest normalized body checked for duplicates,
+// short replies like "thanks!" repeat legitimately
+const DuplicateMinLength = 32
+
+// Duplicate rejects a body the user already posted in one of their Window
+// latest posts, ignoring case and whitespace
+type Duplicate struct {
+	Recent func(userId int, limit int) ([]domain.Post, error)
+	Window int
+}
+
+func (d *Duplicate) Name() string { return "duplicate" }
+
+func (d *Duplicate) Check(c *Content) (Verdict, string, error) {
+	body := normalize(c.Body)
+	if utf8.RuneCountInString(body) < DuplicateMinLength {
+		return Allow, "", nil
+	}
+
+	posts, err := d.Recent(c.UserId, d.Window)
+	if err != nil {
+		return Allow, "", err
+	}
+
+	for _, p := range posts {
+		if p.Id != c.PostId && normalize(p.Content) == body {
+			return Reject, fmt.Sprintf("same content as post %d", p.Id), nil
+		}
+	}
+
+	return Allow, "", nil
+}
+
+func normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
@@ -0,0 +1,102 @@
+// Package filter checks post content before it is stored. Filters are chained,
+// each one allows, flags for review or rejects the content.
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Verdict is what a filter decided, stronger verdicts win in a Chain
+type Verdict int
+
+const (
+	Allow  Verdict = iota
+	Flag           // Stored but held for moderator review
+	Reject         // Not stored
+)
+
+func (v Verdict) String() string {
+	switch v {
+	case Allow:
+		return "allow"
+	case Flag:
+		return "flag"
+	case Reject:
+		return "reject"
+	}
+	return fmt.Sprintf("verdict(%d)", int(v))
+}
+
+// Content is a post being created or updated
+type Content struct {
+	PostId int // 0 while creating
+	UserId int
+	Title  string
+	Body   string
+}
+
+// ContentFilter checks content, the reason explains a flag or a reject to the
+// author and moderators
+type ContentFilter interface {
+	Name() string
+	Check(c *Content) (Verdict, string, error)
+}
+
+// Result is one filter flagging or rejecting the content
+type Result struct {
+	Filter  string
+	Verdict Verdict
+	Reason  string
+}
+
+// Decision is the outcome of a Chain, Results lists the filters that did not
+// allow the content
+type Decision struct {
+	Verdict Verdict
+	Results []Result
+}
+
+// Reason joins the reasons of the results with the strongest verdict
+func (d Decision) Reason() string {
+	var reasons []string
+	for _, r := range d.Results {
+		if r.Verdict == d.Verdict {
+			reasons = append(reasons, r.Filter+": "+r.Reason)
+		}
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// Chain runs filters in order, an empty chain allows everything
+type Chain []ContentFilter
+
+// Run checks content with every filter until one rejects it. A failing filter
+// is skipped, its error is returned along with the decision of the others so
+// the caller can choose to fail open.
+func (ch Chain) Run(c *Content) (Decision, error) {
+	var decision Decision
+	var errs []error
+
+	for _, f := range ch {
+		verdict, reason, err := f.Check(c)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", f.Name(), err))
+			continue
+		}
+
+		if verdict == Allow {
+			continue
+		}
+
+		decision.Results = append(decision.Results, Result{Filter: f.Name(), Verdict: verdict, Reason: reason})
+		decision.Verdict = max(decision.Verdict, verdict)
+
+		if verdict == Reject {
+			break
+		}
+	}
+
+	return decision, errors.Join(errs...)
+}
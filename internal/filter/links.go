@@ -0,0 +1,30 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var linkPattern = regexp.MustCompile(`(?i)\bhttps?://`)
+
+// LinkLimit holds back content with too many links. A limit of 0 is not
+// enforced.
+type LinkLimit struct {
+	FlagAbove   int
+	RejectAbove int
+}
+
+func (l *LinkLimit) Name() string { return "links" }
+
+func (l *LinkLimit) Check(c *Content) (Verdict, string, error) {
+	n := len(linkPattern.FindAllStringIndex(c.Title+"\n"+c.Body, -1))
+
+	switch {
+	case l.RejectAbove > 0 && n > l.RejectAbove:
+		return Reject, fmt.Sprintf("%d links, at most %d allowed", n, l.RejectAbove), nil
+	case l.FlagAbove > 0 && n > l.FlagAbove:
+		return Flag, fmt.Sprintf("%d links", n), nil
+	}
+
+	return Allow, "", nil
+}
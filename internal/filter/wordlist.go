@@ -0,0 +1,95 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Wordlist flags or rejects content containing listed words or matching
+// regular expressions. Words match whole tokens ignoring case, patterns
+// match anywhere in the title or body.
+type Wordlist struct {
+	rejectWords    map[string]bool
+	flagWords      map[string]bool
+	rejectPatterns []*regexp.Regexp
+	flagPatterns   []*regexp.Regexp
+}
+
+// NewWordlist compiles the lists, an invalid pattern is an error
+func NewWordlist(rejectWords, flagWords, rejectPatterns, flagPatterns []string) (*Wordlist, error) {
+	w := &Wordlist{
+		rejectWords: wordSet(rejectWords),
+		flagWords:   wordSet(flagWords),
+	}
+
+	var err error
+
+	if w.rejectPatterns, err = compileAll(rejectPatterns); err != nil {
+		return nil, err
+	}
+	if w.flagPatterns, err = compileAll(flagPatterns); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func wordSet(words []string) map[string]bool {
+	set := map[string]bool{}
+	for _, w := range words {
+		set[strings.ToLower(w)] = true
+	}
+	return set
+}
+
+func compileAll(patterns []string) ([]*regexp.Regexp, error) {
+	var compiled []*regexp.Regexp
+
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+		compiled = append(compiled, re)
+	}
+
+	return compiled, nil
+}
+
+// Empty reports whether nothing is listed, the filter then allows everything
+func (w *Wordlist) Empty() bool {
+	return len(w.rejectWords)+len(w.flagWords)+len(w.rejectPatterns)+len(w.flagPatterns) == 0
+}
+
+func (w *Wordlist) Name() string { return "wordlist" }
+
+func (w *Wordlist) Check(c *Content) (Verdict, string, error) {
+	text := c.Title + "\n" + c.Body
+	tokens := Tokenize(text)
+
+	if reason, ok := w.match(text, tokens, w.rejectWords, w.rejectPatterns); ok {
+		return Reject, reason, nil
+	}
+	if reason, ok := w.match(text, tokens, w.flagWords, w.flagPatterns); ok {
+		return Flag, reason, nil
+	}
+
+	return Allow, "", nil
+}
+
+func (w *Wordlist) match(text string, tokens []string, words map[string]bool, patterns []*regexp.Regexp) (string, bool) {
+	for _, t := range tokens {
+		if words[t] {
+			return fmt.Sprintf("contains %q", t), true
+		}
+	}
+
+	for _, re := range patterns {
+		if re.MatchString(text) {
+			return fmt.Sprintf("matches %q", re.String()), true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/media"
+	"web/example/internal/services"
+	"web/example/internal/signedurl"
+	"web/example/internal/storage"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+type AttachmentHandler struct {
+	attachmentService *services.AttachmentService
+}
+
+func NewAttachmentHandler(attachmentService *services.AttachmentService) *AttachmentHandler {
+	return &AttachmentHandler{
+		attachmentService: attachmentService,
+	}
+}
+
+// attachmentStatus maps attachment errors to response codes
+func attachmentStatus(err error) int {
+	var maxBytes *http.MaxBytesError
+
+	switch {
+	case errors.Is(err, services.ErrAttachmentTooLarge), errors.As(err, &maxBytes):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, services.ErrUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, signedurl.ErrInvalidSignature), errors.Is(err, signedurl.ErrExpired):
+		return http.StatusForbidden
+	case errors.Is(err, sql.ErrNoRows), errors.Is(err, storage.ErrNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// multipartOverhead is allowed on top of the file size limit for the form
+// fields and part headers
+const multipartOverhead = 1 << 20
+
+func (ah *AttachmentHandler) Upload(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	// oversized uploads are cut off while reading, not after buffering them
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, ah.attachmentService.MaxSize+multipartOverhead)
+
+	var req handlermodel.UploadAttachmentRequest
+	if err := c.ShouldBindWith(&req, binding.FormMultipart); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	file, err := req.File.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	attachment, err := ah.attachmentService.UploadAttachmentService(uri.Id, req.UserEmail, req.File.Filename, file)
+	if err != nil {
+		c.JSON(attachmentStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, attachment)
+}
+
+func (ah *AttachmentHandler) ReadAll(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.ReadAttachmentsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if !identified(c, req.UserEmail) {
+		return
+	}
+
+	if attachments, err := ah.attachmentService.ReadAttachments(uri.Id, req.UserEmail); err != nil {
+		c.JSON(attachmentStatus(err), gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusOK, attachments)
+	}
+}
+
+func (ah *AttachmentHandler) Delete(c *gin.Context) {
+	var uri handlermodel.AttachmentUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var body handlermodel.DeleteAttachmentBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := ah.attachmentService.DeleteAttachmentService(uri.Id, uri.AttachmentId, body.UserEmail); err != nil {
+		c.JSON(attachmentStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+func (ah *AttachmentHandler) Download(c *gin.Context) {
+	ah.serve(c, false)
+}
+
+func (ah *AttachmentHandler) Thumbnail(c *gin.Context) {
+	ah.serve(c, true)
+}
+
+// serve streams the attachment (or its thumbnail) for a signed URL,
+// http.ServeContent answers range and conditional requests
+func (ah *AttachmentHandler) serve(c *gin.Context, thumbnail bool) {
+	var uri handlermodel.AttachmentIdUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.DownloadAttachmentRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+
+	attachment, contentType, content, err := ah.attachmentService.OpenAttachment(c.Request.Context(), uri.Id, thumbnail, req.Expires, req.Sig)
+	if err != nil {
+		c.JSON(attachmentStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	defer content.Close()
+
+	// only images are shown inline, anything else is downloaded
+	disposition := "attachment"
+	if media.IsImage(attachment.ContentType) {
+		disposition = "inline"
+	}
+
+	etag := strconv.Itoa(attachment.Id)
+	if thumbnail {
+		etag += "-thumbnail"
+	}
+
+	c.Header("Content-Type", contentType)
+	c.Header("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": attachment.Filename}))
+	c.Header("Content-Security-Policy", "sandbox")
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Cache-Control", "private")
+	// stored files never change, the id is a strong validator for If-Range
+	c.Header("ETag", `"`+etag+`"`)
+
+	modified, _ := time.Parse(time.RFC3339, attachment.CreatedAt)
+	http.ServeContent(c.Writer, c.Request, "", modified, content)
+}
@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"net/http"
+	"web/example/internal/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// identified answers 401 when a public read names its reader without the
+// token, the reader decides what drafts and restricted posts are visible
+func identified(c *gin.Context, userEmail string) bool {
+	if userEmail == "" || middleware.Authenticated(c) {
+		return true
+	}
+
+	c.Header("WWW-Authenticate", `Bearer realm="api", charset="UTF-8"`)
+	c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+	return false
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuthorHandler struct {
+	authorService *services.AuthorService
+}
+
+func NewAuthorHandler(db *sql.DB, notificationService *services.NotificationService) *AuthorHandler {
+	return &AuthorHandler{
+		authorService: services.NewAuthorService(db, notificationService),
+	}
+}
+
+// authorStatus maps post author errors to response codes
+func authorStatus(err error) int {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	case errors.Is(err, services.ErrBlocked):
+		return http.StatusForbidden
+	case errors.Is(err, services.ErrAlreadyAuthor), errors.Is(err, services.ErrOwnerCannotLeave):
+		return http.StatusConflict
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func (ah *AuthorHandler) ReadAll(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.ReadPostAuthorsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if authors, err := ah.authorService.ReadAuthors(uri.Id, req.UserEmail); err != nil {
+		c.JSON(authorStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, authors)
+	}
+}
+
+func (ah *AuthorHandler) Invite(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.InvitePostAuthorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := ah.authorService.Invite(uri.Id, &req); err != nil {
+		c.JSON(authorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (ah *AuthorHandler) Accept(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.AcceptPostAuthorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := ah.authorService.Accept(uri.Id, req.UserEmail); err != nil {
+		c.JSON(authorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (ah *AuthorHandler) Remove(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.RemovePostAuthorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := ah.authorService.Remove(uri.Id, &req); err != nil {
+		c.JSON(authorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (ah *AuthorHandler) Transfer(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.TransferPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := ah.authorService.Transfer(uri.Id, &req); err != nil {
+		c.JSON(authorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
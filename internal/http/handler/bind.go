@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"web/example/internal/http/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bindError answers a request that failed to bind: 422 with the failed rule
+// of each field, 413 past a body limit, or 400 when it could not be decoded
+func bindError(c *gin.Context, err error) {
+	var maxBytes *http.MaxBytesError
+
+	if fields := validation.Fields(err); fields != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "invalid request", "fields": fields})
+	} else if errors.As(err, &maxBytes) {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,264 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BookmarkHandler struct {
+	bookmarkService *services.BookmarkService
+}
+
+func NewBookmarkHandler(db *sql.DB) *BookmarkHandler {
+	return &BookmarkHandler{
+		bookmarkService: services.NewBookmarkService(db),
+	}
+}
+
+// bookmarkStatus maps bookmark and reading list errors to response codes
+func bookmarkStatus(err error) int {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	case errors.Is(err, services.ErrReadingListExists), errors.Is(err, services.ErrAlreadyInList):
+		return http.StatusConflict
+	case errors.Is(err, services.ErrReadingListFull):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func (bh *BookmarkHandler) Bookmark(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.BookmarkPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := bh.bookmarkService.Bookmark(uri.Id, req.UserEmail, req.Note); err != nil {
+		c.JSON(bookmarkStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (bh *BookmarkHandler) Unbookmark(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.BookmarkPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := bh.bookmarkService.Unbookmark(uri.Id, req.UserEmail); err != nil {
+		c.JSON(bookmarkStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (bh *BookmarkHandler) ReadBookmarks(c *gin.Context) {
+	var req handlermodel.ReadBookmarksRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if page, err := bh.bookmarkService.ReadBookmarks(req.UserEmail, req.Cursor, req.Limit); err != nil {
+		c.JSON(bookmarkStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, page)
+	}
+}
+
+func (bh *BookmarkHandler) CreateList(c *gin.Context) {
+	var req handlermodel.CreateReadingListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if list, err := bh.bookmarkService.CreateReadingList(&req); err != nil {
+		c.JSON(bookmarkStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusCreated, list)
+	}
+}
+
+func (bh *BookmarkHandler) ReadLists(c *gin.Context) {
+	var req handlermodel.ReadReadingListsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if lists, err := bh.bookmarkService.ReadReadingLists(req.UserEmail); err != nil {
+		c.JSON(bookmarkStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, lists)
+	}
+}
+
+func (bh *BookmarkHandler) ReadList(c *gin.Context) {
+	var uri handlermodel.ReadingListUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.ReadReadingListsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if list, err := bh.bookmarkService.ReadReadingList(uri.Id, req.UserEmail); err != nil {
+		c.JSON(bookmarkStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, list)
+	}
+}
+
+// ReadSharedList reads a public list, no login needed
+func (bh *BookmarkHandler) ReadSharedList(c *gin.Context) {
+	var uri handlermodel.SharedReadingListUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if list, err := bh.bookmarkService.ReadSharedReadingList(uri.Token); err != nil {
+		c.JSON(bookmarkStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, list)
+	}
+}
+
+func (bh *BookmarkHandler) UpdateList(c *gin.Context) {
+	var uri handlermodel.ReadingListUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.UpdateReadingListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if list, err := bh.bookmarkService.UpdateReadingList(uri.Id, &req); err != nil {
+		c.JSON(bookmarkStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, list)
+	}
+}
+
+func (bh *BookmarkHandler) DeleteList(c *gin.Context) {
+	var uri handlermodel.ReadingListUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.DeleteReadingListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := bh.bookmarkService.DeleteReadingList(uri.Id, req.UserEmail); err != nil {
+		c.JSON(bookmarkStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (bh *BookmarkHandler) AddItem(c *gin.Context) {
+	var uri handlermodel.ReadingListUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.AddReadingListItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := bh.bookmarkService.AddListItem(uri.Id, &req); err != nil {
+		c.JSON(bookmarkStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (bh *BookmarkHandler) UpdateItem(c *gin.Context) {
+	var uri handlermodel.ReadingListItemUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.UpdateReadingListItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := bh.bookmarkService.UpdateListItem(uri.Id, uri.PostId, &req); err != nil {
+		c.JSON(bookmarkStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (bh *BookmarkHandler) RemoveItem(c *gin.Context) {
+	var uri handlermodel.ReadingListItemUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.DeleteReadingListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := bh.bookmarkService.RemoveListItem(uri.Id, uri.PostId, req.UserEmail); err != nil {
+		c.JSON(bookmarkStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+	"web/example/internal/export"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// maxImportSize caps an uploaded export
+const maxImportSize = 32 << 20
+
+type ExportHandler struct {
+	exportService *services.ExportService
+}
+
+func NewExportHandler(exportService *services.ExportService) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+	}
+}
+
+// exportStatus maps export errors to response codes
+func exportStatus(err error) int {
+	var maxBytes *http.MaxBytesError
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	case errors.As(err, &maxBytes):
+		return http.StatusRequestEntityTooLarge
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func (eh *ExportHandler) Export(c *gin.Context) {
+	var req handlermodel.ExportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	archive, err := eh.exportService.Export(req.UserEmail)
+	if err != nil {
+		c.JSON(exportStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	// written to a buffer first so a failure can still be reported as such
+	var buf bytes.Buffer
+	name := "posts-" + time.Now().UTC().Format("20060102")
+	contentType := "application/jsonl"
+
+	if req.Format == "zip" {
+		name += ".zip"
+		contentType = "application/zip"
+		err = export.WriteZip(&buf, archive)
+	} else {
+		name += ".jsonl"
+		err = export.WriteJSONL(&buf, archive)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	c.Data(http.StatusOK, contentType, buf.Bytes())
+}
+
+// Import answers 201 when the export was imported, 200 for a dry run and 422
+// when items failed and nothing was imported, always with the report
+func (eh *ExportHandler) Import(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize+multipartOverhead)
+
+	var req handlermodel.ImportRequest
+	if err := c.ShouldBindWith(&req, binding.FormMultipart); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if req.File.Size > maxImportSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("exports are limited to %d bytes", maxImportSize)})
+		return
+	}
+
+	file, err := req.File.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	b, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	archive, err := export.Read(b)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := eh.exportService.Import(req.UserEmail, archive, req.DryRun)
+	switch {
+	case err != nil:
+		c.JSON(exportStatus(err), gin.H{"error": err.Error()})
+	case report.Failed > 0:
+		c.JSON(http.StatusUnprocessableEntity, report)
+	case report.DryRun:
+		c.JSON(http.StatusOK, report)
+	default:
+		c.JSON(http.StatusCreated, report)
+	}
+}
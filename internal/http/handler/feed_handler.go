@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"web/example/internal/feed"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FeedHandler struct {
+	postService *services.PostService
+	baseURL     string
+}
+
+// NewFeedHandler uses BASE_URL (e.g. https://blog.example.com) for the links
+// and ids in feeds, without it they are built from the request host
+func NewFeedHandler(db *sql.DB) *FeedHandler {
+	return &FeedHandler{
+		postService: services.NewPostService(db),
+		baseURL:     strings.TrimRight(os.Getenv("BASE_URL"), "/"),
+	}
+}
+
+func (fh *FeedHandler) RSS(c *gin.Context) {
+	fh.serve(c, feed.RSS, feed.RSSContentType)
+}
+
+func (fh *FeedHandler) Atom(c *gin.Context) {
+	fh.serve(c, feed.Atom, feed.AtomContentType)
+}
+
+func (fh *FeedHandler) JSON(c *gin.Context) {
+	fh.serve(c, feed.JSON, feed.JSONContentType)
+}
+
+func (fh *FeedHandler) base(c *gin.Context) string {
+	if fh.baseURL != "" {
+		return fh.baseURL
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+
+	return scheme + "://" + c.Request.Host
+}
+
+// serve builds the feed and answers conditional requests: the ETag is a hash
+// of the document so it changes with any edit, Last-Modified is the newest post
+func (fh *FeedHandler) serve(c *gin.Context, encode func(*feed.Feed) ([]byte, error), contentType string) {
+	var req handlermodel.ReadFeedRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	author, posts, err := fh.postService.ReadFeed(req.Author, req.Tag, req.Limit)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "author not found"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	base := fh.base(c)
+
+	f := &feed.Feed{
+		Title:       "go-web-demo",
+		Description: "Latest posts",
+		Link:        base + "/posts",
+		FeedURL:     base + c.Request.URL.Path,
+	}
+	query := url.Values{}
+	if author != nil {
+		query.Set("author", req.Author)
+		f.Title = "go-web-demo: " + author.Username
+		f.Description = "Latest posts by " + author.Username
+	}
+	if req.Tag != "" {
+		query.Set("tag", req.Tag)
+		f.Title += " #" + req.Tag
+		f.Description += " tagged " + req.Tag
+	}
+	if len(query) > 0 {
+		f.FeedURL += "?" + query.Encode()
+	}
+
+	for _, post := range posts {
+		published, err := time.Parse(time.RFC3339, post.CreatedAt)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if published.After(f.Updated) {
+			f.Updated = published
+		}
+
+		f.Items = append(f.Items, feed.Item{
+			// ids stay valid across slug changes
+			Id:          base + "/posts/" + strconv.Itoa(post.Id),
+			URL:         base + "/posts/" + post.Slug,
+			Title:       post.Title,
+			Author:      post.AuthorName,
+			ContentHTML: *post.ContentHTML,
+			Published:   published,
+			Tags:        post.Tags,
+		})
+	}
+
+	body, err := encode(f)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	sum := sha256.Sum256(body)
+
+	c.Header("Content-Type", contentType)
+	c.Header("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	c.Header("Cache-Control", "public, max-age=300")
+
+	http.ServeContent(c.Writer, c.Request, "", f.Updated, bytes.NewReader(body))
+}
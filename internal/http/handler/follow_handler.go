@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FollowHandler struct {
+	followService *services.FollowService
+}
+
+func NewFollowHandler(db *sql.DB, notificationService *services.NotificationService) *FollowHandler {
+	return &FollowHandler{
+		followService: services.NewFollowService(db, notificationService),
+	}
+}
+
+// followStatus maps follow errors to response codes
+func followStatus(err error) int {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	case errors.Is(err, services.ErrBlocked):
+		return http.StatusForbidden
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// change binds a follow request and applies it with action
+func (fh *FollowHandler) change(c *gin.Context, action func(userEmail string, targetEmail string) error) {
+	var req handlermodel.FollowUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := action(req.UserEmail, req.TargetEmail); err != nil {
+		c.JSON(followStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (fh *FollowHandler) Follow(c *gin.Context) {
+	fh.change(c, fh.followService.Follow)
+}
+
+func (fh *FollowHandler) Unfollow(c *gin.Context) {
+	fh.change(c, fh.followService.Unfollow)
+}
+
+func (fh *FollowHandler) Block(c *gin.Context) {
+	fh.change(c, fh.followService.Block)
+}
+
+func (fh *FollowHandler) Unblock(c *gin.Context) {
+	fh.change(c, fh.followService.Unblock)
+}
+
+// list binds a follow list request and reads the page with read
+func (fh *FollowHandler) list(c *gin.Context, read func(email string, cursor string, limit int) (*domain.Page[domain.Follow], error)) {
+	var req handlermodel.ReadFollowsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if page, err := read(req.Email, req.Cursor, req.Limit); err != nil {
+		c.JSON(followStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, page)
+	}
+}
+
+func (fh *FollowHandler) ReadFollowers(c *gin.Context) {
+	fh.list(c, fh.followService.ReadFollowers)
+}
+
+func (fh *FollowHandler) ReadFollowing(c *gin.Context) {
+	fh.list(c, fh.followService.ReadFollowing)
+}
+
+func (fh *FollowHandler) ReadCounts(c *gin.Context) {
+	var req handlermodel.ReadFollowCountsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if counts, err := fh.followService.ReadFollowCounts(req.Email); err != nil {
+		c.JSON(followStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, counts)
+	}
+}
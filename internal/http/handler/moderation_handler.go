@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository"
+	"web/example/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ModerationHandler struct {
+	moderationService *services.ModerationService
+}
+
+func NewModerationHandler(moderationService *services.ModerationService) *ModerationHandler {
+	return &ModerationHandler{
+		moderationService: moderationService,
+	}
+}
+
+// moderationStatus maps moderation errors to response codes
+func moderationStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrNotModerator):
+		return http.StatusForbidden
+	case errors.Is(err, services.ErrAlreadyReported):
+		return http.StatusConflict
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func (mh *ModerationHandler) Report(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.ReportPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := mh.moderationService.ReportPost(uri.Id, req.UserEmail, domain.ReportReason(req.Reason), req.Details); err != nil {
+		c.JSON(moderationStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (mh *ModerationHandler) Queue(c *gin.Context) {
+	var req handlermodel.ModerationQueueRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	filter := repository.QueueFilter{Reason: domain.ReportReason(req.Reason), Hidden: req.Hidden, Flagged: req.Flagged}
+
+	if page, err := mh.moderationService.ReadQueue(req.UserEmail, filter, req.Cursor, req.Limit); err != nil {
+		c.JSON(moderationStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, page)
+	}
+}
+
+func (mh *ModerationHandler) Detail(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.ModerationDetailRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if detail, err := mh.moderationService.ReadModerationDetail(uri.Id, req.UserEmail); err != nil {
+		c.JSON(moderationStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, detail)
+	}
+}
+
+// moderate binds a moderation request and applies action to the post
+func (mh *ModerationHandler) moderate(c *gin.Context, action domain.ModerationAction) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.ModeratePostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := mh.moderationService.Moderate(uri.Id, req.UserEmail, action, req.Note); err != nil {
+		c.JSON(moderationStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (mh *ModerationHandler) Hide(c *gin.Context) {
+	mh.moderate(c, domain.ModerationHide)
+}
+
+func (mh *ModerationHandler) Restore(c *gin.Context) {
+	mh.moderate(c, domain.ModerationRestore)
+}
+
+func (mh *ModerationHandler) Dismiss(c *gin.Context) {
+	mh.moderate(c, domain.ModerationDismiss)
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// streamHeartbeat keeps idle streams from being cut by proxies and notices
+// clients that went away without closing the connection
+const streamHeartbeat = 25 * time.Second
+
+type NotificationHandler struct {
+	notificationService *services.NotificationService
+	heartbeat           time.Duration
+}
+
+func NewNotificationHandler(notificationService *services.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+		heartbeat:           streamHeartbeat,
+	}
+}
+
+// notificationStatus maps notification errors to response codes
+func notificationStatus(err error) int {
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
+
+func (nh *NotificationHandler) ReadAll(c *gin.Context) {
+	var req handlermodel.ReadNotificationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if page, err := nh.notificationService.ReadNotifications(req.UserEmail, req.Unread, req.Cursor, req.Limit); err != nil {
+		c.JSON(notificationStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, page)
+	}
+}
+
+func (nh *NotificationHandler) MarkRead(c *gin.Context) {
+	var uri handlermodel.NotificationUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.MarkNotificationsReadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := nh.notificationService.MarkRead(req.UserEmail, uri.Id); err != nil {
+		c.JSON(notificationStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (nh *NotificationHandler) MarkAllRead(c *gin.Context) {
+	var req handlermodel.MarkNotificationsReadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := nh.notificationService.MarkAllRead(req.UserEmail); err != nil {
+		c.JSON(notificationStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// Stream pushes new notifications as Server-Sent Events. Clients reconnecting
+// with Last-Event-ID first get what they missed. The stream ends when the
+// client disconnects, or when it falls too far behind, the client then
+// reconnects and catches up.
+func (nh *NotificationHandler) Stream(c *gin.Context) {
+	var req handlermodel.NotificationStreamRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	lastId := req.LastEventId
+	if header := c.GetHeader("Last-Event-ID"); header != "" {
+		if id, err := strconv.Atoi(header); err == nil {
+			lastId = id
+		}
+	}
+
+	sub, missed, err := nh.notificationService.Subscribe(req.UserEmail, lastId)
+	if err != nil {
+		c.JSON(notificationStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	defer nh.notificationService.Unsubscribe(sub)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // don't let nginx buffer the stream
+	c.Status(http.StatusOK)
+
+	w := c.Writer
+	if _, err := fmt.Fprint(w, "retry: 3000\n\n"); err != nil {
+		return
+	}
+	w.Flush()
+
+	// notifications published while catching up arrive twice, ids only grow
+	sent := lastId
+	send := func(n domain.Notification) error {
+		if n.Id <= sent {
+			return nil
+		}
+
+		data, err := json.Marshal(n)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(w, "id: %d\nevent: notification\ndata: %s\n\n", n.Id, data); err != nil {
+			return err
+		}
+		w.Flush()
+
+		sent = n.Id
+		return nil
+	}
+
+	for _, n := range missed {
+		if err := send(n); err != nil {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(nh.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case n, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			if err := send(n); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			w.Flush()
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/services"
+	"web/example/internal/signedurl"
+	"web/example/internal/storage"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PersonalDataHandler struct {
+	personalDataService *services.PersonalDataService
+}
+
+func NewPersonalDataHandler(personalDataService *services.PersonalDataService) *PersonalDataHandler {
+	return &PersonalDataHandler{
+		personalDataService: personalDataService,
+	}
+}
+
+// personalDataStatus maps data export and erasure errors to response codes
+func personalDataStatus(err error) int {
+	switch {
+	case errors.Is(err, sql.ErrNoRows), errors.Is(err, storage.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, services.ErrDataExportGone):
+		return http.StatusGone
+	case errors.Is(err, services.ErrWrongPassword), errors.Is(err, signedurl.ErrInvalidSignature), errors.Is(err, signedurl.ErrExpired):
+		return http.StatusForbidden
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func (ph *PersonalDataHandler) RequestExport(c *gin.Context) {
+	var req handlermodel.RequestDataExportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if export, err := ph.personalDataService.RequestExport(req.UserEmail); err != nil {
+		c.JSON(personalDataStatus(err), gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusAccepted, export)
+	}
+}
+
+func (ph *PersonalDataHandler) ReadExports(c *gin.Context) {
+	var req handlermodel.ReadDataExportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if exports, err := ph.personalDataService.ReadExports(req.UserEmail); err != nil {
+		c.JSON(personalDataStatus(err), gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusOK, exports)
+	}
+}
+
+func (ph *PersonalDataHandler) ReadExport(c *gin.Context) {
+	var uri handlermodel.DataExportUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.ReadDataExportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if export, err := ph.personalDataService.ReadExport(uri.Id, req.UserEmail); err != nil {
+		c.JSON(personalDataStatus(err), gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusOK, export)
+	}
+}
+
+// Download streams the archive once, it can't be resumed with range requests
+// since the download is used up as it starts
+func (ph *PersonalDataHandler) Download(c *gin.Context) {
+	var uri handlermodel.DataExportUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.DownloadDataExportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+
+	content, err := ph.personalDataService.Download(c.Request.Context(), uri.Id, req.Expires, req.Sig)
+	if err != nil {
+		c.JSON(personalDataStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	defer content.Close()
+
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", `attachment; filename="data-export-`+strconv.Itoa(uri.Id)+`.zip"`)
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+	io.Copy(c.Writer, content)
+}
+
+// Erase answers 202 with what the erasure will do and when, or 200 with the
+// preview of a dry run
+func (ph *PersonalDataHandler) Erase(c *gin.Context) {
+	var req handlermodel.EraseAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	plan, err := ph.personalDataService.EraseAccount(&req)
+	switch {
+	case err != nil:
+		c.JSON(personalDataStatus(err), gin.H{"error": err.Error()})
+	case req.DryRun:
+		c.JSON(http.StatusOK, plan)
+	default:
+		c.JSON(http.StatusAccepted, plan)
+	}
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PinHandler struct {
+	pinService *services.PinService
+}
+
+func NewPinHandler(pinService *services.PinService) *PinHandler {
+	return &PinHandler{
+		pinService: pinService,
+	}
+}
+
+// pinStatus maps pin and feature errors to response codes
+func pinStatus(err error) int {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	case errors.Is(err, services.ErrNotAdmin):
+		return http.StatusForbidden
+	case errors.Is(err, services.ErrPinLimit), errors.Is(err, services.ErrFeatureLimit):
+		return http.StatusConflict
+	case errors.Is(err, services.ErrNotListed):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func (ph *PinHandler) Pin(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.PinPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := ph.pinService.Pin(uri.Id, &req); err != nil {
+		c.JSON(pinStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+func (ph *PinHandler) Unpin(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.UnpinPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := ph.pinService.Unpin(uri.Id, &req); err != nil {
+		c.JSON(pinStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+func (ph *PinHandler) Feature(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.FeaturePostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := ph.pinService.Feature(uri.Id, &req); err != nil {
+		c.JSON(pinStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+func (ph *PinHandler) Unfeature(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.UnfeaturePostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := ph.pinService.Unfeature(uri.Id, &req); err != nil {
+		c.JSON(pinStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
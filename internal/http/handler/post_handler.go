@@ -2,9 +2,15 @@ package handler
 
 import (
 	"database/sql"
+	"errors"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"web/example/internal/domain"
 	handlermodel "web/example/internal/http/handler_model"
 	"web/example/internal/services"
+	"web/example/internal/views"
 
 	"github.com/gin-gonic/gin"
 )
@@ -13,21 +19,82 @@ type PostHandler struct {
 	postService *services.PostService
 }
 
-func NewPostHandler(db *sql.DB) *PostHandler {
+// NewPostHandler serves the posts of postService
+func NewPostHandler(postService *services.PostService) *PostHandler {
 	return &PostHandler{
-		postService: services.NewPostService(db),
+		postService: postService,
 	}
 }
 
+// postWriteStatus maps errors writing post content, content the filters
+// reject is unprocessable
+func postWriteStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrContentRejected):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, services.ErrPreconditionFailed):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, services.ErrPreconditionRequired):
+		return http.StatusPreconditionRequired
+	}
+	return http.StatusBadRequest
+}
+
+// etag is the entity tag of a post, its version and the language it was
+// served in (e.g. "3-pt"), translations are distinct representations
+func etag(post *domain.Post) string {
+	if post.Language == "" {
+		return `"` + strconv.Itoa(post.Version) + `"`
+	}
+	return `"` + strconv.Itoa(post.Version) + "-" + post.Language + `"`
+}
+
+// ifMatch reads the version named by If-Match, nil when the header is
+// missing. "*" matches any version and a tag that is no version matches none,
+// the language of the tag doesn't matter for writes.
+func ifMatch(c *gin.Context) *int {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" {
+		return nil
+	}
+
+	version := services.AnyVersion
+	if header != "*" {
+		tag := strings.Trim(strings.TrimPrefix(header, "W/"), `"`)
+		tag, _, _ = strings.Cut(tag, "-")
+		if n, err := strconv.Atoi(tag); err == nil && n > 0 {
+			version = n
+		} else {
+			version = -1
+		}
+	}
+
+	return &version
+}
+
+// writePostError responds to a failed write, a version mismatch sends the
+// current post so the client can merge and retry
+func (np *PostHandler) writePostError(c *gin.Context, err error, postId int, userEmail string) {
+	if errors.Is(err, services.ErrPreconditionFailed) {
+		if post, readErr := np.postService.ReadPost(postId, userEmail); readErr == nil {
+			c.Header("ETag", etag(post))
+			c.JSON(http.StatusPreconditionFailed, post)
+			return
+		}
+	}
+
+	c.JSON(postWriteStatus(err), gin.H{"error": err.Error()})
+}
+
 func (np *PostHandler) Create(c *gin.Context) {
 	var req handlermodel.CreatePostRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		bindError(c, err)
 		return
 	}
 
 	if err := np.postService.CreatePostService(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(postWriteStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 	c.Status(http.StatusAccepted)
@@ -36,11 +103,41 @@ func (np *PostHandler) Create(c *gin.Context) {
 func (np *PostHandler) Delete(c *gin.Context) {
 	var req handlermodel.DeletePostRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		bindError(c, err)
 		return
 	}
 
+	req.Version = ifMatch(c)
 	if err := np.postService.DeletePostService(&req); err != nil {
+		np.writePostError(c, err, req.Id, req.UserEmail)
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+func (np *PostHandler) ReadTrash(c *gin.Context) {
+	var req handlermodel.ReadTrashRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if posts, err := np.postService.ReadTrash(req.UserEmail); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, posts)
+	}
+}
+
+func (np *PostHandler) Restore(c *gin.Context) {
+	var req handlermodel.RestorePostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := np.postService.RestorePostService(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
@@ -50,37 +147,489 @@ func (np *PostHandler) Delete(c *gin.Context) {
 func (np *PostHandler) Update(c *gin.Context) {
 	var req handlermodel.UpdatePostRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		bindError(c, err)
 		return
 	}
 
+	req.Version = ifMatch(c)
 	if err := np.postService.UpdatePostService(&req); err != nil {
+		np.writePostError(c, err, req.Id, req.UserEmail)
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+func (np *PostHandler) Publish(c *gin.Context) {
+	np.changeStatus(c, domain.PostStatusPublished)
+}
+
+func (np *PostHandler) Unpublish(c *gin.Context) {
+	np.changeStatus(c, domain.PostStatusDraft)
+}
+
+func (np *PostHandler) Archive(c *gin.Context) {
+	np.changeStatus(c, domain.PostStatusArchived)
+}
+
+func (np *PostHandler) changeStatus(c *gin.Context, next domain.PostStatus) {
+	var req handlermodel.ChangePostStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := np.postService.ChangePostStatusService(&req, next); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+func (np *PostHandler) Schedule(c *gin.Context) {
+	var req handlermodel.SchedulePostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := np.postService.SchedulePostService(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 	c.Status(http.StatusAccepted)
 }
 
+func (np *PostHandler) ReadScheduled(c *gin.Context) {
+	var req handlermodel.ReadScheduledPostsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if posts, err := np.postService.ReadScheduledPosts(req.UserEmail); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, posts)
+	}
+}
+
+// Timeline pages through the published posts of followed users, newest first
+func (np *PostHandler) Timeline(c *gin.Context) {
+	var req handlermodel.ReadTimelineRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if page, err := np.postService.ReadTimeline(req.UserEmail, req.Cursor, req.Limit); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, page)
+	}
+}
+
+// localize picks the variants of posts for the lang query parameter, or
+// Accept-Language without it, and tells their languages in Content-Language
+func (np *PostHandler) localize(c *gin.Context, lang string, posts ...*domain.Post) error {
+	if lang == "" {
+		lang = c.GetHeader("Accept-Language")
+	}
+
+	if err := np.postService.Localize(lang, posts...); err != nil {
+		return err
+	}
+
+	var languages []string
+	for _, post := range posts {
+		if !slices.Contains(languages, post.Language) {
+			languages = append(languages, post.Language)
+		}
+	}
+
+	c.Header("Vary", "Accept-Language")
+	if len(languages) > 0 {
+		c.Header("Content-Language", strings.Join(languages, ", "))
+	}
+
+	return nil
+}
+
+// countView counts the read of post by the request's visitor
+func (np *PostHandler) countView(c *gin.Context, post *domain.Post, viewerEmail string) {
+	np.postService.CountView(post, viewerEmail, views.View{
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Referrer:  c.Request.Referer(),
+	})
+}
+
 func (np *PostHandler) Read(c *gin.Context) {
 	var req handlermodel.ReadPostRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		bindError(c, err)
+		return
+	}
+
+	if !identified(c, req.UserEmail) {
 		return
 	}
 
-	if post, err := np.postService.ReadPost(req.Id); err != nil {
+	if post, err := np.postService.ReadPost(req.Id, req.UserEmail); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
+	} else if err := np.localize(c, "", post); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
 	} else {
+		np.countView(c, post, req.UserEmail)
+		c.Header("ETag", etag(post))
 		c.JSON(http.StatusOK, post)
 	}
 }
 
+// ReadByRef reads a post by id or slug, old slugs redirect to the current one
+func (np *PostHandler) ReadByRef(c *gin.Context) {
+	var uri handlermodel.PostRefUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.ReadPostByRefRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if !identified(c, req.UserEmail) {
+		return
+	}
+
+	// slugs are never only digits, so a number is always an id
+	if id, err := strconv.Atoi(uri.Ref); err == nil {
+		if post, err := np.postService.ReadPost(id, req.UserEmail); err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		} else if err := np.localize(c, req.Lang, post); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		} else {
+			np.countView(c, post, req.UserEmail)
+			c.Header("ETag", etag(post))
+			c.JSON(http.StatusOK, post)
+		}
+		return
+	}
+
+	post, err := np.postService.ReadPostBySlug(uri.Ref, req.UserEmail)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if post.Slug != uri.Ref {
+		location := "/posts/" + post.Slug
+		if c.Request.URL.RawQuery != "" {
+			location += "?" + c.Request.URL.RawQuery
+		}
+		c.Redirect(http.StatusMovedPermanently, location)
+		return
+	}
+
+	if err := np.localize(c, req.Lang, post); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	np.countView(c, post, req.UserEmail)
+	c.Header("ETag", etag(post))
+	c.JSON(http.StatusOK, post)
+}
+
+func (np *PostHandler) UpdateById(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var body handlermodel.UpdatePostBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	req := handlermodel.UpdatePostRequest{
+		Id:               uri.Id,
+		UserEmail:        body.UserEmail,
+		NewTitle:         body.NewTitle,
+		NewContent:       body.NewContent,
+		NewContentFormat: body.NewContentFormat,
+		NewVisibility:    body.NewVisibility,
+		NewTags:          body.NewTags,
+		Version:          ifMatch(c),
+	}
+	if err := np.postService.UpdatePostService(&req); err != nil {
+		np.writePostError(c, err, req.Id, req.UserEmail)
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+func (np *PostHandler) DeleteById(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var body handlermodel.DeletePostBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	req := handlermodel.DeletePostRequest{Id: uri.Id, UserEmail: body.UserEmail}
+	req.Version = ifMatch(c)
+	if err := np.postService.DeletePostService(&req); err != nil {
+		np.writePostError(c, err, req.Id, req.UserEmail)
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
 func (np *PostHandler) ReadAll(c *gin.Context) {
-	if posts, err := np.postService.ReadAllPosts(); err != nil {
+	var req handlermodel.ReadAllPostsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if !identified(c, req.UserEmail) {
+		return
+	}
+
+	var page *domain.Page[domain.Post]
+	var err error
+	if req.Author != "" {
+		page, err = np.postService.ReadAuthorPosts(req.Author, req.UserEmail, req.Cursor, req.Limit)
+	} else {
+		page, err = np.postService.ReadAllPosts(req.UserEmail, req.Cursor, req.Limit)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	localized := make([]*domain.Post, len(page.Items))
+	for i := range page.Items {
+		localized[i] = &page.Items[i]
+	}
+
+	if err := np.localize(c, req.Lang, localized...); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// ReadAllLegacy serves the deprecated GET /post/all, it walks the pages of the
+// listing and returns every listed post as a bare array, like before the
+// listing was paginated
+func (np *PostHandler) ReadAllLegacy(c *gin.Context) {
+	var req handlermodel.ReadAllPostsLegacyRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if !identified(c, req.UserEmail) {
+		return
+	}
+
+	posts := []domain.Post{}
+
+	cursor := ""
+	for {
+		page, err := np.postService.ReadAllPosts(req.UserEmail, cursor, services.MaxPageSize)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		posts = append(posts, page.Items...)
+
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	localized := make([]*domain.Post, len(posts))
+	for i := range posts {
+		localized[i] = &posts[i]
+	}
+
+	if err := np.localize(c, req.Lang, localized...); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, posts)
+}
+
+// ReadMentions pages through the published posts mentioning a user
+func (np *PostHandler) ReadMentions(c *gin.Context) {
+	var uri handlermodel.UsernameUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.ReadMentionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	page, err := np.postService.ReadMentions(uri.Username, req.Cursor, req.Limit)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+func (np *PostHandler) ReadRevisions(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.ReadPostRevisionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if !identified(c, req.UserEmail) {
+		return
+	}
+
+	if revisions, err := np.postService.ReadRevisions(uri.Id, req.UserEmail); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	} else {
-		c.JSON(http.StatusOK, posts)
+		c.JSON(http.StatusOK, revisions)
+	}
+}
+
+func (np *PostHandler) DiffRevisions(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.DiffPostRevisionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if !identified(c, req.UserEmail) {
+		return
+	}
+
+	if result, err := np.postService.DiffRevisions(uri.Id, &req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, result)
+	}
+}
+
+func (np *PostHandler) RestoreRevision(c *gin.Context) {
+	var uri handlermodel.PostRevisionUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.RestorePostRevisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := np.postService.RestoreRevisionService(uri.Id, uri.Revision, &req); err != nil {
+		c.JSON(postWriteStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+// translationStatus maps errors writing translations to response codes
+func translationStatus(err error) int {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	case errors.Is(err, services.ErrOriginalLanguage):
+		return http.StatusConflict
+	}
+	return postWriteStatus(err)
+}
+
+// SaveTranslation adds or replaces the translation of the post in the
+// language of the path
+func (np *PostHandler) SaveTranslation(c *gin.Context) {
+	var uri handlermodel.PostTranslationUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.SavePostTranslationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
 	}
+
+	if err := np.postService.SaveTranslation(uri.Id, uri.Lang, &req); err != nil {
+		c.JSON(translationStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+// DeleteTranslation removes the translation of the post in the language of
+// the path
+func (np *PostHandler) DeleteTranslation(c *gin.Context) {
+	var uri handlermodel.PostTranslationUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.DeletePostTranslationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := np.postService.DeleteTranslation(uri.Id, uri.Lang, &req); err != nil {
+		c.JSON(translationStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusAccepted)
 }
@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SeriesHandler struct {
+	seriesService *services.SeriesService
+}
+
+func NewSeriesHandler(db *sql.DB) *SeriesHandler {
+	return &SeriesHandler{
+		seriesService: services.NewSeriesService(db),
+	}
+}
+
+// seriesStatus maps series errors to response codes
+func seriesStatus(err error) int {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	case errors.Is(err, services.ErrPostInSeries):
+		return http.StatusConflict
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func (sh *SeriesHandler) Create(c *gin.Context) {
+	var req handlermodel.CreateSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if series, err := sh.seriesService.CreateSeries(&req); err != nil {
+		c.JSON(seriesStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusCreated, series)
+	}
+}
+
+func (sh *SeriesHandler) ReadAll(c *gin.Context) {
+	var req handlermodel.ReadUserSeriesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if !identified(c, req.UserEmail) {
+		return
+	}
+
+	if series, err := sh.seriesService.ReadUserSeries(req.Email, req.UserEmail); err != nil {
+		c.JSON(seriesStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, series)
+	}
+}
+
+func (sh *SeriesHandler) Read(c *gin.Context) {
+	var uri handlermodel.SeriesUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.ReadSeriesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if !identified(c, req.UserEmail) {
+		return
+	}
+
+	if series, err := sh.seriesService.ReadSeries(uri.Id, req.UserEmail); err != nil {
+		c.JSON(seriesStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, series)
+	}
+}
+
+func (sh *SeriesHandler) Update(c *gin.Context) {
+	var uri handlermodel.SeriesUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.UpdateSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if series, err := sh.seriesService.UpdateSeries(uri.Id, &req); err != nil {
+		c.JSON(seriesStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, series)
+	}
+}
+
+func (sh *SeriesHandler) Delete(c *gin.Context) {
+	var uri handlermodel.SeriesUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.DeleteSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := sh.seriesService.DeleteSeries(uri.Id, req.UserEmail); err != nil {
+		c.JSON(seriesStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (sh *SeriesHandler) AddPost(c *gin.Context) {
+	var uri handlermodel.SeriesUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.AddSeriesPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := sh.seriesService.AddPost(uri.Id, &req); err != nil {
+		c.JSON(seriesStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (sh *SeriesHandler) MovePost(c *gin.Context) {
+	var uri handlermodel.SeriesPostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.MoveSeriesPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := sh.seriesService.MovePost(uri.Id, uri.PostId, &req); err != nil {
+		c.JSON(seriesStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (sh *SeriesHandler) Reorder(c *gin.Context) {
+	var uri handlermodel.SeriesUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.ReorderSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if series, err := sh.seriesService.Reorder(uri.Id, &req); err != nil {
+		c.JSON(seriesStatus(err), gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, series)
+	}
+}
+
+func (sh *SeriesHandler) RemovePost(c *gin.Context) {
+	var uri handlermodel.SeriesPostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.DeleteSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := sh.seriesService.RemovePost(uri.Id, uri.PostId, req.UserEmail); err != nil {
+		c.JSON(seriesStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StatsHandler struct {
+	statsService *services.StatsService
+}
+
+// NewStatsHandler serves the stats of statsService
+func NewStatsHandler(statsService *services.StatsService) *StatsHandler {
+	return &StatsHandler{
+		statsService: statsService,
+	}
+}
+
+func (sh *StatsHandler) Read(c *gin.Context) {
+	var uri handlermodel.PostUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	var req handlermodel.ReadPostStatsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if stats, err := sh.statsService.ReadStats(uri.Id, req.UserEmail, req.Days); err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, sql.ErrNoRows) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	} else {
+		c.JSON(http.StatusOK, stats)
+	}
+}
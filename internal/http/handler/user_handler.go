@@ -24,7 +24,7 @@ func NewUserHandler(db *sql.DB) *UserHandler {
 func (uh *UserHandler) Create(c *gin.Context) {
 	var req hm.CreateUserRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		bindError(c, err)
 		return
 	}
 
@@ -40,7 +40,7 @@ func (uh *UserHandler) Create(c *gin.Context) {
 func (uh *UserHandler) Delete(c *gin.Context) {
 	var req hm.DeleteUserRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		bindError(c, err)
 		return
 	}
 
@@ -54,10 +54,25 @@ func (uh *UserHandler) Delete(c *gin.Context) {
 	c.Status(http.StatusAccepted)
 }
 
+func (uh *UserHandler) CancelDeletion(c *gin.Context) {
+	var req hm.CancelUserDeletionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bindError(c, err)
+		return
+	}
+
+	if err := uh.userService.CancelDeletionService(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
 func (uh *UserHandler) Login(c *gin.Context) {
 	var req hm.LoginUserRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		bindError(c, err)
 		return
 	}
 
@@ -73,7 +88,7 @@ func (uh *UserHandler) Login(c *gin.Context) {
 func (uh *UserHandler) Get(c *gin.Context) {
 	var req hm.GetUserRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		bindError(c, err)
 		return
 	}
 
@@ -89,7 +104,7 @@ func (uh *UserHandler) Get(c *gin.Context) {
 func (uh *UserHandler) ChangeUsername(c *gin.Context) {
 	var req hm.ChangeUsernameRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		bindError(c, err)
 		return
 	}
 
@@ -0,0 +1,36 @@
+package handlermodel
+
+import "mime/multipart"
+
+// Multipart upload of a file to a post
+type UploadAttachmentRequest struct {
+	UserEmail string                `form:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	File      *multipart.FileHeader `form:"file" binding:"required"`
+}
+
+// List the attachments of a post
+type ReadAttachmentsRequest struct {
+	UserEmail string `form:"userEmail"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Post id and attachment id taken from the route path
+type AttachmentUri struct {
+	Id           int `uri:"id" binding:"required"`
+	AttachmentId int `uri:"attachmentId" binding:"required"`
+}
+
+// Delete an attachment of a post
+type DeleteAttachmentBody struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Signature of a download URL, as handed out in the attachment listing
+type DownloadAttachmentRequest struct {
+	Expires string `form:"expires" binding:"required"`
+	Sig     string `form:"sig" binding:"required"`
+}
+
+// Attachment id taken from the download route path
+type AttachmentIdUri struct {
+	Id int `uri:"id" binding:"required"`
+}
@@ -0,0 +1,30 @@
+package handlermodel
+
+// Authors of a post with pending invites, authors only
+type ReadPostAuthorsRequest struct {
+	UserEmail string `form:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Invite a user as editor of an owned post
+type InvitePostAuthorRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Email     string `json:"email" binding:"required,email"`
+}
+
+// Accept an invite to a post
+type AcceptPostAuthorRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Remove an editor or withdraw an invite, Email is the user to remove which
+// may be the caller leaving the post
+type RemovePostAuthorRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Email     string `json:"email" binding:"required,email"`
+}
+
+// Transfer an owned post to one of its editors
+type TransferPostRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Email     string `json:"email" binding:"required,email"`
+}
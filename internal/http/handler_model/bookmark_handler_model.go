@@ -0,0 +1,71 @@
+package handlermodel
+
+// Bookmark or unbookmark a post, post id taken from the route path
+type BookmarkPostRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Note      string `json:"note" binding:"max=1000,nocontrol" clean:"nfc"`
+}
+
+// Own bookmarks, newest first
+type ReadBookmarksRequest struct {
+	UserEmail string `form:"userEmail" binding:"required"`    // We use this as mock to get the user ID since our token does not hold claims
+	Cursor    string `form:"cursor"`                          // nextCursor of the previous page
+	Limit     int    `form:"limit" binding:"omitempty,min=1"` // Defaults to 20, capped at 50
+}
+
+// Reading list id taken from the route path
+type ReadingListUri struct {
+	Id int `uri:"id" binding:"required"`
+}
+
+// Reading list item taken from the route path
+type ReadingListItemUri struct {
+	Id     int `uri:"id" binding:"required"`
+	PostId int `uri:"postId" binding:"required"`
+}
+
+// Public reading list token taken from the route path
+type SharedReadingListUri struct {
+	Token string `uri:"token" binding:"required"`
+}
+
+// Own reading lists, or one of them with its items
+type ReadReadingListsRequest struct {
+	UserEmail string `form:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Create a reading list, public lists can be read by anyone with their share URL
+type CreateReadingListRequest struct {
+	UserEmail   string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Name        string `json:"name" binding:"required,max=100,singleline" clean:"trim"`
+	Description string `json:"description" binding:"max=1000,nocontrol" clean:"nfc"`
+	Public      bool   `json:"public"`
+}
+
+// Change a reading list, fields left out are kept
+type UpdateReadingListRequest struct {
+	UserEmail   string  `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Name        *string `json:"name" binding:"omitempty,min=1,max=100,singleline" clean:"trim"`
+	Description *string `json:"description" binding:"omitempty,max=1000,nocontrol" clean:"nfc"`
+	Public      *bool   `json:"public"` // Sharing again after unsharing makes a new share URL
+}
+
+// Delete a reading list or remove an item from it
+type DeleteReadingListRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Add a post to a reading list
+type AddReadingListItemRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	PostId    int    `json:"postId" binding:"required"`
+	Note      string `json:"note" binding:"max=1000,nocontrol" clean:"nfc"`
+	Position  int    `json:"position" binding:"min=0"` // 1 based, 0 appends
+}
+
+// Move an item of a reading list or change its note, fields left out are kept
+type UpdateReadingListItemRequest struct {
+	UserEmail string  `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Position  *int    `json:"position" binding:"omitempty,min=1"`
+	Note      *string `json:"note" binding:"omitempty,max=1000,nocontrol" clean:"nfc"`
+}
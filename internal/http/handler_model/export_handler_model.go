@@ -0,0 +1,16 @@
+package handlermodel
+
+import "mime/multipart"
+
+// Export of the user's posts and series
+type ExportRequest struct {
+	UserEmail string `form:"userEmail" binding:"required"`               // We use this as mock to get the user ID since our token does not hold claims
+	Format    string `form:"format" binding:"omitempty,oneof=jsonl zip"` // Defaults to jsonl
+}
+
+// Multipart upload of an export, in either format
+type ImportRequest struct {
+	UserEmail string                `form:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	File      *multipart.FileHeader `form:"file" binding:"required"`
+	DryRun    bool                  `form:"dryRun"` // Only report what would be imported
+}
@@ -0,0 +1,9 @@
+package handlermodel
+
+// Feed of all authors, or of one when Author is set, narrowed to a tag when
+// Tag is set
+type ReadFeedRequest struct {
+	Author string `form:"author"`                                  // Email of the author
+	Tag    string `form:"tag" binding:"omitempty,tag" clean:"tag"` // Only posts filed under it
+	Limit  int    `form:"limit" binding:"omitempty,min=1"`         // Defaults to 20, capped at 50
+}
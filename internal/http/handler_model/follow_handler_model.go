@@ -0,0 +1,26 @@
+package handlermodel
+
+// Follow, unfollow, block or unblock another user
+type FollowUserRequest struct {
+	UserEmail   string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	TargetEmail string `json:"targetEmail" binding:"required"`
+}
+
+// Followers or following of a user
+type ReadFollowsRequest struct {
+	Email  string `form:"email" binding:"required"`
+	Cursor string `form:"cursor"`                          // nextCursor of the previous page
+	Limit  int    `form:"limit" binding:"omitempty,min=1"` // Defaults to 20, capped at 50
+}
+
+// Follower and following counts of a user
+type ReadFollowCountsRequest struct {
+	Email string `form:"email" binding:"required"`
+}
+
+// Home timeline of the posts of followed users
+type ReadTimelineRequest struct {
+	UserEmail string `form:"userEmail" binding:"required"`    // We use this as mock to get the user ID since our token does not hold claims
+	Cursor    string `form:"cursor"`                          // nextCursor of the previous page
+	Limit     int    `form:"limit" binding:"omitempty,min=1"` // Defaults to 20, capped at 50
+}
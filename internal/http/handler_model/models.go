@@ -0,0 +1,104 @@
+package handlermodel
+
+// Models lists every request model, the server checks their tags on startup
+var Models = []any{
+	UploadAttachmentRequest{},
+	ReadAttachmentsRequest{},
+	AttachmentUri{},
+	DeleteAttachmentBody{},
+	DownloadAttachmentRequest{},
+	AttachmentIdUri{},
+
+	ReadPostAuthorsRequest{},
+	InvitePostAuthorRequest{},
+	AcceptPostAuthorRequest{},
+	RemovePostAuthorRequest{},
+	TransferPostRequest{},
+
+	BookmarkPostRequest{},
+	ReadBookmarksRequest{},
+	ReadingListUri{},
+	ReadingListItemUri{},
+	SharedReadingListUri{},
+	ReadReadingListsRequest{},
+	CreateReadingListRequest{},
+	UpdateReadingListRequest{},
+	DeleteReadingListRequest{},
+	AddReadingListItemRequest{},
+	UpdateReadingListItemRequest{},
+
+	ExportRequest{},
+	ImportRequest{},
+
+	ReadFeedRequest{},
+
+	FollowUserRequest{},
+	ReadFollowsRequest{},
+	ReadFollowCountsRequest{},
+	ReadTimelineRequest{},
+
+	ReportPostRequest{},
+	ModerationQueueRequest{},
+	ModerationDetailRequest{},
+	ModeratePostRequest{},
+
+	ReadNotificationsRequest{},
+	NotificationUri{},
+	MarkNotificationsReadRequest{},
+	NotificationStreamRequest{},
+
+	RequestDataExportRequest{},
+	ReadDataExportsRequest{},
+	DataExportUri{},
+	DownloadDataExportRequest{},
+	EraseAccountRequest{},
+
+	CreatePostRequest{},
+	UpdatePostRequest{},
+	ReadPostRequest{},
+	ReadAllPostsRequest{},
+	ReadAllPostsLegacyRequest{},
+	ChangePostStatusRequest{},
+	DeletePostRequest{},
+	ReadTrashRequest{},
+	RestorePostRequest{},
+	SchedulePostRequest{},
+	ReadScheduledPostsRequest{},
+	PostUri{},
+	PostRefUri{},
+	ReadPostByRefRequest{},
+	UpdatePostBody{},
+	DeletePostBody{},
+	PostRevisionUri{},
+	ReadPostRevisionsRequest{},
+	DiffPostRevisionsRequest{},
+	RestorePostRevisionRequest{},
+	UsernameUri{},
+	ReadMentionsRequest{},
+	ReadPostStatsRequest{},
+	PostTranslationUri{},
+	SavePostTranslationRequest{},
+	DeletePostTranslationRequest{},
+	PinPostRequest{},
+	UnpinPostRequest{},
+	FeaturePostRequest{},
+	UnfeaturePostRequest{},
+
+	SeriesUri{},
+	SeriesPostUri{},
+	ReadUserSeriesRequest{},
+	ReadSeriesRequest{},
+	CreateSeriesRequest{},
+	UpdateSeriesRequest{},
+	DeleteSeriesRequest{},
+	AddSeriesPostRequest{},
+	MoveSeriesPostRequest{},
+	ReorderSeriesRequest{},
+
+	CreateUserRequest{},
+	DeleteUserRequest{},
+	LoginUserRequest{},
+	GetUserRequest{},
+	ChangeUsernameRequest{},
+	CancelUserDeletionRequest{},
+}
@@ -0,0 +1,29 @@
+package handlermodel
+
+// Report a post, a user reports a post once
+type ReportPostRequest struct {
+	UserEmail string  `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Reason    string  `json:"reason" binding:"required,oneof=spam harassment hate violence sexual misinformation other"`
+	Details   *string `json:"details" binding:"omitempty,max=500,nocontrol" clean:"nfc"`
+}
+
+// Moderation queue of reported posts
+type ModerationQueueRequest struct {
+	UserEmail string `form:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Reason    string `form:"reason" binding:"omitempty,oneof=spam harassment hate violence sexual misinformation other"`
+	Hidden    *bool  `form:"hidden"`                          // true for posts hidden pending review, false for visible ones
+	Flagged   bool   `form:"flagged"`                         // Only posts held by the content filters
+	Cursor    string `form:"cursor"`                          // nextCursor of the previous page
+	Limit     int    `form:"limit" binding:"omitempty,min=1"` // Defaults to 20, capped at 50
+}
+
+// Reports, filter flags and moderation log of a post
+type ModerationDetailRequest struct {
+	UserEmail string `form:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Hide, restore or dismiss the reports of a post
+type ModeratePostRequest struct {
+	UserEmail string  `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Note      *string `json:"note" binding:"omitempty,max=500,nocontrol" clean:"nfc"`
+}
@@ -0,0 +1,25 @@
+package handlermodel
+
+// List own notifications
+type ReadNotificationsRequest struct {
+	UserEmail string `form:"userEmail" binding:"required"`    // We use this as mock to get the user ID since our token does not hold claims
+	Unread    bool   `form:"unread"`                          // Only unread notifications
+	Cursor    string `form:"cursor"`                          // nextCursor of the previous page
+	Limit     int    `form:"limit" binding:"omitempty,min=1"` // Defaults to 20, capped at 50
+}
+
+// Notification id taken from the route path
+type NotificationUri struct {
+	Id int `uri:"id" binding:"required"`
+}
+
+// Mark one or all own notifications as read
+type MarkNotificationsReadRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Live stream of own notifications
+type NotificationStreamRequest struct {
+	UserEmail   string `form:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	LastEventId int    `form:"lastEventId"`                  // Alternative to the Last-Event-ID header
+}
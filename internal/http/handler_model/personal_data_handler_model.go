@@ -0,0 +1,29 @@
+package handlermodel
+
+// Request an export of everything stored about the user
+type RequestDataExportRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// List or read the user's data exports
+type ReadDataExportsRequest struct {
+	UserEmail string `form:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Data export id taken from the route path
+type DataExportUri struct {
+	Id int `uri:"id" binding:"required"`
+}
+
+// Signature of a data export download URL
+type DownloadDataExportRequest struct {
+	Expires string `form:"expires" binding:"required"`
+	Sig     string `form:"sig" binding:"required"`
+}
+
+// Erase the account, confirmed by the password
+type EraseAccountRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+	DryRun   bool   `json:"dryRun"` // Only return what the erasure would do
+}
@@ -1,27 +1,196 @@
 package handlermodel
 
+import "time"
+
 // Create new post
 type CreatePostRequest struct {
-	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
-	Title     string `json:"title" binding:"required"`
-	Content   string `json:"content" binding:"required"`
+	UserEmail     string   `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Title         string   `json:"title" binding:"required,post_title" clean:"trim"`
+	Content       string   `json:"content" binding:"required,post_content" clean:"nfc"`
+	Status        string   `json:"status" binding:"omitempty,oneof=draft published"`                       // Defaults to published
+	ContentFormat string   `json:"contentFormat" binding:"omitempty,oneof=plain markdown"`                 // Defaults to plain
+	Visibility    string   `json:"visibility" binding:"omitempty,oneof=public unlisted followers private"` // Defaults to public
+	Language      string   `json:"language" binding:"omitempty,bcp47_language_tag"`                        // Of the original, defaults to en
+	Tags          []string `json:"tags" binding:"post_tags" clean:"tags"`
 }
 
 // Update the post
 type UpdatePostRequest struct {
-	Id         int    `json:"id" binding:"required"`
-	UserEmail  string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
-	NewTitle   string `json:"newTitle" binding:"required"`
-	NewContent string `json:"newContent" binding:"required"`
+	Id               int      `json:"id" binding:"required"`
+	UserEmail        string   `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	NewTitle         string   `json:"newTitle" binding:"required,post_title" clean:"trim"`
+	NewContent       string   `json:"newContent" binding:"required,post_content" clean:"nfc"`
+	NewContentFormat string   `json:"newContentFormat" binding:"omitempty,oneof=plain markdown"`                 // Keeps the current format when empty
+	NewVisibility    string   `json:"newVisibility" binding:"omitempty,oneof=public unlisted followers private"` // Keeps the current visibility when empty
+	NewTags          []string `json:"newTags" binding:"post_tags" clean:"tags"`                                  // Keeps the current tags when absent, [] clears them
+	Version          *int     `json:"-"`                                                                         // From If-Match, nil when not sent
 }
 
-// Read the post (all posts are public no need email/claim)
+// Read the post (published posts are public, email is only needed to read own drafts)
 type ReadPostRequest struct {
-	Id int `json:"id" binding:"required"`
+	Id        int    `json:"id" binding:"required"`
+	UserEmail string `json:"userEmail"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Read all posts, sent as query since the listing has no body
+type ReadAllPostsRequest struct {
+	UserEmail string `form:"userEmail"`                        // We use this as mock to get the user ID since our token does not hold claims
+	Lang      string `form:"lang"`                             // Accept-Language value, takes precedence over the header
+	Author    string `form:"author" binding:"omitempty,email"` // Only the posts of this author, pinned first
+	Cursor    string `form:"cursor"`                           // nextCursor of the previous page
+	Limit     int    `form:"limit" binding:"omitempty,min=1"`  // Defaults to 20, capped at 50
+}
+
+// Read every listed post through the deprecated GET /post/all
+type ReadAllPostsLegacyRequest struct {
+	UserEmail string `form:"userEmail"` // We use this as mock to get the user ID since our token does not hold claims
+	Lang      string `form:"lang"`      // Accept-Language value, takes precedence over the header
+}
+
+// Publish, unpublish or archive the post
+type ChangePostStatusRequest struct {
+	Id        int    `json:"id" binding:"required"`
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
 }
 
 // Delete the post
 type DeletePostRequest struct {
 	Id        int    `json:"id" binding:"required"`
 	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Version   *int   `json:"-"`                            // From If-Match, nil when not sent
+}
+
+// List own deleted posts
+type ReadTrashRequest struct {
+	UserEmail string `form:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Restore a deleted post from the trash
+type RestorePostRequest struct {
+	Id        int    `json:"id" binding:"required"`
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Schedule a draft to be published later
+type SchedulePostRequest struct {
+	Id        int       `json:"id" binding:"required"`
+	UserEmail string    `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	PublishAt time.Time `json:"publishAt" binding:"required"` // RFC 3339
+}
+
+// List own scheduled posts
+type ReadScheduledPostsRequest struct {
+	UserEmail string `form:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Post id taken from the route path
+type PostUri struct {
+	Id int `uri:"id" binding:"required"`
+}
+
+// Post id or slug taken from the route path
+type PostRefUri struct {
+	Ref string `uri:"id" binding:"required"`
+}
+
+// Read a post by id or slug
+type ReadPostByRefRequest struct {
+	UserEmail string `form:"userEmail"` // We use this as mock to get the user ID since our token does not hold claims
+	Lang      string `form:"lang"`      // Accept-Language value, takes precedence over the header
+}
+
+// Update the post, id taken from the route path
+type UpdatePostBody struct {
+	UserEmail        string   `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	NewTitle         string   `json:"newTitle" binding:"required,post_title" clean:"trim"`
+	NewContent       string   `json:"newContent" binding:"required,post_content" clean:"nfc"`
+	NewContentFormat string   `json:"newContentFormat" binding:"omitempty,oneof=plain markdown"`                 // Keeps the current format when empty
+	NewVisibility    string   `json:"newVisibility" binding:"omitempty,oneof=public unlisted followers private"` // Keeps the current visibility when empty
+	NewTags          []string `json:"newTags" binding:"post_tags" clean:"tags"`                                  // Keeps the current tags when absent, [] clears them
+}
+
+// Delete the post, id taken from the route path
+type DeletePostBody struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Post id and revision number taken from the route path
+type PostRevisionUri struct {
+	Id       int `uri:"id" binding:"required"`
+	Revision int `uri:"revision" binding:"required"`
+}
+
+// List the revisions of a post
+type ReadPostRevisionsRequest struct {
+	UserEmail string `form:"userEmail"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Diff two revisions of a post
+type DiffPostRevisionsRequest struct {
+	From      int    `form:"from" binding:"required"`
+	To        int    `form:"to" binding:"required"`
+	Mode      string `form:"mode" binding:"omitempty,oneof=unified word"` // Defaults to unified
+	UserEmail string `form:"userEmail"`                                   // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Restore an older revision of the post
+type RestorePostRevisionRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Username taken from the route path
+type UsernameUri struct {
+	Username string `uri:"username" binding:"required"`
+}
+
+// List the posts mentioning a user
+type ReadMentionsRequest struct {
+	Cursor string `form:"cursor"`                          // nextCursor of the previous page
+	Limit  int    `form:"limit" binding:"omitempty,min=1"` // Defaults to 20, capped at 50
+}
+
+// View stats of an owned post
+type ReadPostStatsRequest struct {
+	UserEmail string `form:"userEmail" binding:"required"`           // We use this as mock to get the user ID since our token does not hold claims
+	Days      int    `form:"days" binding:"omitempty,min=1,max=365"` // Defaults to 30, today included
+}
+
+// Post id and translation language taken from the route path
+type PostTranslationUri struct {
+	Id   int    `uri:"id" binding:"required"`
+	Lang string `uri:"lang" binding:"required,bcp47_language_tag"`
+}
+
+// Add or replace a translation of the post
+type SavePostTranslationRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Title     string `json:"title" binding:"required,post_title" clean:"trim"`
+	Content   string `json:"content" binding:"required,post_content" clean:"nfc"`
+}
+
+// Remove a translation of the post
+type DeletePostTranslationRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Pin an owned post to the profile, pinning a pinned post moves it
+type PinPostRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"`       // We use this as mock to get the user ID since our token does not hold claims
+	Position  int    `json:"position" binding:"omitempty,min=1"` // 1 based, the end when unset
+}
+
+// Unpin an owned post
+type UnpinPostRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Feature a post on the global listing, featuring a featured post moves it
+type FeaturePostRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"`       // We use this as mock to get the user ID since our token does not hold claims
+	Position  int    `json:"position" binding:"omitempty,min=1"` // 1 based, the end when unset
+}
+
+// Unfeature a post
+type UnfeaturePostRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
 }
@@ -0,0 +1,61 @@
+package handlermodel
+
+// Series id taken from the route path
+type SeriesUri struct {
+	Id int `uri:"id" binding:"required"`
+}
+
+// Part of a series taken from the route path
+type SeriesPostUri struct {
+	Id     int `uri:"id" binding:"required"`
+	PostId int `uri:"postId" binding:"required"`
+}
+
+// Series of an author
+type ReadUserSeriesRequest struct {
+	Email     string `form:"email" binding:"required"`
+	UserEmail string `form:"userEmail"` // Optional, the reader, followers-only and own draft parts are listed for them
+}
+
+// A single series
+type ReadSeriesRequest struct {
+	UserEmail string `form:"userEmail"` // Optional, the reader, followers-only and own draft parts are listed for them
+}
+
+// Create an empty series
+type CreateSeriesRequest struct {
+	UserEmail   string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Title       string `json:"title" binding:"required,max=255,singleline" clean:"trim"`
+	Description string `json:"description" binding:"max=1000,nocontrol" clean:"nfc"`
+}
+
+// Change a series, fields left out are kept
+type UpdateSeriesRequest struct {
+	UserEmail   string  `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Title       *string `json:"title" binding:"omitempty,min=1,max=255,singleline" clean:"trim"`
+	Description *string `json:"description" binding:"omitempty,max=1000,nocontrol" clean:"nfc"`
+}
+
+// Delete a series or remove a post from it
+type DeleteSeriesRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+}
+
+// Add an own post to a series
+type AddSeriesPostRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	PostId    int    `json:"postId" binding:"required"`
+	Position  int    `json:"position" binding:"min=0"` // 1 based, 0 appends
+}
+
+// Move a part of a series
+type MoveSeriesPostRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	Position  int    `json:"position" binding:"required,min=1"`
+}
+
+// Reorder a series, the posts named come first in this order and the rest follow
+type ReorderSeriesRequest struct {
+	UserEmail string `json:"userEmail" binding:"required"` // We use this as mock to get the user ID since our token does not hold claims
+	PostIds   []int  `json:"postIds" binding:"required,min=1"`
+}
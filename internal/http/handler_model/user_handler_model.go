@@ -2,29 +2,35 @@ package handlermodel
 
 // Create new user model
 type CreateUserRequest struct {
-	Username string `json:"username" binding:"required"`
-	Email    string `json:"email" binding:"required"`
-	Password string `json:"password" binding:"required"`
+	Username string `json:"username" binding:"required,username" clean:"trim"`
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,password"` // Policy only checked for new passwords
 }
 
 // Delete user model
 type DeleteUserRequest struct {
-	Email string `json:"email" binging:"required"`
+	Email string `json:"email" binding:"required"`
 }
 
 // Login user model
 type LoginUserRequest struct {
-	Email    string `json:"email" binging:"required"`
-	Password string `json:"password" binging:"required"`
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
 }
 
 // Login user model
 type GetUserRequest struct {
-	Email string `json:"email" binging:"required"`
+	Email string `json:"email" binding:"required"`
 }
 
 // new username
 type ChangeUsernameRequest struct {
-	Email       string `json:"email" binging:"required"`
-	NewUsername string `json:"newUsername" binging:"required"`
+	Email       string `json:"email" binding:"required"`
+	NewUsername string `json:"newUsername" binding:"required,username" clean:"trim"`
+}
+
+// Cancel a pending account deletion
+type CancelUserDeletionRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
 }
@@ -9,14 +9,13 @@ import (
 
 const MockValidJWT = "MOCK_VALID_JWT"
 
+// authenticatedKey marks requests that carried a valid token
+const authenticatedKey = "authenticated"
+
 // RequireBearer checks Authorization: Bearer <token> and compares against expected.
 func RequireBearer(expected string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		auth := c.GetHeader("Authorization")
-		parts := strings.SplitN(auth, " ", 2)
-		if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") || strings.TrimSpace(parts[1]) != expected {
-			c.Header("WWW-Authenticate", `Bearer realm="api", charset="UTF-8"`)
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		if !checkBearer(c, expected) {
 			return
 		}
 		c.Next()
@@ -26,3 +25,36 @@ func RequireBearer(expected string) gin.HandlerFunc {
 func RequireMockToken() gin.HandlerFunc {
 	return RequireBearer(MockValidJWT)
 }
+
+// OptionalBearer lets requests without Authorization through as anonymous,
+// a token that is sent must still match expected
+func OptionalBearer(expected string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") != "" && !checkBearer(c, expected) {
+			return
+		}
+		c.Next()
+	}
+}
+
+func OptionalMockToken() gin.HandlerFunc {
+	return OptionalBearer(MockValidJWT)
+}
+
+// Authenticated tells whether the request carried a valid token
+func Authenticated(c *gin.Context) bool {
+	return c.GetBool(authenticatedKey)
+}
+
+// checkBearer marks the request authenticated, or aborts it with 401
+func checkBearer(c *gin.Context, expected string) bool {
+	auth := c.GetHeader("Authorization")
+	parts := strings.SplitN(auth, " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") || strings.TrimSpace(parts[1]) != expected {
+		c.Header("WWW-Authenticate", `Bearer realm="api", charset="UTF-8"`)
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return false
+	}
+	c.Set(authenticatedKey, true)
+	return true
+}
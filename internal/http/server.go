@@ -4,16 +4,42 @@ import (
 	"database/sql"
 	"net/http"
 	"web/example/internal/http/handler"
+	handlermodel "web/example/internal/http/handler_model"
 	"web/example/internal/http/middleware"
+	"web/example/internal/http/validation"
+	"web/example/internal/services"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"go.uber.org/zap"
 )
 
-func StartServer(db_connection *sql.DB) {
+func StartServer(db_connection *sql.DB, post_service *services.PostService, attachment_service *services.AttachmentService, notification_service *services.NotificationService, moderation_service *services.ModerationService, stats_service *services.StatsService, personal_data_service *services.PersonalDataService, pin_service *services.PinService, export_service *services.ExportService) {
+	// cleans text fields before the binding tags are checked, JSON bodies
+	// with unknown fields or trailing data are refused
+	validator := validation.New()
+	if err := validator.Check(handlermodel.Models...); err != nil {
+		zap.S().Fatalln("Invalid request model tags: ", err.Error())
+	}
+	binding.Validator = validator
+	binding.JSON = validation.JSON
+
 	r := gin.Default()
 
 	user_handler := handler.NewUserHandler(db_connection)
-	post_handler := handler.NewPostHandler(db_connection)
+	post_handler := handler.NewPostHandler(post_service)
+	attachment_handler := handler.NewAttachmentHandler(attachment_service)
+	feed_handler := handler.NewFeedHandler(db_connection)
+	follow_handler := handler.NewFollowHandler(db_connection, notification_service)
+	notification_handler := handler.NewNotificationHandler(notification_service)
+	moderation_handler := handler.NewModerationHandler(moderation_service)
+	bookmark_handler := handler.NewBookmarkHandler(db_connection)
+	series_handler := handler.NewSeriesHandler(db_connection)
+	author_handler := handler.NewAuthorHandler(db_connection, notification_service)
+	stats_handler := handler.NewStatsHandler(stats_service)
+	export_handler := handler.NewExportHandler(export_service)
+	personal_data_handler := handler.NewPersonalDataHandler(personal_data_service)
+	pin_handler := handler.NewPinHandler(pin_service)
 
 	r.GET("/ping", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{
@@ -23,22 +49,170 @@ func StartServer(db_connection *sql.DB) {
 
 	// User Handling
 	r.POST("/user", user_handler.Create)
-	r.DELETE("/user", middleware.RequireMockToken(), user_handler.Delete) // Will also delete all user posts
+	r.DELETE("/user", middleware.RequireMockToken(), user_handler.Delete)               // Will also delete all user posts once the grace period is over
+	r.POST("/user/delete/cancel", user_handler.CancelDeletion)                          // Password protected, the account can't log in while pending deletion
+	r.POST("/user/erasure", middleware.RequireMockToken(), personal_data_handler.Erase) // Password confirmed, like DELETE /user but returns the erasure plan
 	r.GET("/user", middleware.RequireMockToken(), user_handler.Get)
 	r.PATCH("/user", middleware.RequireMockToken(), user_handler.ChangeUsername)
 
+	// Export of the user's posts and series as JSON Lines or a ZIP of Markdown
+	// files, importing one is all or nothing (dryRun=true only reports)
+	r.GET("/user/export", middleware.RequireMockToken(), export_handler.Export)  // ?format=jsonl|zip
+	r.POST("/user/import", middleware.RequireMockToken(), export_handler.Import) // multipart, "file", "userEmail" and "dryRun" fields
+
+	// Personal data exports, built in the background. Ready ones carry a signed
+	// download URL that works once and until the archive expires.
+	r.POST("/user/data-export", middleware.RequireMockToken(), personal_data_handler.RequestExport)
+	r.GET("/user/data-export", middleware.RequireMockToken(), personal_data_handler.ReadExports)
+	r.GET("/user/data-export/:id", middleware.RequireMockToken(), personal_data_handler.ReadExport)
+	r.GET("/data-exports/:id/download", personal_data_handler.Download) // authorized by the URL signature
+
+	// Follows and blocks, blocking also removes the follows both ways
+	r.POST("/user/follow", middleware.RequireMockToken(), follow_handler.Follow)
+	r.DELETE("/user/follow", middleware.RequireMockToken(), follow_handler.Unfollow)
+	r.POST("/user/block", middleware.RequireMockToken(), follow_handler.Block)
+	r.DELETE("/user/block", middleware.RequireMockToken(), follow_handler.Unblock)
+	r.GET("/user/followers", follow_handler.ReadFollowers) // ?email=, cursor paginated
+	r.GET("/user/following", follow_handler.ReadFollowing)
+	r.GET("/user/follow-counts", follow_handler.ReadCounts)
+
+	// Bookmarks, newest first and cursor paginated. Bookmarks of posts deleted
+	// or no longer readable are listed with a null post.
+	r.GET("/user/bookmarks", middleware.RequireMockToken(), bookmark_handler.ReadBookmarks)
+
+	// Reading lists, ordered with a note per post, public ones are readable by
+	// anyone at their sharePath
+	lists := r.Group("/user/lists", middleware.RequireMockToken())
+	lists.POST("", bookmark_handler.CreateList)
+	lists.GET("", bookmark_handler.ReadLists)
+	lists.GET("/:id", bookmark_handler.ReadList)
+	lists.PATCH("/:id", bookmark_handler.UpdateList)
+	lists.DELETE("/:id", bookmark_handler.DeleteList)
+	lists.POST("/:id/items", bookmark_handler.AddItem)
+	lists.PATCH("/:id/items/:postId", bookmark_handler.UpdateItem) // move and/or change the note
+	lists.DELETE("/:id/items/:postId", bookmark_handler.RemoveItem)
+	r.GET("/lists/:token", bookmark_handler.ReadSharedList)
+
+	// Series, ordered parts of a multi-part article. Parts are numbered among
+	// those the reader can see, single post reads include prev/next.
+	series := r.Group("/series")
+	series.GET("", middleware.OptionalMockToken(), series_handler.ReadAll) // ?email= of the author
+	series.GET("/:id", middleware.OptionalMockToken(), series_handler.Read)
+	series.POST("", middleware.RequireMockToken(), series_handler.Create)
+	series.PATCH("/:id", middleware.RequireMockToken(), series_handler.Update)
+	series.DELETE("/:id", middleware.RequireMockToken(), series_handler.Delete) // the posts are kept
+	series.POST("/:id/posts", middleware.RequireMockToken(), series_handler.AddPost)
+	series.PATCH("/:id/posts/:postId", middleware.RequireMockToken(), series_handler.MovePost)
+	series.DELETE("/:id/posts/:postId", middleware.RequireMockToken(), series_handler.RemovePost) // later parts move up
+	series.PUT("/:id/order", middleware.RequireMockToken(), series_handler.Reorder)
+
+	// Home timeline, published posts of followed users newest first, cursor paginated
+	r.GET("/timeline", middleware.RequireMockToken(), post_handler.Timeline)
+
+	// Published posts mentioning the user, cursor paginated
+	r.GET("/users/:username/mentions", post_handler.ReadMentions)
+
+	// Notifications of follows and mentions, newest first and cursor paginated (?unread=true for unread only)
+	r.GET("/notifications", middleware.RequireMockToken(), notification_handler.ReadAll)
+	r.POST("/notifications/:id/read", middleware.RequireMockToken(), notification_handler.MarkRead)
+	r.POST("/notifications/read-all", middleware.RequireMockToken(), notification_handler.MarkAllRead)
+	r.GET("/notifications/stream", middleware.RequireMockToken(), notification_handler.Stream) // Server-Sent Events
+
 	// Login handling
 	r.POST("/user/login", user_handler.Login)
 
 	// Post handling
-	// posts could be accessed also by using
-	// `/post/{post_id}` but i prefere to use full json approach
-	r.POST("/post", post_handler.Create)
-	r.DELETE("/post", middleware.RequireMockToken(), post_handler.Delete)
-	r.GET("/post", post_handler.Read) // posts are public
-	r.PUT("/post", middleware.RequireMockToken(), post_handler.Update)
-
-	r.GET("/post/all", post_handler.ReadAll) // posts are public
+	// reads are public, a userEmail naming the reader needs the token
+	// posts are addressed by path, GET /posts/{id} also accepts the post slug
+	// and redirects old slugs to the current one
+	posts := r.Group("/posts")
+	posts.POST("", post_handler.Create)
+	posts.GET("", middleware.OptionalMockToken(), post_handler.ReadAll)       // published posts are public, cursor paginated, featured first or ?author= with pinned first
+	posts.GET("/:id", middleware.OptionalMockToken(), post_handler.ReadByRef) // published posts are public, drafts only for the owner, sends the version and language as ETag
+	// updates and deletes take If-Match with the ETag read, a stale one is 412 with the current post
+	posts.PUT("/:id", middleware.RequireMockToken(), post_handler.UpdateById)
+	posts.DELETE("/:id", middleware.RequireMockToken(), post_handler.DeleteById) // Moves the post to the trash
+
+	// Pins to the owner's profile and features on the global listing (admins),
+	// both limited in number, POST again with another position to move one
+	posts.POST("/:id/pin", middleware.RequireMockToken(), pin_handler.Pin)
+	posts.DELETE("/:id/pin", middleware.RequireMockToken(), pin_handler.Unpin)
+	posts.POST("/:id/feature", middleware.RequireMockToken(), pin_handler.Feature)
+	posts.DELETE("/:id/feature", middleware.RequireMockToken(), pin_handler.Unfeature)
+
+	// Translations, reads pick one by ?lang= or Accept-Language and fall back
+	// to the original
+	posts.PUT("/:id/translations/:lang", middleware.RequireMockToken(), post_handler.SaveTranslation)
+	posts.DELETE("/:id/translations/:lang", middleware.RequireMockToken(), post_handler.DeleteTranslation)
+
+	// View stats, owner only. Reads of published posts are counted once per
+	// visitor and window, bots and the authors are not counted.
+	posts.GET("/:id/stats", middleware.RequireMockToken(), stats_handler.Read)
+
+	// Revision history, every create/update stores a revision
+	posts.GET("/:id/revisions", middleware.OptionalMockToken(), post_handler.ReadRevisions)
+	posts.GET("/:id/revisions/diff", middleware.OptionalMockToken(), post_handler.DiffRevisions)
+	posts.POST("/:id/revisions/:revision/restore", middleware.RequireMockToken(), post_handler.RestoreRevision)
+
+	// Attachments, listed with signed expiring download URLs
+	posts.POST("/:id/attachments", middleware.RequireMockToken(), attachment_handler.Upload) // multipart, "file" and "userEmail" fields
+	posts.GET("/:id/attachments", middleware.OptionalMockToken(), attachment_handler.ReadAll)
+	posts.DELETE("/:id/attachments/:attachmentId", middleware.RequireMockToken(), attachment_handler.Delete)
+
+	// Bookmarks, bookmarking again replaces the note
+	posts.POST("/:id/bookmark", middleware.RequireMockToken(), bookmark_handler.Bookmark)
+	posts.DELETE("/:id/bookmark", middleware.RequireMockToken(), bookmark_handler.Unbookmark)
+
+	// Co-authors, owners invite editors who may edit but not delete, publish
+	// or manage authors. Leaving is removing yourself.
+	posts.GET("/:id/authors", middleware.RequireMockToken(), author_handler.ReadAll) // with pending invites
+	posts.POST("/:id/authors", middleware.RequireMockToken(), author_handler.Invite)
+	posts.POST("/:id/authors/accept", middleware.RequireMockToken(), author_handler.Accept)
+	posts.DELETE("/:id/authors", middleware.RequireMockToken(), author_handler.Remove)
+	posts.POST("/:id/transfer", middleware.RequireMockToken(), author_handler.Transfer) // the old owner stays on as editor
+
+	// Reports, once per user, enough of them hide the post pending review
+	posts.POST("/:id/report", middleware.RequireMockToken(), moderation_handler.Report)
+
+	// Moderation, moderators only
+	moderation := r.Group("/moderation", middleware.RequireMockToken())
+	moderation.GET("/queue", moderation_handler.Queue) // posts with open reports, ?reason= and ?hidden= filters
+	moderation.GET("/posts/:id", moderation_handler.Detail)
+	moderation.POST("/posts/:id/hide", moderation_handler.Hide)
+	moderation.POST("/posts/:id/restore", moderation_handler.Restore)
+	moderation.POST("/posts/:id/dismiss", moderation_handler.Dismiss) // rejects the reports, undoes an automatic hide
+
+	// Downloads are authorized by the URL signature, they support range requests
+	r.GET("/attachments/:id", attachment_handler.Download)
+	r.GET("/attachments/:id/thumbnail", attachment_handler.Thumbnail)
+
+	// Feeds of the newest published posts, ?author=<email> for a single author
+	r.GET("/feed.rss", feed_handler.RSS)
+	r.GET("/feed.atom", feed_handler.Atom)
+	r.GET("/feed.json", feed_handler.JSON)
+
+	// Deprecated JSON body routes, kept as aliases of the /posts routes above
+	r.POST("/post", middleware.Deprecated("/posts"), post_handler.Create)
+	r.DELETE("/post", middleware.Deprecated("/posts/{id}"), middleware.RequireMockToken(), post_handler.Delete)
+	r.GET("/post", middleware.Deprecated("/posts/{id}"), middleware.OptionalMockToken(), post_handler.Read)
+	r.PUT("/post", middleware.Deprecated("/posts/{id}"), middleware.RequireMockToken(), post_handler.Update)
+	r.GET("/post/all", middleware.Deprecated("/posts"), middleware.OptionalMockToken(), post_handler.ReadAllLegacy) // every post as a bare array, unpaginated
+	r.GET("/post/:id/revisions", middleware.Deprecated("/posts/{id}/revisions"), middleware.OptionalMockToken(), post_handler.ReadRevisions)
+	r.GET("/post/:id/revisions/diff", middleware.Deprecated("/posts/{id}/revisions/diff"), middleware.OptionalMockToken(), post_handler.DiffRevisions)
+	r.POST("/post/:id/revisions/:revision/restore", middleware.Deprecated("/posts/{id}/revisions/{revision}/restore"), middleware.RequireMockToken(), post_handler.RestoreRevision)
+
+	// Post lifecycle: draft -> published -> archived, unpublish goes back to draft
+	r.POST("/post/publish", middleware.RequireMockToken(), post_handler.Publish)
+	r.POST("/post/unpublish", middleware.RequireMockToken(), post_handler.Unpublish)
+	r.POST("/post/archive", middleware.RequireMockToken(), post_handler.Archive)
+
+	// Trash, deleted posts can be restored until they are purged
+	r.GET("/post/trash", middleware.RequireMockToken(), post_handler.ReadTrash)
+	r.POST("/post/restore", middleware.RequireMockToken(), post_handler.Restore)
+
+	// Scheduled publishing, due drafts are published by the background scheduler
+	r.POST("/post/schedule", middleware.RequireMockToken(), post_handler.Schedule)
+	r.GET("/post/scheduled", middleware.RequireMockToken(), post_handler.ReadScheduled)
 
 	r.Run()
 }
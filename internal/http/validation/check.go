@@ -0,0 +1,105 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// tagKeys are the struct tags handler models may carry
+var tagKeys = map[string]bool{"json": true, "form": true, "uri": true, "binding": true, "clean": true}
+
+// Check makes sure the models only carry known tags, binding rules and clean
+// modes, so a misspelled tag fails on startup instead of silently checking
+// nothing
+func (v *Validator) Check(models ...any) error {
+	var errs []error
+
+	for _, m := range models {
+		t := reflect.TypeOf(m)
+		if t.Kind() != reflect.Struct {
+			errs = append(errs, fmt.Errorf("%s is not a struct", t))
+			continue
+		}
+
+		for i := range t.NumField() {
+			f := t.Field(i)
+
+			keys, err := tagKeysOf(f.Tag)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s.%s: %w", t.Name(), f.Name, err))
+				continue
+			}
+			for _, key := range keys {
+				if !tagKeys[key] {
+					errs = append(errs, fmt.Errorf("%s.%s: unknown tag %q", t.Name(), f.Name, key))
+				}
+			}
+
+			if mode, ok := f.Tag.Lookup("clean"); ok {
+				ft := f.Type
+				if ft.Kind() == reflect.Pointer {
+					ft = ft.Elem()
+				}
+				switch {
+				case mode == "tags":
+					if ft != reflect.TypeFor[[]string]() {
+						errs = append(errs, fmt.Errorf("%s.%s: clean:%q, only on string slices", t.Name(), f.Name, mode))
+					}
+				case ft.Kind() != reflect.String || (mode != "trim" && mode != "nfc" && mode != "tag"):
+					errs = append(errs, fmt.Errorf("%s.%s: clean:%q, only trim, nfc or tag on strings", t.Name(), f.Name, mode))
+				}
+			}
+		}
+
+		if err := v.compile(t); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", t.Name(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// compile has the validator parse the binding tags of t, it panics on
+// unknown rules
+func (v *Validator) compile(t reflect.Type) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	// the zero value fails required rules, only the panic matters
+	v.validate.Struct(reflect.New(t).Interface())
+
+	return nil
+}
+
+// tagKeysOf lists the keys of a struct tag, which reflect.StructTag.Lookup
+// can't do
+func tagKeysOf(tag reflect.StructTag) ([]string, error) {
+	var keys []string
+
+	s := string(tag)
+	for {
+		s = strings.TrimLeft(s, " ")
+		if s == "" {
+			return keys, nil
+		}
+
+		key, rest, ok := strings.Cut(s, ":")
+		if !ok || key == "" || strings.ContainsAny(key, " \"") {
+			return nil, fmt.Errorf("malformed tag `%s`", tag)
+		}
+
+		value, err := strconv.QuotedPrefix(rest)
+		if err != nil {
+			return nil, fmt.Errorf("malformed tag `%s`", tag)
+		}
+
+		keys = append(keys, key)
+		s = rest[len(value):]
+	}
+}
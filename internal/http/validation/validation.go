@@ -0,0 +1,218 @@
+// Package validation checks the handler models: it cleans their text fields,
+// registers the rules shared with the domain and turns failures into a list
+// of fields.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"web/example/internal/domain"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// Validator is the gin struct validator for the `binding` tags. Before
+// validating it cleans string fields tagged `clean:"trim"` (trimmed and NFC
+// normalized) or `clean:"nfc"` (only normalized, for content where leading
+// white space matters), and tags tagged `clean:"tag"` or lists of them
+// tagged `clean:"tags"` (trimmed and lowercased, lists lose the empty and
+// repeated ones).
+type Validator struct {
+	validate *validator.Validate
+}
+
+var _ binding.StructValidator = (*Validator)(nil)
+
+// New creates a Validator with the custom rules and aliases registered
+func New() *Validator {
+	v := validator.New()
+	v.SetTagName("binding")
+
+	// errors name fields as clients send them
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		for _, key := range []string{"json", "form", "uri"} {
+			if name, _, _ := strings.Cut(f.Tag.Get(key), ","); name != "" && name != "-" {
+				return name
+			}
+		}
+		return ""
+	})
+
+	rules := map[string]func(string) bool{
+		"singleline": func(s string) bool { return !domain.HasControl(s, false) },
+		"nocontrol":  func(s string) bool { return !domain.HasControl(s, true) },
+		"email":      domain.IsEmail, // replaces the built in rule, which allows display names
+		"username":   domain.IsUsername,
+		"password":   domain.IsStrongPassword,
+		"tag":        domain.IsTag,
+	}
+	for tag, rule := range rules {
+		v.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+			return rule(fl.Field().String())
+		})
+	}
+
+	v.RegisterAlias("post_title", fmt.Sprintf("max=%d,singleline", domain.MaxTitleLength))
+	v.RegisterAlias("post_content", fmt.Sprintf("max=%d,nocontrol", domain.MaxContentLength))
+	v.RegisterAlias("post_tags", fmt.Sprintf("omitempty,max=%d,dive,tag", domain.MaxTags))
+
+	return &Validator{validate: v}
+}
+
+// ValidateStruct cleans and validates a struct, a pointer to one or a slice
+// of them
+func (v *Validator) ValidateStruct(obj any) error {
+	if obj == nil {
+		return nil
+	}
+
+	value := reflect.ValueOf(obj)
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.Elem().Kind() != reflect.Struct {
+			return v.ValidateStruct(value.Elem().Interface())
+		}
+		clean(value.Elem())
+		return v.validate.Struct(obj)
+	case reflect.Struct:
+		// a copy, nothing to clean in place
+		return v.validate.Struct(obj)
+	case reflect.Slice, reflect.Array:
+		var errs binding.SliceValidationError
+		for i := range value.Len() {
+			item := value.Index(i)
+			if item.CanAddr() && item.Kind() == reflect.Struct {
+				item = item.Addr()
+			}
+			if err := v.ValidateStruct(item.Interface()); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) == 0 {
+			return nil
+		}
+		return errs
+	default:
+		return nil
+	}
+}
+
+// Engine returns the underlying go-playground validator
+func (v *Validator) Engine() any {
+	return v.validate
+}
+
+// clean applies the clean tags of an addressable struct and the structs it
+// holds
+func clean(v reflect.Value) {
+	t := v.Type()
+
+	for i := range t.NumField() {
+		f, fv := t.Field(i), v.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		mode, ok := f.Tag.Lookup("clean")
+		if !ok {
+			if fv.Kind() == reflect.Struct {
+				clean(fv)
+			}
+			continue
+		}
+
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		switch {
+		case mode == "tag":
+			fv.SetString(domain.CleanTag(fv.String()))
+		case fv.Kind() == reflect.String:
+			fv.SetString(domain.CleanText(fv.String(), mode == "trim"))
+		case mode == "tags" && !fv.IsNil():
+			fv.Set(reflect.ValueOf(domain.CleanTags(fv.Interface().([]string))))
+		}
+	}
+}
+
+// FieldError is one failed rule of a request field
+type FieldError struct {
+	Field   string `json:"field"`   // As sent, nested fields are dotted
+	Rule    string `json:"rule"`    // The binding tag that failed
+	Message string `json:"message"` // Without the field name
+}
+
+// Fields lists the failed rules of a validation error, it returns nil for
+// any other error
+func Fields(err error) []FieldError {
+	var slice binding.SliceValidationError
+	if errors.As(err, &slice) {
+		var fields []FieldError
+		for i, err := range slice {
+			for _, f := range Fields(err) {
+				f.Field = fmt.Sprintf("[%d].%s", i, f.Field)
+				fields = append(fields, f)
+			}
+		}
+		return fields
+	}
+
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return nil
+	}
+
+	fields := make([]FieldError, 0, len(verrs))
+	for _, fe := range verrs {
+		// the namespace starts with the struct type
+		_, field, _ := strings.Cut(fe.Namespace(), ".")
+
+		fields = append(fields, FieldError{Field: field, Rule: fe.ActualTag(), Message: message(fe)})
+	}
+
+	return fields
+}
+
+// message describes the failed rule
+func message(fe validator.FieldError) string {
+	unit := ""
+	if fe.Kind() == reflect.String {
+		unit = " characters"
+		if fe.Param() == "1" {
+			unit = " character"
+		}
+	}
+
+	switch fe.ActualTag() {
+	case "required":
+		return "is required"
+	case "min":
+		return fmt.Sprintf("must be at least %s%s", fe.Param(), unit)
+	case "max":
+		return fmt.Sprintf("must be at most %s%s", fe.Param(), unit)
+	case "email":
+		return fmt.Sprintf("must be an email address of at most %d characters", domain.MaxEmailLength)
+	case "username":
+		return fmt.Sprintf("must be 1 to %d letters, digits, '_', '.' or '-'", domain.MaxUsernameLength)
+	case "password":
+		return fmt.Sprintf("must be %d characters to %d bytes with a letter and a digit", domain.MinPasswordLength, domain.MaxPasswordLength)
+	case "tag":
+		return fmt.Sprintf("must be 1 to %d lowercase letters, digits or inner '-'", domain.MaxTagLength)
+	case "oneof":
+		return "must be one of " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	case "bcp47_language_tag":
+		return "must be a BCP 47 language tag"
+	case "singleline":
+		return "must be a single line without control characters"
+	case "nocontrol":
+		return "must not contain control characters"
+	default:
+		return fmt.Sprintf("fails the %s rule", fe.ActualTag())
+	}
+}
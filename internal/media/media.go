@@ -0,0 +1,191 @@
+// Package media inspects uploads: content sniffing, metadata stripping and
+// thumbnails for images.
+package media
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"mime"
+	"net/http"
+
+	"golang.org/x/image/draw"
+)
+
+// DefaultAllowedTypes are the upload types accepted unless configured otherwise
+var DefaultAllowedTypes = []string{"image/jpeg", "image/png", "image/gif", "application/pdf", "text/plain"}
+
+// ThumbnailSize bounds the longer side of thumbnails, in pixels
+const ThumbnailSize = 320
+
+// MaxPixels guards against decompression bombs, small files that decode
+// into huge images
+const MaxPixels = 40_000_000
+
+var ErrTooManyPixels = errors.New("image dimensions are too large")
+
+// Sniff detects the content type from the data itself, whatever the client
+// claimed, without parameters ("text/plain", not "text/plain; charset=utf-8")
+func Sniff(data []byte) string {
+	contentType := http.DetectContentType(data)
+
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		return mediaType
+	}
+
+	return contentType
+}
+
+// IsImage reports whether the content type is an image we can process
+func IsImage(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif":
+		return true
+	}
+	return false
+}
+
+// Image is a processed image upload
+type Image struct {
+	Data          []byte // metadata stripped, orientation applied
+	Width         int
+	Height        int
+	Thumbnail     []byte
+	ThumbnailType string
+}
+
+// ProcessImage strips EXIF and other metadata (camera, location...) from the
+// image and renders its thumbnail. JPEGs with an EXIF orientation are rotated
+// and re-encoded since the orientation is lost with the EXIF block, anything
+// else keeps its original bytes minus the metadata.
+func ProcessImage(contentType string, data []byte) (*Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Width*config.Height > MaxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	out := &Image{}
+
+	switch contentType {
+	case "image/jpeg":
+		if orientation := jpegOrientation(data); orientation > 1 {
+			img = orient(img, orientation)
+
+			var buf bytes.Buffer
+			if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90}); err != nil {
+				return nil, err
+			}
+			out.Data = buf.Bytes()
+		} else if out.Data, err = stripJPEG(data); err != nil {
+			return nil, err
+		}
+	case "image/png":
+		if out.Data, err = stripPNG(data); err != nil {
+			return nil, err
+		}
+	default:
+		// GIF has no EXIF
+		out.Data = data
+	}
+
+	bounds := img.Bounds()
+	out.Width, out.Height = bounds.Dx(), bounds.Dy()
+
+	if out.Thumbnail, out.ThumbnailType, err = thumbnail(img, contentType); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ThumbnailType is the content type of thumbnails for images of contentType,
+// JPEGs stay JPEG and everything else becomes PNG to keep transparency
+func ThumbnailType(contentType string) string {
+	if contentType == "image/jpeg" {
+		return "image/jpeg"
+	}
+	return "image/png"
+}
+
+// thumbnail scales the image to fit ThumbnailSize
+func thumbnail(img image.Image, contentType string) ([]byte, string, error) {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	if w > ThumbnailSize || h > ThumbnailSize {
+		if w >= h {
+			w, h = ThumbnailSize, max(1, h*ThumbnailSize/w)
+		} else {
+			w, h = max(1, w*ThumbnailSize/h), ThumbnailSize
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+
+	var buf bytes.Buffer
+	thumbnailType := ThumbnailType(contentType)
+
+	var err error
+	if thumbnailType == "image/jpeg" {
+		err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80})
+	} else {
+		err = png.Encode(&buf, dst)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	return buf.Bytes(), thumbnailType, nil
+}
+
+// orient applies an EXIF orientation (2-8) to the pixels
+func orient(img image.Image, orientation int) image.Image {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			dx, dy := x, y
+
+			switch orientation {
+			case 2: // mirrored
+				dx = w - 1 - x
+			case 3: // upside down
+				dx, dy = w-1-x, h-1-y
+			case 4: // mirrored upside down
+				dy = h - 1 - y
+			case 5: // transposed
+				dx, dy = y, x
+			case 6: // rotated 90° clockwise
+				dx, dy = h-1-y, x
+			case 7: // transversed
+				dx, dy = h-1-y, w-1-x
+			case 8: // rotated 90° counter clockwise
+				dx, dy = y, w-1-x
+			}
+
+			dst.Set(dx, dy, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,159 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+var (
+	errBadJPEG = errors.New("malformed jpeg")
+	errBadPNG  = errors.New("malformed png")
+)
+
+// JPEG markers dropped from uploads: APP1 (EXIF, XMP), APP13 (IPTC) and
+// comments. APP0 (JFIF), APP2 (ICC profile) and APP14 (Adobe) are kept,
+// they change how the image is displayed.
+var jpegDropped = map[byte]bool{0xE1: true, 0xED: true, 0xFE: true}
+
+// jpegSegments calls fn with every marker segment before the image data
+// (start of scan) and returns the offset of the start of scan marker
+func jpegSegments(data []byte, fn func(marker byte, segment []byte)) (int, error) {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 0, errBadJPEG
+	}
+
+	for i := 2; ; {
+		if i+4 > len(data) || data[i] != 0xFF {
+			return 0, errBadJPEG
+		}
+
+		marker := data[i+1]
+		if marker == 0xFF {
+			// fill byte
+			i++
+			continue
+		}
+
+		if marker == 0xDA {
+			return i, nil
+		}
+
+		length := int(data[i+2])<<8 | int(data[i+3])
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			return 0, errBadJPEG
+		}
+
+		fn(marker, data[i:end])
+		i = end
+	}
+}
+
+// stripJPEG drops the metadata segments without re-encoding the image
+func stripJPEG(data []byte) ([]byte, error) {
+	out := make([]byte, 0, len(data))
+	out = append(out, 0xFF, 0xD8)
+
+	scan, err := jpegSegments(data, func(marker byte, segment []byte) {
+		if !jpegDropped[marker] {
+			out = append(out, segment...)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(out, data[scan:]...), nil
+}
+
+// jpegOrientation reads the EXIF orientation tag, 1 (as stored) when absent
+func jpegOrientation(data []byte) int {
+	orientation := 1
+
+	jpegSegments(data, func(marker byte, segment []byte) {
+		if marker == 0xE1 {
+			if o := exifOrientation(segment[4:]); o > 0 {
+				orientation = o
+			}
+		}
+	})
+
+	return orientation
+}
+
+// exifOrientation finds tag 0x0112 in the first IFD of an APP1 EXIF payload
+func exifOrientation(payload []byte) int {
+	if len(payload) < 14 || string(payload[:6]) != "Exif\x00\x00" {
+		return 0
+	}
+	tiff := payload[6:]
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 0
+	}
+
+	entries := int(order.Uint16(tiff[ifd:]))
+	for e := 0; e < entries; e++ {
+		entry := ifd + 2 + e*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			if o := int(order.Uint16(tiff[entry+8:])); o >= 1 && o <= 8 {
+				return o
+			}
+			return 0
+		}
+	}
+
+	return 0
+}
+
+const pngSignature = "\x89PNG\r\n\x1a\n"
+
+// PNG chunks dropped from uploads, EXIF, text (often tools, authors,
+// comments) and the modification time
+var pngDropped = map[string]bool{"eXIf": true, "tEXt": true, "zTXt": true, "iTXt": true, "tIME": true}
+
+// stripPNG drops the metadata chunks, the others are copied as they are
+func stripPNG(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, []byte(pngSignature)) {
+		return nil, errBadPNG
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, pngSignature...)
+
+	for i := len(pngSignature); i < len(data); {
+		// length, type, data, crc
+		if i+12 > len(data) {
+			return nil, errBadPNG
+		}
+
+		length := int64(binary.BigEndian.Uint32(data[i:]))
+		if length > int64(len(data)-i-12) {
+			return nil, errBadPNG
+		}
+		end := i + 12 + int(length)
+
+		if !pngDropped[string(data[i+4:i+8])] {
+			out = append(out, data[i:end]...)
+		}
+		i = end
+	}
+
+	return out, nil
+}
@@ -0,0 +1,82 @@
+// Package mention finds @username mentions in post content.
+package mention
+
+import (
+	"strings"
+	"unicode"
+)
+
+// MaxUsernames caps how many different users one post can mention, further
+// names are ignored
+const MaxUsernames = 50
+
+// Token is one @username in the content. Start and End are offsets in
+// Unicode code points, Start is at the @ and End is after the last letter.
+type Token struct {
+	Username string
+	Start    int
+	End      int
+}
+
+func isNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-'
+}
+
+// Parse lists the mentions in content in order. A mention is an @ at the start
+// of a word followed by letters, digits, '_', '.' or '-', punctuation ending
+// a sentence is not part of it. Email addresses and paths like a/@b are not
+// mentions.
+func Parse(content string) []Token {
+	runes := []rune(content)
+	seen := map[string]bool{}
+
+	var tokens []Token
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '@' {
+			continue
+		}
+		if i > 0 && (isNameRune(runes[i-1]) || runes[i-1] == '@' || runes[i-1] == '/') {
+			continue
+		}
+
+		end := i + 1
+		for end < len(runes) && isNameRune(runes[end]) {
+			end++
+		}
+
+		// "@bob." at the end of a sentence mentions bob
+		name := strings.TrimRight(string(runes[i+1:end]), ".-")
+		if name == "" || name[0] == '.' || name[0] == '-' {
+			continue
+		}
+
+		if !seen[name] {
+			if len(seen) == MaxUsernames {
+				continue
+			}
+			seen[name] = true
+		}
+
+		nameEnd := i + 1 + len([]rune(name))
+		tokens = append(tokens, Token{Username: name, Start: i, End: nameEnd})
+		i = nameEnd - 1
+	}
+
+	return tokens
+}
+
+// Usernames lists the distinct usernames of tokens in order of appearance
+func Usernames(tokens []Token) []string {
+	seen := map[string]bool{}
+
+	var names []string
+	for _, t := range tokens {
+		if !seen[t.Username] {
+			seen[t.Username] = true
+			names = append(names, t.Username)
+		}
+	}
+
+	return names
+}
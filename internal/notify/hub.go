@@ -0,0 +1,99 @@
+// Package notify fans out new notifications to the live streams of their users.
+package notify
+
+import (
+	"sync"
+	"web/example/internal/domain"
+)
+
+// subscriptionBuffer is how many notifications a stream may fall behind
+// before it is dropped
+const subscriptionBuffer = 16
+
+// Subscription receives the notifications of one user. C is closed when the
+// subscriber could not keep up, it should reconnect and catch up from the db.
+type Subscription struct {
+	C      <-chan domain.Notification
+	c      chan domain.Notification
+	userId int
+}
+
+// Hub is an in-process pub/sub of notifications keyed by user, every
+// subscription of the user gets a copy. It only reaches streams connected
+// to this process.
+type Hub struct {
+	mu   sync.Mutex
+	subs map[int]map[*Subscription]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		subs: map[int]map[*Subscription]struct{}{},
+	}
+}
+
+// Subscribe starts receiving the notifications of userId, callers must
+// Unsubscribe when done
+func (h *Hub) Subscribe(userId int) *Subscription {
+	c := make(chan domain.Notification, subscriptionBuffer)
+	sub := &Subscription{C: c, c: c, userId: userId}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.subs[userId] == nil {
+		h.subs[userId] = map[*Subscription]struct{}{}
+	}
+	h.subs[userId][sub] = struct{}{}
+
+	return sub
+}
+
+// Unsubscribe stops the subscription, calling it twice is fine
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(sub)
+}
+
+// remove drops the subscription and closes its channel, h.mu must be held
+func (h *Hub) remove(sub *Subscription) {
+	subs, ok := h.subs[sub.userId]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.subs, sub.userId)
+	}
+
+	close(sub.c)
+}
+
+// Publish sends n to every subscription of n.UserId without blocking, a
+// subscription with a full buffer is closed instead of stalling the others
+func (h *Hub) Publish(n domain.Notification) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs[n.UserId] {
+		select {
+		case sub.c <- n:
+		default:
+			h.remove(sub)
+		}
+	}
+}
+
+// Subscribers counts the open subscriptions of userId
+func (h *Hub) Subscribers(userId int) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return len(h.subs[userId])
+}
@@ -0,0 +1,87 @@
+// Package render turns post content into sanitized HTML.
+package render
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+)
+
+// Content formats a post can declare
+const (
+	FormatPlain    = "plain"
+	FormatMarkdown = "markdown"
+)
+
+// GitHub flavored markdown (tables, autolinks, strikethrough, task lists) with
+// ids on headings so they can be linked to. Raw HTML in the source is dropped
+// by goldmark already, the sanitizer below is the actual XSS guard.
+var markdown = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+)
+
+var policy = newPolicy()
+
+// newPolicy is bluemonday's allowlist for user generated content plus the
+// attributes our rendering relies on
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+
+	// heading anchors
+	p.AllowAttrs("id").Matching(regexp.MustCompile(`^[\w-]+$`)).OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	// code fence language, for client side highlighting
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#-]+$`)).OnElements("code")
+	// task lists
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+
+	p.RequireNoFollowOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+
+	return p
+}
+
+// HTML renders content in the given format to sanitized HTML
+func HTML(format string, content string) (string, error) {
+	var raw string
+
+	switch format {
+	case FormatMarkdown:
+		var buf bytes.Buffer
+		if err := markdown.Convert([]byte(content), &buf); err != nil {
+			return "", err
+		}
+		raw = buf.String()
+	default:
+		raw = plainHTML(content)
+	}
+
+	return policy.Sanitize(raw), nil
+}
+
+// plainHTML escapes plain text, blank lines split paragraphs and single
+// newlines become line breaks
+func plainHTML(content string) string {
+	var sb strings.Builder
+
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	for _, paragraph := range strings.Split(content, "\n\n") {
+		paragraph = strings.Trim(paragraph, "\n")
+		if paragraph == "" {
+			continue
+		}
+
+		sb.WriteString("<p>")
+		sb.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>\n"))
+		sb.WriteString("</p>\n")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"web/example/internal/domain"
+)
+
+// Attachments only hold metadata, the files themselves are in the blob store
+type AttachmentRepositoryInterface interface {
+	CreateAttachment(attachment *domain.Attachment) error
+	ReadAttachment(id int) (*domain.Attachment, error)
+	ReadAttachments(postId int) ([]domain.Attachment, error)
+	DeleteAttachment(id int) error
+}
+
+// attachmentColumns is the column list matching scanAttachment
+const attachmentColumns = "id, post_id, filename, content_type, size, width, height, storage_key, thumbnail_key, created_at"
+
+func scanAttachment(row rowScanner) (*domain.Attachment, error) {
+	var a domain.Attachment
+
+	if err := row.Scan(&a.Id, &a.PostId, &a.Filename, &a.ContentType, &a.Size, &a.Width, &a.Height, &a.StorageKey, &a.ThumbnailKey, &a.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// AttachmentRepository handles all database operations for attachments
+type AttachmentRepository struct {
+	db *sql.DB
+}
+
+// NewAttachmentRepository creates a new instance of AttachmentRepository
+func NewAttachmentRepository(db *sql.DB) *AttachmentRepository {
+	return &AttachmentRepository{
+		db: db,
+	}
+}
+
+// CreateAttachment inserts the attachment, attachment.Id is set to the new id
+func (r *AttachmentRepository) CreateAttachment(attachment *domain.Attachment) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		`INSERT INTO attachments (post_id, filename, content_type, size, width, height, storage_key, thumbnail_key)
+		values (?, ?, ?, ?, ?, ?, ?, ?)`,
+		attachment.PostId, attachment.Filename, attachment.ContentType, attachment.Size, attachment.Width, attachment.Height, attachment.StorageKey, attachment.ThumbnailKey)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	attachment.Id = int(id)
+
+	return nil
+}
+
+func (r *AttachmentRepository) ReadAttachment(id int) (*domain.Attachment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	row := r.db.QueryRowContext(ctx, "SELECT "+attachmentColumns+" FROM attachments WHERE id == ?", id)
+
+	return scanAttachment(row)
+}
+
+// ReadAttachments lists the attachments of a post, oldest first
+func (r *AttachmentRepository) ReadAttachments(postId int) ([]domain.Attachment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, "SELECT "+attachmentColumns+" FROM attachments WHERE post_id == ? ORDER BY id", postId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attachments []domain.Attachment
+
+	for rows.Next() {
+		a, err := scanAttachment(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		attachments = append(attachments, *a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+func (r *AttachmentRepository) DeleteAttachment(id int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, "DELETE FROM attachments WHERE id == ?", id)
+	if err != nil {
+		return err
+	}
+
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// readBlobKeys lists the stored files of the attachments of the posts
+// matching where, thumbnails included, before the rows are deleted with the
+// posts
+func readBlobKeys(ctx context.Context, tx *sql.Tx, where string, args ...any) ([]string, error) {
+	rows, err := tx.QueryContext(ctx,
+		"SELECT storage_key, thumbnail_key FROM attachments WHERE post_id IN (SELECT id FROM posts WHERE "+where+")", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []string
+
+	for rows.Next() {
+		var key string
+		var thumbnailKey *string
+
+		if err := rows.Scan(&key, &thumbnailKey); err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+		if thumbnailKey != nil {
+			keys = append(keys, *thumbnailKey)
+		}
+	}
+
+	return keys, rows.Err()
+}
@@ -0,0 +1,317 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+	"web/example/internal/domain"
+)
+
+// BookmarkRepositoryInterface covers bookmarks and reading lists
+type BookmarkRepositoryInterface interface {
+	SaveBookmark(userId int, postId int, note string) error
+	DeleteBookmark(userId int, postId int) error
+	ReadBookmarks(userId int, beforeId int, limit int) ([]domain.Bookmark, error)
+	CreateReadingList(list *domain.ReadingList) error
+	ReadReadingList(id int) (*domain.ReadingList, error)
+	ReadReadingListByToken(token string) (*domain.ReadingList, error)
+	ReadReadingLists(userId int) ([]domain.ReadingList, error)
+	UpdateReadingList(list *domain.ReadingList) error
+	DeleteReadingList(id int) error
+	ReadListItems(listId int) ([]domain.ReadingListItem, error)
+	AddListItem(listId int, postId int, note string, position int) (bool, error)
+	MoveListItem(listId int, postId int, position int) (bool, error)
+	UpdateListItemNote(listId int, postId int, note string) (bool, error)
+	RemoveListItem(listId int, postId int) error
+}
+
+// BookmarkRepository handles all database operations for bookmarks and
+// reading lists
+type BookmarkRepository struct {
+	db *sql.DB
+}
+
+// NewBookmarkRepository creates a new instance of BookmarkRepository
+func NewBookmarkRepository(db *sql.DB) *BookmarkRepository {
+	return &BookmarkRepository{
+		db: db,
+	}
+}
+
+// SaveBookmark bookmarks the post, bookmarking it again only replaces the note
+func (r *BookmarkRepository) SaveBookmark(userId int, postId int, note string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		"INSERT INTO bookmarks (user_id, post_id, note) values (?, ?, ?) ON CONFLICT (user_id, post_id) DO UPDATE SET note = excluded.note",
+		userId, postId, note)
+
+	return err
+}
+
+func (r *BookmarkRepository) DeleteBookmark(userId int, postId int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx, "DELETE FROM bookmarks WHERE user_id == ? AND post_id == ?", userId, postId)
+
+	return err
+}
+
+// ReadBookmarks pages through the user's bookmarks newest first, beforeId is
+// the last bookmark of the previous page and 0 for the first page. Posts are
+// not joined, bookmarks of deleted posts are still listed.
+func (r *BookmarkRepository) ReadBookmarks(userId int, beforeId int, limit int) ([]domain.Bookmark, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT id, post_id, note, created_at FROM bookmarks WHERE user_id == ? AND (? == 0 OR id < ?) ORDER BY id DESC LIMIT ?",
+		userId, beforeId, beforeId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bookmarks []domain.Bookmark
+
+	for rows.Next() {
+		var b domain.Bookmark
+
+		if err := rows.Scan(&b.Id, &b.PostId, &b.Note, &b.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		bookmarks = append(bookmarks, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return bookmarks, nil
+}
+
+// readingListColumns is the column list matching scanReadingList
+const readingListColumns = "id, user_id, name, description, share_token, created_at, " +
+	"(SELECT COUNT(*) FROM reading_list_items i WHERE i.list_id == reading_lists.id)"
+
+func scanReadingList(row rowScanner) (*domain.ReadingList, error) {
+	var l domain.ReadingList
+
+	if err := row.Scan(&l.Id, &l.UserId, &l.Name, &l.Description, &l.ShareToken, &l.CreatedAt, &l.ItemCount); err != nil {
+		return nil, err
+	}
+
+	return &l, nil
+}
+
+// CreateReadingList stores the list and sets its id, names are unique per user
+func (r *BookmarkRepository) CreateReadingList(list *domain.ReadingList) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		"INSERT INTO reading_lists (user_id, name, description, share_token) values (?, ?, ?, ?)",
+		list.UserId, list.Name, list.Description, list.ShareToken)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	list.Id = int(id)
+
+	return err
+}
+
+func (r *BookmarkRepository) ReadReadingList(id int) (*domain.ReadingList, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	return scanReadingList(r.db.QueryRowContext(ctx,
+		"SELECT "+readingListColumns+" FROM reading_lists WHERE id == ?", id))
+}
+
+// ReadReadingListByToken reads a public list by its share token
+func (r *BookmarkRepository) ReadReadingListByToken(token string) (*domain.ReadingList, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	return scanReadingList(r.db.QueryRowContext(ctx,
+		"SELECT "+readingListColumns+" FROM reading_lists WHERE share_token == ?", token))
+}
+
+// ReadReadingLists lists the user's lists by name, without their items
+func (r *BookmarkRepository) ReadReadingLists(userId int) ([]domain.ReadingList, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+readingListColumns+" FROM reading_lists WHERE user_id == ? ORDER BY name", userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lists []domain.ReadingList
+
+	for rows.Next() {
+		l, err := scanReadingList(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		lists = append(lists, *l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+// UpdateReadingList overwrites name, description and share token
+func (r *BookmarkRepository) UpdateReadingList(list *domain.ReadingList) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE reading_lists SET name = ?, description = ?, share_token = ? WHERE id == ?",
+		list.Name, list.Description, list.ShareToken, list.Id)
+
+	return err
+}
+
+func (r *BookmarkRepository) DeleteReadingList(id int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx, "DELETE FROM reading_lists WHERE id == ?", id)
+
+	return err
+}
+
+// ReadListItems lists the items in order, positions are renumbered from 1
+// so gaps left by purged posts don't show
+func (r *BookmarkRepository) ReadListItems(listId int) ([]domain.ReadingListItem, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT post_id, note, added_at FROM reading_list_items WHERE list_id == ? ORDER BY position",
+		listId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []domain.ReadingListItem
+
+	for rows.Next() {
+		i := domain.ReadingListItem{Position: len(items) + 1}
+
+		if err := rows.Scan(&i.PostId, &i.Note, &i.AddedAt); err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// AddListItem inserts the post at position, 1 based and clamped to the list,
+// 0 appends it. It reports false when the post is already in the list.
+func (r *BookmarkRepository) AddListItem(listId int, postId int, note string, position int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	order, err := readOrder(ctx, tx, "reading_list_items", "list_id", "post_id", listId)
+	if err != nil || slices.Contains(order, postId) {
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"INSERT INTO reading_list_items (list_id, post_id, position, note) values (?, ?, 0, ?)",
+		listId, postId, note); err != nil {
+		return false, err
+	}
+
+	if err := writeOrder(ctx, tx, "reading_list_items", "list_id", "post_id", listId, insertAt(order, postId, position)); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// MoveListItem moves the post to position, 1 based and clamped to the list.
+// It reports false when the post is not in the list.
+func (r *BookmarkRepository) MoveListItem(listId int, postId int, position int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	order, err := readOrder(ctx, tx, "reading_list_items", "list_id", "post_id", listId)
+	if err != nil {
+		return false, err
+	}
+
+	i := slices.Index(order, postId)
+	if i < 0 {
+		return false, nil
+	}
+
+	order = slices.Delete(order, i, i+1)
+
+	if err := writeOrder(ctx, tx, "reading_list_items", "list_id", "post_id", listId, insertAt(order, postId, max(position, 1))); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// UpdateListItemNote reports false when the post is not in the list
+func (r *BookmarkRepository) UpdateListItemNote(listId int, postId int, note string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE reading_list_items SET note = ? WHERE list_id == ? AND post_id == ?",
+		note, listId, postId)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+
+	return n > 0, err
+}
+
+// RemoveListItem drops the post from the list, the gap it leaves doesn't
+// affect the order
+func (r *BookmarkRepository) RemoveListItem(listId int, postId int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx, "DELETE FROM reading_list_items WHERE list_id == ? AND post_id == ?", listId, postId)
+
+	return err
+}
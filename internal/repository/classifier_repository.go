@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+	"web/example/internal/domain"
+)
+
+// ClassifierRepositoryInterface stores the spam classifier training
+type ClassifierRepositoryInterface interface {
+	Train(postId int, spam bool, tokens []string) error
+	ReadDocumentCounts() (int, int, error)
+	ReadTokenCounts(tokens []string) (map[string]domain.TokenCount, error)
+}
+
+// ClassifierRepository handles all database operations for the spam classifier
+type ClassifierRepository struct {
+	db *sql.DB
+}
+
+// NewClassifierRepository creates a new instance of ClassifierRepository
+func NewClassifierRepository(db *sql.DB) *ClassifierRepository {
+	return &ClassifierRepository{
+		db: db,
+	}
+}
+
+// Train counts the tokens of the post as spam or ham. A post trained before
+// is untrained first, so relabelling or editing it never counts it twice.
+func (r *ClassifierRepository) Train(postId int, spam bool, tokens []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var wasSpam bool
+	var previous string
+
+	err = tx.QueryRowContext(ctx, "SELECT spam, tokens FROM classifier_documents WHERE post_id == ?", postId).Scan(&wasSpam, &previous)
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return err
+	default:
+		var old []string
+		if err := json.Unmarshal([]byte(previous), &old); err != nil {
+			return err
+		}
+
+		if err := countTokens(ctx, tx, old, wasSpam, -1); err != nil {
+			return err
+		}
+	}
+
+	if err := countTokens(ctx, tx, tokens, spam, 1); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM classifier_tokens WHERE spam <= 0 AND ham <= 0"); err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO classifier_documents (post_id, spam, tokens) values (?, ?, ?)
+		ON CONFLICT (post_id) DO UPDATE SET spam = excluded.spam, tokens = excluded.tokens, trained_at = CURRENT_TIMESTAMP`,
+		postId, spam, string(encoded)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// countTokens adds delta to the spam or ham count of every token
+func countTokens(ctx context.Context, tx *sql.Tx, tokens []string, spam bool, delta int) error {
+	column := "ham"
+	if spam {
+		column = "spam"
+	}
+
+	stmt, err := tx.PrepareContext(ctx,
+		"INSERT INTO classifier_tokens (token, "+column+") values (?, ?) ON CONFLICT (token) DO UPDATE SET "+column+" = "+column+" + excluded."+column)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, token := range tokens {
+		if _, err := stmt.ExecContext(ctx, token, delta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadDocumentCounts returns how many posts were trained as spam and as ham
+func (r *ClassifierRepository) ReadDocumentCounts() (int, int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	var spam, ham int
+
+	err := r.db.QueryRowContext(ctx,
+		"SELECT COALESCE(SUM(spam), 0), COALESCE(SUM(NOT spam), 0) FROM classifier_documents").Scan(&spam, &ham)
+
+	return spam, ham, err
+}
+
+// ReadTokenCounts returns the counts of the tokens seen in training, unknown
+// tokens are left out
+func (r *ClassifierRepository) ReadTokenCounts(tokens []string) (map[string]domain.TokenCount, error) {
+	counts := map[string]domain.TokenCount{}
+	if len(tokens) == 0 {
+		return counts, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	args := make([]any, len(tokens))
+	for i, t := range tokens {
+		args[i] = t
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT token, spam, ham FROM classifier_tokens WHERE token IN (?"+strings.Repeat(", ?", len(tokens)-1)+")",
+		args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var token string
+		var c domain.TokenCount
+
+		if err := rows.Scan(&token, &c.Spam, &c.Ham); err != nil {
+			return nil, err
+		}
+
+		counts[token] = c
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
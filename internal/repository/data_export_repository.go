@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"web/example/internal/domain"
+)
+
+// DataExportRepositoryInterface covers personal data exports and reading
+// the data that goes into them
+type DataExportRepositoryInterface interface {
+	CreateDataExport(export *domain.DataExport) error
+	ReadDataExport(id int) (*domain.DataExport, error)
+	ReadUserDataExports(userId int) ([]domain.DataExport, error)
+	ReadPendingDataExports(limit int) ([]domain.DataExport, error)
+	MarkDataExportReady(id int, storageKey string, size int64, expiresAt time.Time) (bool, error)
+	MarkDataExportFailed(id int, reason string) error
+	ClaimDataExportDownload(id int, now time.Time) (bool, error)
+	ReadStaleDataExports(now time.Time) ([]domain.DataExport, error)
+	ClearDataExportBlob(id int) error
+	ReadPersonalData(userId int) ([]domain.DataSection, error)
+}
+
+// DataExportRepository handles all database operations for personal data
+// exports
+type DataExportRepository struct {
+	db *sql.DB
+}
+
+// NewDataExportRepository creates a new instance of DataExportRepository
+func NewDataExportRepository(db *sql.DB) *DataExportRepository {
+	return &DataExportRepository{
+		db: db,
+	}
+}
+
+const dataExportColumns = "id, user_id, status, storage_key, size, error, created_at, ready_at, expires_at, downloaded_at"
+
+func scanDataExport(row rowScanner) (*domain.DataExport, error) {
+	var e domain.DataExport
+
+	if err := row.Scan(&e.Id, &e.UserId, &e.Status, &e.StorageKey, &e.Size, &e.Error, &e.CreatedAt, &e.ReadyAt, &e.ExpiresAt, &e.DownloadedAt); err != nil {
+		return nil, err
+	}
+
+	return &e, nil
+}
+
+func scanDataExports(rows *sql.Rows) ([]domain.DataExport, error) {
+	var exports []domain.DataExport
+
+	for rows.Next() {
+		e, err := scanDataExport(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		exports = append(exports, *e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return exports, nil
+}
+
+// CreateDataExport stores a pending export and sets its id
+func (r *DataExportRepository) CreateDataExport(export *domain.DataExport) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx, "INSERT INTO data_exports (user_id) values (?)", export.UserId)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	export.Id = int(id)
+
+	return err
+}
+
+func (r *DataExportRepository) ReadDataExport(id int) (*domain.DataExport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	return scanDataExport(r.db.QueryRowContext(ctx, "SELECT "+dataExportColumns+" FROM data_exports WHERE id == ?", id))
+}
+
+// ReadUserDataExports lists the user's exports, newest first
+func (r *DataExportRepository) ReadUserDataExports(userId int) ([]domain.DataExport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+dataExportColumns+" FROM data_exports WHERE user_id == ? ORDER BY id DESC", userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanDataExports(rows)
+}
+
+// ReadPendingDataExports lists the oldest exports waiting to be built
+func (r *DataExportRepository) ReadPendingDataExports(limit int) ([]domain.DataExport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+dataExportColumns+" FROM data_exports WHERE status == 'pending' ORDER BY id LIMIT ?", limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanDataExports(rows)
+}
+
+// MarkDataExportReady records the stored archive of a pending export, it
+// reports false when the export is no longer pending (the account was erased
+// while it was built)
+func (r *DataExportRepository) MarkDataExportReady(id int, storageKey string, size int64, expiresAt time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE data_exports SET status = 'ready', storage_key = ?, size = ?, ready_at = CURRENT_TIMESTAMP, expires_at = ? WHERE id == ? AND status == 'pending'",
+		storageKey, size, expiresAt.UTC().Format(sqliteTimeLayout), id)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+
+	return n > 0, err
+}
+
+func (r *DataExportRepository) MarkDataExportFailed(id int, reason string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE data_exports SET status = 'failed', error = ? WHERE id == ? AND status == 'pending'", reason, id)
+
+	return err
+}
+
+// ClaimDataExportDownload marks a ready export downloaded, it reports false
+// when it was downloaded before or has expired so each archive is only
+// handed out once
+func (r *DataExportRepository) ClaimDataExportDownload(id int, now time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE data_exports SET status = 'downloaded', downloaded_at = CURRENT_TIMESTAMP WHERE id == ? AND status == 'ready' AND expires_at > ?",
+		id, now.UTC().Format(sqliteTimeLayout))
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+
+	return n > 0, err
+}
+
+// ReadStaleDataExports lists the exports whose archive is still stored but
+// was downloaded or has expired
+func (r *DataExportRepository) ReadStaleDataExports(now time.Time) ([]domain.DataExport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+dataExportColumns+" FROM data_exports WHERE storage_key IS NOT NULL AND (status != 'ready' OR expires_at <= ?)",
+		now.UTC().Format(sqliteTimeLayout))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanDataExports(rows)
+}
+
+// ClearDataExportBlob records that the archive was deleted, a ready export
+// expires with it
+func (r *DataExportRepository) ClearDataExportBlob(id int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE data_exports SET storage_key = NULL, status = CASE WHEN status == 'ready' THEN 'expired' ELSE status END WHERE id == ?", id)
+
+	return err
+}
+
+// personalData are the sections of a personal data export and the rows
+// they hold, ?1 being the user id. The password hash is left out.
+var personalData = []struct {
+	name  string
+	query string
+}{
+	{"profile", "SELECT id, username, email, role, deleted_at FROM users WHERE id == ?1"},
+	{"posts", "SELECT * FROM posts WHERE user_id == ?1 ORDER BY id"},
+	{"post_revisions", "SELECT r.* FROM post_revisions r JOIN posts p ON p.id == r.post_id WHERE p.user_id == ?1 ORDER BY r.id"},
+	{"post_slugs", "SELECT s.* FROM post_slugs s JOIN posts p ON p.id == s.post_id WHERE p.user_id == ?1"},
+	{"post_tags", "SELECT t.* FROM post_tags t JOIN posts p ON p.id == t.post_id WHERE p.user_id == ?1 ORDER BY t.post_id, t.position"},
+	{"post_pins", "SELECT * FROM post_pins WHERE user_id == ?1 ORDER BY position"},
+	{"posts_featured", "SELECT post_id, created_at FROM featured_posts WHERE featured_by == ?1"},
+	{"post_translations", "SELECT t.post_id, t.language, t.title, t.content, t.original, t.created_at, t.updated_at FROM post_translations t JOIN posts p ON p.id == t.post_id WHERE p.user_id == ?1 ORDER BY t.post_id, t.language"},
+	{"attachments", "SELECT a.* FROM attachments a JOIN posts p ON p.id == a.post_id WHERE p.user_id == ?1 ORDER BY a.id"},
+	{"post_views", "SELECT v.* FROM post_views v JOIN posts p ON p.id == v.post_id WHERE p.user_id == ?1"},
+	{"post_flags", "SELECT f.* FROM post_flags f JOIN posts p ON p.id == f.post_id WHERE p.user_id == ?1 ORDER BY f.id"},
+	{"post_authors", "SELECT * FROM post_authors WHERE user_id == ?1 OR invited_by == ?1"},
+	{"mentions", "SELECT * FROM post_mentions WHERE user_id == ?1"},
+	{"series", "SELECT * FROM series WHERE user_id == ?1 ORDER BY id"},
+	{"series_posts", "SELECT sp.* FROM series_posts sp JOIN series s ON s.id == sp.series_id WHERE s.user_id == ?1"},
+	{"follows", "SELECT * FROM follows WHERE follower_id == ?1 OR followee_id == ?1"},
+	{"blocks", "SELECT * FROM user_blocks WHERE blocker_id == ?1"},
+	{"notifications", "SELECT * FROM notifications WHERE user_id == ?1 ORDER BY id"},
+	{"bookmarks", "SELECT * FROM bookmarks WHERE user_id == ?1 ORDER BY id"},
+	{"reading_lists", "SELECT * FROM reading_lists WHERE user_id == ?1 ORDER BY id"},
+	{"reading_list_items", "SELECT i.* FROM reading_list_items i JOIN reading_lists l ON l.id == i.list_id WHERE l.user_id == ?1"},
+	{"reports_filed", "SELECT * FROM post_reports WHERE reporter_id == ?1 ORDER BY id"},
+	// the audit trail: moderation of the user's posts and by the user
+	{"moderation_actions", "SELECT m.* FROM moderation_actions m WHERE m.moderator_id == ?1 OR m.post_id IN (SELECT id FROM posts WHERE user_id == ?1) ORDER BY m.id"},
+	{"data_exports", "SELECT " + dataExportColumns + " FROM data_exports WHERE user_id == ?1 ORDER BY id"},
+}
+
+// ReadPersonalData reads every section of the user's personal data, rows
+// keep the column names of the tables
+func (r *DataExportRepository) ReadPersonalData(userId int) ([]domain.DataSection, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), erasureTimeout)
+	defer cancel()
+
+	sections := make([]domain.DataSection, len(personalData))
+
+	for i, section := range personalData {
+		rows, err := r.db.QueryContext(ctx, section.query, userId)
+		if err != nil {
+			return nil, err
+		}
+
+		sections[i].Name = section.name
+		sections[i].Rows, err = scanMaps(rows)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sections, nil
+}
+
+// scanMaps reads the rows as column name to value maps and closes them
+func scanMaps(rows *sql.Rows) ([]map[string]any, error) {
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []map[string]any{}
+
+	for rows.Next() {
+		values := make([]any, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]any, len(columns))
+		for i, column := range columns {
+			// text comes back as bytes, which would encode as base64
+			if b, ok := values[i].([]byte); ok {
+				values[i] = string(b)
+			}
+			row[column] = values[i]
+		}
+
+		result = append(result, row)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"web/example/internal/domain"
+)
+
+// erasureStep applies to the rows of table matching where, ?1 being the
+// user id. set anonymizes them, without it they are deleted.
+type erasureStep struct {
+	data   string
+	action domain.ErasureAction
+	table  string
+	where  string
+	set    string
+}
+
+// erasurePolicy is what erasing an account does, in the order it is done.
+// Deleting the posts also deletes everything hanging off them through the
+// foreign keys: revisions, slugs, mentions, tags, flags, reports, views, and other
+// users' bookmarks, list items and notifications of them. The files of their
+// attachments are deleted from the store by the caller, see erase. The account row is kept anonymized so
+// the reports the user filed and the moderation actions they took keep
+// their history without pointing at a person.
+var erasurePolicy = []erasureStep{
+	// no foreign key, see classifier_documents, the token counts stay
+	{data: "classifier documents", action: domain.ErasureDelete, table: "classifier_documents",
+		where: "post_id IN (SELECT id FROM posts WHERE user_id == ?1)"},
+	{data: "attachments and their files", action: domain.ErasureDelete, table: "attachments",
+		where: "post_id IN (SELECT id FROM posts WHERE user_id == ?1)"},
+	{data: "posts", action: domain.ErasureDelete, table: "posts", where: "user_id == ?1"},
+	{data: "co-authorships", action: domain.ErasureDelete, table: "post_authors", where: "user_id == ?1"},
+	{data: "mentions", action: domain.ErasureDelete, table: "post_mentions", where: "user_id == ?1"},
+	{data: "follows", action: domain.ErasureDelete, table: "follows", where: "follower_id == ?1 OR followee_id == ?1"},
+	{data: "blocks", action: domain.ErasureDelete, table: "user_blocks", where: "blocker_id == ?1 OR blocked_id == ?1"},
+	{data: "notifications", action: domain.ErasureDelete, table: "notifications", where: "user_id == ?1 OR actor_id == ?1"},
+	{data: "bookmarks", action: domain.ErasureDelete, table: "bookmarks", where: "user_id == ?1"},
+	{data: "reading lists", action: domain.ErasureDelete, table: "reading_lists", where: "user_id == ?1"},
+	{data: "series", action: domain.ErasureDelete, table: "series", where: "user_id == ?1"},
+	// expired right away, the data export worker deletes the archives
+	{data: "data exports", action: domain.ErasureDelete, table: "data_exports", where: "user_id == ?1",
+		set: "expires_at = CURRENT_TIMESTAMP, status = CASE WHEN status == 'pending' THEN 'failed' ELSE status END"},
+	{data: "co-author invitations sent", action: domain.ErasureAnonymize, table: "post_authors", where: "invited_by == ?1",
+		set: "invited_by = NULL"},
+	{data: "reports filed", action: domain.ErasureAnonymize, table: "post_reports", where: "reporter_id == ?1",
+		set: "details = NULL"},
+	{data: "posts featured", action: domain.ErasureAnonymize, table: "featured_posts", where: "featured_by == ?1",
+		set: "featured_by = NULL"},
+	{data: "moderation actions taken", action: domain.ErasureAnonymize, table: "moderation_actions", where: "moderator_id == ?1"},
+	{data: "account", action: domain.ErasureAnonymize, table: "users", where: "id == ?1",
+		set: "email = 'erased-' || id || '@invalid', username = 'deleted', password_hash = '', role = 'user', erased_at = CURRENT_TIMESTAMP"},
+}
+
+// erasureTimeout bounds erasing one account, deleting posts cascades widely
+const erasureTimeout = 30 * time.Second
+
+// ReadErasurePlan counts the rows each step of the erasure policy would
+// apply to for the user
+func (r *UserRepository) ReadErasurePlan(userId int) ([]domain.ErasureStep, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	steps := make([]domain.ErasureStep, len(erasurePolicy))
+
+	for i, step := range erasurePolicy {
+		steps[i] = domain.ErasureStep{Data: step.data, Action: step.action}
+
+		if err := r.db.QueryRowContext(ctx,
+			"SELECT COUNT(*) FROM "+step.table+" WHERE "+step.where, userId).Scan(&steps[i].Rows); err != nil {
+			return nil, err
+		}
+	}
+
+	return steps, nil
+}
+
+// eraseUser applies the erasure policy to the user
+func eraseUser(ctx context.Context, tx *sql.Tx, userId int) error {
+	for _, step := range erasurePolicy {
+		query := "DELETE FROM " + step.table + " WHERE " + step.where
+		switch {
+		case step.set != "":
+			query = "UPDATE " + step.table + " SET " + step.set + " WHERE " + step.where
+		case step.action == domain.ErasureAnonymize:
+			// kept as is, the anonymized account row is all that links it
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, query, userId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
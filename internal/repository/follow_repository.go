@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"web/example/internal/domain"
+)
+
+// FollowRepositoryInterface covers the social graph: follows and blocks
+type FollowRepositoryInterface interface {
+	Follow(followerId int, followeeId int) (bool, error)
+	Unfollow(followerId int, followeeId int) error
+	ReadFollowers(userId int, beforeId int, limit int) ([]domain.Follow, error)
+	ReadFollowing(userId int, beforeId int, limit int) ([]domain.Follow, error)
+	ReadFollowCounts(userId int) (*domain.FollowCounts, error)
+	Block(blockerId int, blockedId int) error
+	Unblock(blockerId int, blockedId int) error
+	IsBlocked(userId int, otherId int) (bool, error)
+	IsFollowing(followerId int, followeeId int) (bool, error)
+}
+
+// FollowRepository handles all database operations for follows and blocks
+type FollowRepository struct {
+	db *sql.DB
+}
+
+// NewFollowRepository creates a new instance of FollowRepository
+func NewFollowRepository(db *sql.DB) *FollowRepository {
+	return &FollowRepository{
+		db: db,
+	}
+}
+
+// Follow is idempotent, following twice keeps the original follow. It
+// reports whether a new follow was created.
+func (r *FollowRepository) Follow(followerId int, followeeId int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		"INSERT INTO follows (follower_id, followee_id) values (?, ?) ON CONFLICT DO NOTHING",
+		followerId, followeeId)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+
+	return n > 0, err
+}
+
+func (r *FollowRepository) Unfollow(followerId int, followeeId int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		"DELETE FROM follows WHERE follower_id == ? AND followee_id == ?",
+		followerId, followeeId)
+
+	return err
+}
+
+// readFollows pages through one side of the follows of userId, newest first.
+// beforeId is the last follow of the previous page, 0 for the first page.
+// Accounts pending deletion are left out.
+func (r *FollowRepository) readFollows(userColumn string, otherColumn string, userId int, beforeId int, limit int) ([]domain.Follow, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT f.id, u.username, f.created_at FROM follows f JOIN users u ON u.id == f."+otherColumn+
+			" WHERE f."+userColumn+" == ? AND (? == 0 OR f.id < ?) AND u.deleted_at IS NULL"+
+			" ORDER BY f.id DESC LIMIT ?",
+		userId, beforeId, beforeId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var follows []domain.Follow
+
+	for rows.Next() {
+		var f domain.Follow
+
+		if err := rows.Scan(&f.Id, &f.Username, &f.FollowedAt); err != nil {
+			return nil, err
+		}
+
+		follows = append(follows, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return follows, nil
+}
+
+// ReadFollowers lists the users following userId
+func (r *FollowRepository) ReadFollowers(userId int, beforeId int, limit int) ([]domain.Follow, error) {
+	return r.readFollows("followee_id", "follower_id", userId, beforeId, limit)
+}
+
+// ReadFollowing lists the users userId follows
+func (r *FollowRepository) ReadFollowing(userId int, beforeId int, limit int) ([]domain.Follow, error) {
+	return r.readFollows("follower_id", "followee_id", userId, beforeId, limit)
+}
+
+// ReadFollowCounts counts followers and following, without accounts pending deletion
+func (r *FollowRepository) ReadFollowCounts(userId int) (*domain.FollowCounts, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	row := r.db.QueryRowContext(ctx,
+		`SELECT
+			(SELECT COUNT(*) FROM follows f JOIN users u ON u.id == f.follower_id WHERE f.followee_id == ? AND u.deleted_at IS NULL),
+			(SELECT COUNT(*) FROM follows f JOIN users u ON u.id == f.followee_id WHERE f.follower_id == ? AND u.deleted_at IS NULL)`,
+		userId, userId)
+
+	var counts domain.FollowCounts
+
+	if err := row.Scan(&counts.Followers, &counts.Following); err != nil {
+		return nil, err
+	}
+
+	return &counts, nil
+}
+
+// Block records the block and drops the follows between both users
+func (r *FollowRepository) Block(blockerId int, blockedId int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx,
+		"INSERT INTO user_blocks (blocker_id, blocked_id) values (?, ?) ON CONFLICT DO NOTHING",
+		blockerId, blockedId); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"DELETE FROM follows WHERE (follower_id == ? AND followee_id == ?) OR (follower_id == ? AND followee_id == ?)",
+		blockerId, blockedId, blockedId, blockerId); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *FollowRepository) Unblock(blockerId int, blockedId int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		"DELETE FROM user_blocks WHERE blocker_id == ? AND blocked_id == ?",
+		blockerId, blockedId)
+
+	return err
+}
+
+// IsBlocked reports whether either user blocked the other
+func (r *FollowRepository) IsBlocked(userId int, otherId int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	var blocked bool
+
+	err := r.db.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM user_blocks WHERE (blocker_id == ? AND blocked_id == ?) OR (blocker_id == ? AND blocked_id == ?))",
+		userId, otherId, otherId, userId).Scan(&blocked)
+
+	return blocked, err
+}
+
+// IsFollowing reports whether the follower follows the followee
+func (r *FollowRepository) IsFollowing(followerId int, followeeId int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	var following bool
+
+	err := r.db.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM follows WHERE follower_id == ? AND followee_id == ?)",
+		followerId, followeeId).Scan(&following)
+
+	return following, err
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"web/example/internal/domain"
+)
+
+// ImportRepositoryInterface stores imported posts and series
+type ImportRepositoryInterface interface {
+	ImportPosts(posts []domain.PostImport, series []domain.SeriesImport) error
+}
+
+// ImportRepository handles the database side of imports
+type ImportRepository struct {
+	db *sql.DB
+}
+
+// NewImportRepository creates a new instance of ImportRepository
+func NewImportRepository(db *sql.DB) *ImportRepository {
+	return &ImportRepository{
+		db: db,
+	}
+}
+
+// importTimeout bounds a whole import, which writes many rows in one
+// transaction
+const importTimeout = 30 * time.Second
+
+// ImportPosts stores the posts as CreatePost does, keeping the times they
+// carry, and then the series. It all happens in one transaction, an error
+// leaves nothing behind. Post ids are set as they are stored, series refer
+// to the posts by pointer so they pick up the new ids.
+func (r *ImportRepository) ImportPosts(posts []domain.PostImport, series []domain.SeriesImport) error {
+	ctx, cancel := context.WithTimeout(context.Background(), importTimeout)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, p := range posts {
+		post := p.Post
+
+		res, err := tx.ExecContext(ctx,
+			`INSERT INTO posts (user_id, slug, title, content, content_format, language, status, visibility, created_at, published_at, publish_at)
+			values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			post.UserId, post.Slug, post.Title, post.Content, post.ContentFormat, post.Language, post.Status, post.Visibility,
+			p.CreatedAt.UTC().Format(sqliteTimeLayout), formatTime(p.PublishedAt), formatTime(p.PublishAt))
+		if err != nil {
+			return err
+		}
+
+		if err := insertPostDetails(ctx, tx, post, res); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range series {
+		res, err := tx.ExecContext(ctx,
+			"INSERT INTO series (user_id, title, description) values (?, ?, ?)",
+			s.Series.UserId, s.Series.Title, s.Series.Description)
+		if err != nil {
+			return err
+		}
+
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+		s.Series.Id = int(id)
+
+		for i, post := range s.Posts {
+			if _, err := tx.ExecContext(ctx,
+				"INSERT INTO series_posts (series_id, post_id, position) values (?, ?, ?)",
+				s.Series.Id, post.Id, i+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// formatTime formats t like CURRENT_TIMESTAMP, nil stays NULL
+func formatTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(sqliteTimeLayout)
+}
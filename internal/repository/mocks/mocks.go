@@ -5,18 +5,20 @@
 package mocks
 
 import (
+	"time"
 	"web/example/internal/domain"
+	"web/example/internal/repository"
 
 	mock "github.com/stretchr/testify/mock"
 )
 
-// NewMockPostRepositoryInterface creates a new instance of MockPostRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAttachmentRepositoryInterface creates a new instance of MockAttachmentRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockPostRepositoryInterface(t interface {
+func NewMockAttachmentRepositoryInterface(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockPostRepositoryInterface {
-	mock := &MockPostRepositoryInterface{}
+}) *MockAttachmentRepositoryInterface {
+	mock := &MockAttachmentRepositoryInterface{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -24,52 +26,52 @@ func NewMockPostRepositoryInterface(t interface {
 	return mock
 }
 
-// MockPostRepositoryInterface is an autogenerated mock type for the PostRepositoryInterface type
-type MockPostRepositoryInterface struct {
+// MockAttachmentRepositoryInterface is an autogenerated mock type for the AttachmentRepositoryInterface type
+type MockAttachmentRepositoryInterface struct {
 	mock.Mock
 }
 
-type MockPostRepositoryInterface_Expecter struct {
+type MockAttachmentRepositoryInterface_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockPostRepositoryInterface) EXPECT() *MockPostRepositoryInterface_Expecter {
-	return &MockPostRepositoryInterface_Expecter{mock: &_m.Mock}
+func (_m *MockAttachmentRepositoryInterface) EXPECT() *MockAttachmentRepositoryInterface_Expecter {
+	return &MockAttachmentRepositoryInterface_Expecter{mock: &_m.Mock}
 }
 
-// CreatePost provides a mock function for the type MockPostRepositoryInterface
-func (_mock *MockPostRepositoryInterface) CreatePost(post *domain.Post) error {
-	ret := _mock.Called(post)
+// CreateAttachment provides a mock function for the type MockAttachmentRepositoryInterface
+func (_mock *MockAttachmentRepositoryInterface) CreateAttachment(attachment *domain.Attachment) error {
+	ret := _mock.Called(attachment)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePost")
+		panic("no return value specified for CreateAttachment")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*domain.Post) error); ok {
-		r0 = returnFunc(post)
+	if returnFunc, ok := ret.Get(0).(func(*domain.Attachment) error); ok {
+		r0 = returnFunc(attachment)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockPostRepositoryInterface_CreatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePost'
-type MockPostRepositoryInterface_CreatePost_Call struct {
+// MockAttachmentRepositoryInterface_CreateAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAttachment'
+type MockAttachmentRepositoryInterface_CreateAttachment_Call struct {
 	*mock.Call
 }
 
-// CreatePost is a helper method to define mock.On call
-//   - post *domain.Post
-func (_e *MockPostRepositoryInterface_Expecter) CreatePost(post interface{}) *MockPostRepositoryInterface_CreatePost_Call {
-	return &MockPostRepositoryInterface_CreatePost_Call{Call: _e.mock.On("CreatePost", post)}
+// CreateAttachment is a helper method to define mock.On call
+//   - attachment *domain.Attachment
+func (_e *MockAttachmentRepositoryInterface_Expecter) CreateAttachment(attachment interface{}) *MockAttachmentRepositoryInterface_CreateAttachment_Call {
+	return &MockAttachmentRepositoryInterface_CreateAttachment_Call{Call: _e.mock.On("CreateAttachment", attachment)}
 }
 
-func (_c *MockPostRepositoryInterface_CreatePost_Call) Run(run func(post *domain.Post)) *MockPostRepositoryInterface_CreatePost_Call {
+func (_c *MockAttachmentRepositoryInterface_CreateAttachment_Call) Run(run func(attachment *domain.Attachment)) *MockAttachmentRepositoryInterface_CreateAttachment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *domain.Post
+		var arg0 *domain.Attachment
 		if args[0] != nil {
-			arg0 = args[0].(*domain.Post)
+			arg0 = args[0].(*domain.Attachment)
 		}
 		run(
 			arg0,
@@ -78,22 +80,22 @@ func (_c *MockPostRepositoryInterface_CreatePost_Call) Run(run func(post *domain
 	return _c
 }
 
-func (_c *MockPostRepositoryInterface_CreatePost_Call) Return(err error) *MockPostRepositoryInterface_CreatePost_Call {
+func (_c *MockAttachmentRepositoryInterface_CreateAttachment_Call) Return(err error) *MockAttachmentRepositoryInterface_CreateAttachment_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPostRepositoryInterface_CreatePost_Call) RunAndReturn(run func(post *domain.Post) error) *MockPostRepositoryInterface_CreatePost_Call {
+func (_c *MockAttachmentRepositoryInterface_CreateAttachment_Call) RunAndReturn(run func(attachment *domain.Attachment) error) *MockAttachmentRepositoryInterface_CreateAttachment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeletePost provides a mock function for the type MockPostRepositoryInterface
-func (_mock *MockPostRepositoryInterface) DeletePost(id int) error {
+// DeleteAttachment provides a mock function for the type MockAttachmentRepositoryInterface
+func (_mock *MockAttachmentRepositoryInterface) DeleteAttachment(id int) error {
 	ret := _mock.Called(id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeletePost")
+		panic("no return value specified for DeleteAttachment")
 	}
 
 	var r0 error
@@ -105,18 +107,18 @@ func (_mock *MockPostRepositoryInterface) DeletePost(id int) error {
 	return r0
 }
 
-// MockPostRepositoryInterface_DeletePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePost'
-type MockPostRepositoryInterface_DeletePost_Call struct {
+// MockAttachmentRepositoryInterface_DeleteAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttachment'
+type MockAttachmentRepositoryInterface_DeleteAttachment_Call struct {
 	*mock.Call
 }
 
-// DeletePost is a helper method to define mock.On call
+// DeleteAttachment is a helper method to define mock.On call
 //   - id int
-func (_e *MockPostRepositoryInterface_Expecter) DeletePost(id interface{}) *MockPostRepositoryInterface_DeletePost_Call {
-	return &MockPostRepositoryInterface_DeletePost_Call{Call: _e.mock.On("DeletePost", id)}
+func (_e *MockAttachmentRepositoryInterface_Expecter) DeleteAttachment(id interface{}) *MockAttachmentRepositoryInterface_DeleteAttachment_Call {
+	return &MockAttachmentRepositoryInterface_DeleteAttachment_Call{Call: _e.mock.On("DeleteAttachment", id)}
 }
 
-func (_c *MockPostRepositoryInterface_DeletePost_Call) Run(run func(id int)) *MockPostRepositoryInterface_DeletePost_Call {
+func (_c *MockAttachmentRepositoryInterface_DeleteAttachment_Call) Run(run func(id int)) *MockAttachmentRepositoryInterface_DeleteAttachment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
@@ -129,111 +131,118 @@ func (_c *MockPostRepositoryInterface_DeletePost_Call) Run(run func(id int)) *Mo
 	return _c
 }
 
-func (_c *MockPostRepositoryInterface_DeletePost_Call) Return(err error) *MockPostRepositoryInterface_DeletePost_Call {
+func (_c *MockAttachmentRepositoryInterface_DeleteAttachment_Call) Return(err error) *MockAttachmentRepositoryInterface_DeleteAttachment_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPostRepositoryInterface_DeletePost_Call) RunAndReturn(run func(id int) error) *MockPostRepositoryInterface_DeletePost_Call {
+func (_c *MockAttachmentRepositoryInterface_DeleteAttachment_Call) RunAndReturn(run func(id int) error) *MockAttachmentRepositoryInterface_DeleteAttachment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadAllPosts provides a mock function for the type MockPostRepositoryInterface
-func (_mock *MockPostRepositoryInterface) ReadAllPosts() ([]domain.Post, error) {
-	ret := _mock.Called()
+// ReadAttachment provides a mock function for the type MockAttachmentRepositoryInterface
+func (_mock *MockAttachmentRepositoryInterface) ReadAttachment(id int) (*domain.Attachment, error) {
+	ret := _mock.Called(id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadAllPosts")
+		panic("no return value specified for ReadAttachment")
 	}
 
-	var r0 []domain.Post
+	var r0 *domain.Attachment
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() ([]domain.Post, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(int) (*domain.Attachment, error)); ok {
+		return returnFunc(id)
 	}
-	if returnFunc, ok := ret.Get(0).(func() []domain.Post); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(int) *domain.Attachment); ok {
+		r0 = returnFunc(id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Post)
+			r0 = ret.Get(0).(*domain.Attachment)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPostRepositoryInterface_ReadAllPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAllPosts'
-type MockPostRepositoryInterface_ReadAllPosts_Call struct {
+// MockAttachmentRepositoryInterface_ReadAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAttachment'
+type MockAttachmentRepositoryInterface_ReadAttachment_Call struct {
 	*mock.Call
 }
 
-// ReadAllPosts is a helper method to define mock.On call
-func (_e *MockPostRepositoryInterface_Expecter) ReadAllPosts() *MockPostRepositoryInterface_ReadAllPosts_Call {
-	return &MockPostRepositoryInterface_ReadAllPosts_Call{Call: _e.mock.On("ReadAllPosts")}
+// ReadAttachment is a helper method to define mock.On call
+//   - id int
+func (_e *MockAttachmentRepositoryInterface_Expecter) ReadAttachment(id interface{}) *MockAttachmentRepositoryInterface_ReadAttachment_Call {
+	return &MockAttachmentRepositoryInterface_ReadAttachment_Call{Call: _e.mock.On("ReadAttachment", id)}
 }
 
-func (_c *MockPostRepositoryInterface_ReadAllPosts_Call) Run(run func()) *MockPostRepositoryInterface_ReadAllPosts_Call {
+func (_c *MockAttachmentRepositoryInterface_ReadAttachment_Call) Run(run func(id int)) *MockAttachmentRepositoryInterface_ReadAttachment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
 
-func (_c *MockPostRepositoryInterface_ReadAllPosts_Call) Return(posts []domain.Post, err error) *MockPostRepositoryInterface_ReadAllPosts_Call {
-	_c.Call.Return(posts, err)
+func (_c *MockAttachmentRepositoryInterface_ReadAttachment_Call) Return(attachment *domain.Attachment, err error) *MockAttachmentRepositoryInterface_ReadAttachment_Call {
+	_c.Call.Return(attachment, err)
 	return _c
 }
 
-func (_c *MockPostRepositoryInterface_ReadAllPosts_Call) RunAndReturn(run func() ([]domain.Post, error)) *MockPostRepositoryInterface_ReadAllPosts_Call {
+func (_c *MockAttachmentRepositoryInterface_ReadAttachment_Call) RunAndReturn(run func(id int) (*domain.Attachment, error)) *MockAttachmentRepositoryInterface_ReadAttachment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadPost provides a mock function for the type MockPostRepositoryInterface
-func (_mock *MockPostRepositoryInterface) ReadPost(id int) (*domain.Post, error) {
-	ret := _mock.Called(id)
+// ReadAttachments provides a mock function for the type MockAttachmentRepositoryInterface
+func (_mock *MockAttachmentRepositoryInterface) ReadAttachments(postId int) ([]domain.Attachment, error) {
+	ret := _mock.Called(postId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadPost")
+		panic("no return value specified for ReadAttachments")
 	}
 
-	var r0 *domain.Post
+	var r0 []domain.Attachment
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int) (*domain.Post, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.Attachment, error)); ok {
+		return returnFunc(postId)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int) *domain.Post); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.Attachment); ok {
+		r0 = returnFunc(postId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Post)
+			r0 = ret.Get(0).([]domain.Attachment)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
-		r1 = returnFunc(id)
+		r1 = returnFunc(postId)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPostRepositoryInterface_ReadPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPost'
-type MockPostRepositoryInterface_ReadPost_Call struct {
+// MockAttachmentRepositoryInterface_ReadAttachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAttachments'
+type MockAttachmentRepositoryInterface_ReadAttachments_Call struct {
 	*mock.Call
 }
 
-// ReadPost is a helper method to define mock.On call
-//   - id int
-func (_e *MockPostRepositoryInterface_Expecter) ReadPost(id interface{}) *MockPostRepositoryInterface_ReadPost_Call {
-	return &MockPostRepositoryInterface_ReadPost_Call{Call: _e.mock.On("ReadPost", id)}
+// ReadAttachments is a helper method to define mock.On call
+//   - postId int
+func (_e *MockAttachmentRepositoryInterface_Expecter) ReadAttachments(postId interface{}) *MockAttachmentRepositoryInterface_ReadAttachments_Call {
+	return &MockAttachmentRepositoryInterface_ReadAttachments_Call{Call: _e.mock.On("ReadAttachments", postId)}
 }
 
-func (_c *MockPostRepositoryInterface_ReadPost_Call) Run(run func(id int)) *MockPostRepositoryInterface_ReadPost_Call {
+func (_c *MockAttachmentRepositoryInterface_ReadAttachments_Call) Run(run func(postId int)) *MockAttachmentRepositoryInterface_ReadAttachments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
@@ -246,190 +255,262 @@ func (_c *MockPostRepositoryInterface_ReadPost_Call) Run(run func(id int)) *Mock
 	return _c
 }
 
-func (_c *MockPostRepositoryInterface_ReadPost_Call) Return(post *domain.Post, err error) *MockPostRepositoryInterface_ReadPost_Call {
-	_c.Call.Return(post, err)
+func (_c *MockAttachmentRepositoryInterface_ReadAttachments_Call) Return(attachments []domain.Attachment, err error) *MockAttachmentRepositoryInterface_ReadAttachments_Call {
+	_c.Call.Return(attachments, err)
 	return _c
 }
 
-func (_c *MockPostRepositoryInterface_ReadPost_Call) RunAndReturn(run func(id int) (*domain.Post, error)) *MockPostRepositoryInterface_ReadPost_Call {
+func (_c *MockAttachmentRepositoryInterface_ReadAttachments_Call) RunAndReturn(run func(postId int) ([]domain.Attachment, error)) *MockAttachmentRepositoryInterface_ReadAttachments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePost provides a mock function for the type MockPostRepositoryInterface
-func (_mock *MockPostRepositoryInterface) UpdatePost(id int, title string, content string) error {
-	ret := _mock.Called(id, title, content)
+// NewMockBookmarkRepositoryInterface creates a new instance of MockBookmarkRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBookmarkRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBookmarkRepositoryInterface {
+	mock := &MockBookmarkRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBookmarkRepositoryInterface is an autogenerated mock type for the BookmarkRepositoryInterface type
+type MockBookmarkRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockBookmarkRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBookmarkRepositoryInterface) EXPECT() *MockBookmarkRepositoryInterface_Expecter {
+	return &MockBookmarkRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddListItem provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) AddListItem(listId int, postId int, note string, position int) (bool, error) {
+	ret := _mock.Called(listId, postId, note, position)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePost")
+		panic("no return value specified for AddListItem")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int, string, string) error); ok {
-		r0 = returnFunc(id, title, content)
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, string, int) (bool, error)); ok {
+		return returnFunc(listId, postId, note, position)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, string, int) bool); ok {
+		r0 = returnFunc(listId, postId, note, position)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int, int, string, int) error); ok {
+		r1 = returnFunc(listId, postId, note, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockPostRepositoryInterface_UpdatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePost'
-type MockPostRepositoryInterface_UpdatePost_Call struct {
+// MockBookmarkRepositoryInterface_AddListItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddListItem'
+type MockBookmarkRepositoryInterface_AddListItem_Call struct {
 	*mock.Call
 }
 
-// UpdatePost is a helper method to define mock.On call
-//   - id int
-//   - title string
-//   - content string
-func (_e *MockPostRepositoryInterface_Expecter) UpdatePost(id interface{}, title interface{}, content interface{}) *MockPostRepositoryInterface_UpdatePost_Call {
-	return &MockPostRepositoryInterface_UpdatePost_Call{Call: _e.mock.On("UpdatePost", id, title, content)}
+// AddListItem is a helper method to define mock.On call
+//   - listId int
+//   - postId int
+//   - note string
+//   - position int
+func (_e *MockBookmarkRepositoryInterface_Expecter) AddListItem(listId interface{}, postId interface{}, note interface{}, position interface{}) *MockBookmarkRepositoryInterface_AddListItem_Call {
+	return &MockBookmarkRepositoryInterface_AddListItem_Call{Call: _e.mock.On("AddListItem", listId, postId, note, position)}
 }
 
-func (_c *MockPostRepositoryInterface_UpdatePost_Call) Run(run func(id int, title string, content string)) *MockPostRepositoryInterface_UpdatePost_Call {
+func (_c *MockBookmarkRepositoryInterface_AddListItem_Call) Run(run func(listId int, postId int, note string, position int)) *MockBookmarkRepositoryInterface_AddListItem_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
-		var arg1 string
+		var arg1 int
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(int)
 		}
 		var arg2 string
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPostRepositoryInterface_UpdatePost_Call) Return(err error) *MockPostRepositoryInterface_UpdatePost_Call {
-	_c.Call.Return(err)
+func (_c *MockBookmarkRepositoryInterface_AddListItem_Call) Return(b bool, err error) *MockBookmarkRepositoryInterface_AddListItem_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockPostRepositoryInterface_UpdatePost_Call) RunAndReturn(run func(id int, title string, content string) error) *MockPostRepositoryInterface_UpdatePost_Call {
+func (_c *MockBookmarkRepositoryInterface_AddListItem_Call) RunAndReturn(run func(listId int, postId int, note string, position int) (bool, error)) *MockBookmarkRepositoryInterface_AddListItem_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockUserRepositoryInterface creates a new instance of MockUserRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockUserRepositoryInterface(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockUserRepositoryInterface {
-	mock := &MockUserRepositoryInterface{}
-	mock.Mock.Test(t)
+// CreateReadingList provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) CreateReadingList(list *domain.ReadingList) error {
+	ret := _mock.Called(list)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReadingList")
+	}
 
-	return mock
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.ReadingList) error); ok {
+		r0 = returnFunc(list)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockUserRepositoryInterface is an autogenerated mock type for the UserRepositoryInterface type
-type MockUserRepositoryInterface struct {
-	mock.Mock
+// MockBookmarkRepositoryInterface_CreateReadingList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReadingList'
+type MockBookmarkRepositoryInterface_CreateReadingList_Call struct {
+	*mock.Call
 }
 
-type MockUserRepositoryInterface_Expecter struct {
-	mock *mock.Mock
+// CreateReadingList is a helper method to define mock.On call
+//   - list *domain.ReadingList
+func (_e *MockBookmarkRepositoryInterface_Expecter) CreateReadingList(list interface{}) *MockBookmarkRepositoryInterface_CreateReadingList_Call {
+	return &MockBookmarkRepositoryInterface_CreateReadingList_Call{Call: _e.mock.On("CreateReadingList", list)}
 }
 
-func (_m *MockUserRepositoryInterface) EXPECT() *MockUserRepositoryInterface_Expecter {
-	return &MockUserRepositoryInterface_Expecter{mock: &_m.Mock}
+func (_c *MockBookmarkRepositoryInterface_CreateReadingList_Call) Run(run func(list *domain.ReadingList)) *MockBookmarkRepositoryInterface_CreateReadingList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.ReadingList
+		if args[0] != nil {
+			arg0 = args[0].(*domain.ReadingList)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
 }
 
-// CreateUser provides a mock function for the type MockUserRepositoryInterface
-func (_mock *MockUserRepositoryInterface) CreateUser(usr *domain.User) error {
-	ret := _mock.Called(usr)
+func (_c *MockBookmarkRepositoryInterface_CreateReadingList_Call) Return(err error) *MockBookmarkRepositoryInterface_CreateReadingList_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_CreateReadingList_Call) RunAndReturn(run func(list *domain.ReadingList) error) *MockBookmarkRepositoryInterface_CreateReadingList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBookmark provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) DeleteBookmark(userId int, postId int) error {
+	ret := _mock.Called(userId, postId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for DeleteBookmark")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*domain.User) error); ok {
-		r0 = returnFunc(usr)
+	if returnFunc, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = returnFunc(userId, postId)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockUserRepositoryInterface_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type MockUserRepositoryInterface_CreateUser_Call struct {
+// MockBookmarkRepositoryInterface_DeleteBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBookmark'
+type MockBookmarkRepositoryInterface_DeleteBookmark_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
-//   - usr *domain.User
-func (_e *MockUserRepositoryInterface_Expecter) CreateUser(usr interface{}) *MockUserRepositoryInterface_CreateUser_Call {
-	return &MockUserRepositoryInterface_CreateUser_Call{Call: _e.mock.On("CreateUser", usr)}
+// DeleteBookmark is a helper method to define mock.On call
+//   - userId int
+//   - postId int
+func (_e *MockBookmarkRepositoryInterface_Expecter) DeleteBookmark(userId interface{}, postId interface{}) *MockBookmarkRepositoryInterface_DeleteBookmark_Call {
+	return &MockBookmarkRepositoryInterface_DeleteBookmark_Call{Call: _e.mock.On("DeleteBookmark", userId, postId)}
 }
 
-func (_c *MockUserRepositoryInterface_CreateUser_Call) Run(run func(usr *domain.User)) *MockUserRepositoryInterface_CreateUser_Call {
+func (_c *MockBookmarkRepositoryInterface_DeleteBookmark_Call) Run(run func(userId int, postId int)) *MockBookmarkRepositoryInterface_DeleteBookmark_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *domain.User
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(*domain.User)
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserRepositoryInterface_CreateUser_Call) Return(err error) *MockUserRepositoryInterface_CreateUser_Call {
+func (_c *MockBookmarkRepositoryInterface_DeleteBookmark_Call) Return(err error) *MockBookmarkRepositoryInterface_DeleteBookmark_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockUserRepositoryInterface_CreateUser_Call) RunAndReturn(run func(usr *domain.User) error) *MockUserRepositoryInterface_CreateUser_Call {
+func (_c *MockBookmarkRepositoryInterface_DeleteBookmark_Call) RunAndReturn(run func(userId int, postId int) error) *MockBookmarkRepositoryInterface_DeleteBookmark_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteUser provides a mock function for the type MockUserRepositoryInterface
-func (_mock *MockUserRepositoryInterface) DeleteUser(email string) error {
-	ret := _mock.Called(email)
+// DeleteReadingList provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) DeleteReadingList(id int) error {
+	ret := _mock.Called(id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteUser")
+		panic("no return value specified for DeleteReadingList")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
-		r0 = returnFunc(email)
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(id)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockUserRepositoryInterface_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
-type MockUserRepositoryInterface_DeleteUser_Call struct {
+// MockBookmarkRepositoryInterface_DeleteReadingList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReadingList'
+type MockBookmarkRepositoryInterface_DeleteReadingList_Call struct {
 	*mock.Call
 }
 
-// DeleteUser is a helper method to define mock.On call
-//   - email string
-func (_e *MockUserRepositoryInterface_Expecter) DeleteUser(email interface{}) *MockUserRepositoryInterface_DeleteUser_Call {
-	return &MockUserRepositoryInterface_DeleteUser_Call{Call: _e.mock.On("DeleteUser", email)}
+// DeleteReadingList is a helper method to define mock.On call
+//   - id int
+func (_e *MockBookmarkRepositoryInterface_Expecter) DeleteReadingList(id interface{}) *MockBookmarkRepositoryInterface_DeleteReadingList_Call {
+	return &MockBookmarkRepositoryInterface_DeleteReadingList_Call{Call: _e.mock.On("DeleteReadingList", id)}
 }
 
-func (_c *MockUserRepositoryInterface_DeleteUser_Call) Run(run func(email string)) *MockUserRepositoryInterface_DeleteUser_Call {
+func (_c *MockBookmarkRepositoryInterface_DeleteReadingList_Call) Run(run func(id int)) *MockBookmarkRepositoryInterface_DeleteReadingList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(int)
 		}
 		run(
 			arg0,
@@ -438,96 +519,7221 @@ func (_c *MockUserRepositoryInterface_DeleteUser_Call) Run(run func(email string
 	return _c
 }
 
-func (_c *MockUserRepositoryInterface_DeleteUser_Call) Return(err error) *MockUserRepositoryInterface_DeleteUser_Call {
+func (_c *MockBookmarkRepositoryInterface_DeleteReadingList_Call) Return(err error) *MockBookmarkRepositoryInterface_DeleteReadingList_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockUserRepositoryInterface_DeleteUser_Call) RunAndReturn(run func(email string) error) *MockUserRepositoryInterface_DeleteUser_Call {
+func (_c *MockBookmarkRepositoryInterface_DeleteReadingList_Call) RunAndReturn(run func(id int) error) *MockBookmarkRepositoryInterface_DeleteReadingList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadUser provides a mock function for the type MockUserRepositoryInterface
-func (_mock *MockUserRepositoryInterface) ReadUser(email string) (*domain.User, error) {
-	ret := _mock.Called(email)
+// MoveListItem provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) MoveListItem(listId int, postId int, position int) (bool, error) {
+	ret := _mock.Called(listId, postId, position)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadUser")
+		panic("no return value specified for MoveListItem")
 	}
 
-	var r0 *domain.User
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*domain.User, error)); ok {
-		return returnFunc(email)
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) (bool, error)); ok {
+		return returnFunc(listId, postId, position)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) *domain.User); ok {
-		r0 = returnFunc(email)
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) bool); ok {
+		r0 = returnFunc(listId, postId, position)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(email)
+	if returnFunc, ok := ret.Get(1).(func(int, int, int) error); ok {
+		r1 = returnFunc(listId, postId, position)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserRepositoryInterface_ReadUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUser'
-type MockUserRepositoryInterface_ReadUser_Call struct {
+// MockBookmarkRepositoryInterface_MoveListItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveListItem'
+type MockBookmarkRepositoryInterface_MoveListItem_Call struct {
 	*mock.Call
 }
 
-// ReadUser is a helper method to define mock.On call
-//   - email string
-func (_e *MockUserRepositoryInterface_Expecter) ReadUser(email interface{}) *MockUserRepositoryInterface_ReadUser_Call {
-	return &MockUserRepositoryInterface_ReadUser_Call{Call: _e.mock.On("ReadUser", email)}
+// MoveListItem is a helper method to define mock.On call
+//   - listId int
+//   - postId int
+//   - position int
+func (_e *MockBookmarkRepositoryInterface_Expecter) MoveListItem(listId interface{}, postId interface{}, position interface{}) *MockBookmarkRepositoryInterface_MoveListItem_Call {
+	return &MockBookmarkRepositoryInterface_MoveListItem_Call{Call: _e.mock.On("MoveListItem", listId, postId, position)}
 }
 
-func (_c *MockUserRepositoryInterface_ReadUser_Call) Run(run func(email string)) *MockUserRepositoryInterface_ReadUser_Call {
+func (_c *MockBookmarkRepositoryInterface_MoveListItem_Call) Run(run func(listId int, postId int, position int)) *MockBookmarkRepositoryInterface_MoveListItem_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserRepositoryInterface_ReadUser_Call) Return(user *domain.User, err error) *MockUserRepositoryInterface_ReadUser_Call {
-	_c.Call.Return(user, err)
+func (_c *MockBookmarkRepositoryInterface_MoveListItem_Call) Return(b bool, err error) *MockBookmarkRepositoryInterface_MoveListItem_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockUserRepositoryInterface_ReadUser_Call) RunAndReturn(run func(email string) (*domain.User, error)) *MockUserRepositoryInterface_ReadUser_Call {
+func (_c *MockBookmarkRepositoryInterface_MoveListItem_Call) RunAndReturn(run func(listId int, postId int, position int) (bool, error)) *MockBookmarkRepositoryInterface_MoveListItem_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUsername provides a mock function for the type MockUserRepositoryInterface
-func (_mock *MockUserRepositoryInterface) UpdateUsername(email string, new_username string) error {
-	ret := _mock.Called(email, new_username)
+// ReadBookmarks provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) ReadBookmarks(userId int, beforeId int, limit int) ([]domain.Bookmark, error) {
+	ret := _mock.Called(userId, beforeId, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUsername")
+		panic("no return value specified for ReadBookmarks")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = returnFunc(email, new_username)
+	var r0 []domain.Bookmark
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) ([]domain.Bookmark, error)); ok {
+		return returnFunc(userId, beforeId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) []domain.Bookmark); ok {
+		r0 = returnFunc(userId, beforeId, limit)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Bookmark)
+		}
 	}
-	return r0
-}
-
-// MockUserRepositoryInterface_UpdateUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUsername'
+	if returnFunc, ok := ret.Get(1).(func(int, int, int) error); ok {
+		r1 = returnFunc(userId, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookmarkRepositoryInterface_ReadBookmarks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadBookmarks'
+type MockBookmarkRepositoryInterface_ReadBookmarks_Call struct {
+	*mock.Call
+}
+
+// ReadBookmarks is a helper method to define mock.On call
+//   - userId int
+//   - beforeId int
+//   - limit int
+func (_e *MockBookmarkRepositoryInterface_Expecter) ReadBookmarks(userId interface{}, beforeId interface{}, limit interface{}) *MockBookmarkRepositoryInterface_ReadBookmarks_Call {
+	return &MockBookmarkRepositoryInterface_ReadBookmarks_Call{Call: _e.mock.On("ReadBookmarks", userId, beforeId, limit)}
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadBookmarks_Call) Run(run func(userId int, beforeId int, limit int)) *MockBookmarkRepositoryInterface_ReadBookmarks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadBookmarks_Call) Return(bookmarks []domain.Bookmark, err error) *MockBookmarkRepositoryInterface_ReadBookmarks_Call {
+	_c.Call.Return(bookmarks, err)
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadBookmarks_Call) RunAndReturn(run func(userId int, beforeId int, limit int) ([]domain.Bookmark, error)) *MockBookmarkRepositoryInterface_ReadBookmarks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadListItems provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) ReadListItems(listId int) ([]domain.ReadingListItem, error) {
+	ret := _mock.Called(listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadListItems")
+	}
+
+	var r0 []domain.ReadingListItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.ReadingListItem, error)); ok {
+		return returnFunc(listId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.ReadingListItem); ok {
+		r0 = returnFunc(listId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ReadingListItem)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(listId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookmarkRepositoryInterface_ReadListItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadListItems'
+type MockBookmarkRepositoryInterface_ReadListItems_Call struct {
+	*mock.Call
+}
+
+// ReadListItems is a helper method to define mock.On call
+//   - listId int
+func (_e *MockBookmarkRepositoryInterface_Expecter) ReadListItems(listId interface{}) *MockBookmarkRepositoryInterface_ReadListItems_Call {
+	return &MockBookmarkRepositoryInterface_ReadListItems_Call{Call: _e.mock.On("ReadListItems", listId)}
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadListItems_Call) Run(run func(listId int)) *MockBookmarkRepositoryInterface_ReadListItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadListItems_Call) Return(readingListItems []domain.ReadingListItem, err error) *MockBookmarkRepositoryInterface_ReadListItems_Call {
+	_c.Call.Return(readingListItems, err)
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadListItems_Call) RunAndReturn(run func(listId int) ([]domain.ReadingListItem, error)) *MockBookmarkRepositoryInterface_ReadListItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadReadingList provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) ReadReadingList(id int) (*domain.ReadingList, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadReadingList")
+	}
+
+	var r0 *domain.ReadingList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (*domain.ReadingList, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) *domain.ReadingList); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ReadingList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookmarkRepositoryInterface_ReadReadingList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadReadingList'
+type MockBookmarkRepositoryInterface_ReadReadingList_Call struct {
+	*mock.Call
+}
+
+// ReadReadingList is a helper method to define mock.On call
+//   - id int
+func (_e *MockBookmarkRepositoryInterface_Expecter) ReadReadingList(id interface{}) *MockBookmarkRepositoryInterface_ReadReadingList_Call {
+	return &MockBookmarkRepositoryInterface_ReadReadingList_Call{Call: _e.mock.On("ReadReadingList", id)}
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadReadingList_Call) Run(run func(id int)) *MockBookmarkRepositoryInterface_ReadReadingList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadReadingList_Call) Return(readingList *domain.ReadingList, err error) *MockBookmarkRepositoryInterface_ReadReadingList_Call {
+	_c.Call.Return(readingList, err)
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadReadingList_Call) RunAndReturn(run func(id int) (*domain.ReadingList, error)) *MockBookmarkRepositoryInterface_ReadReadingList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadReadingListByToken provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) ReadReadingListByToken(token string) (*domain.ReadingList, error) {
+	ret := _mock.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadReadingListByToken")
+	}
+
+	var r0 *domain.ReadingList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.ReadingList, error)); ok {
+		return returnFunc(token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.ReadingList); ok {
+		r0 = returnFunc(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ReadingList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookmarkRepositoryInterface_ReadReadingListByToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadReadingListByToken'
+type MockBookmarkRepositoryInterface_ReadReadingListByToken_Call struct {
+	*mock.Call
+}
+
+// ReadReadingListByToken is a helper method to define mock.On call
+//   - token string
+func (_e *MockBookmarkRepositoryInterface_Expecter) ReadReadingListByToken(token interface{}) *MockBookmarkRepositoryInterface_ReadReadingListByToken_Call {
+	return &MockBookmarkRepositoryInterface_ReadReadingListByToken_Call{Call: _e.mock.On("ReadReadingListByToken", token)}
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadReadingListByToken_Call) Run(run func(token string)) *MockBookmarkRepositoryInterface_ReadReadingListByToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadReadingListByToken_Call) Return(readingList *domain.ReadingList, err error) *MockBookmarkRepositoryInterface_ReadReadingListByToken_Call {
+	_c.Call.Return(readingList, err)
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadReadingListByToken_Call) RunAndReturn(run func(token string) (*domain.ReadingList, error)) *MockBookmarkRepositoryInterface_ReadReadingListByToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadReadingLists provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) ReadReadingLists(userId int) ([]domain.ReadingList, error) {
+	ret := _mock.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadReadingLists")
+	}
+
+	var r0 []domain.ReadingList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.ReadingList, error)); ok {
+		return returnFunc(userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.ReadingList); ok {
+		r0 = returnFunc(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ReadingList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookmarkRepositoryInterface_ReadReadingLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadReadingLists'
+type MockBookmarkRepositoryInterface_ReadReadingLists_Call struct {
+	*mock.Call
+}
+
+// ReadReadingLists is a helper method to define mock.On call
+//   - userId int
+func (_e *MockBookmarkRepositoryInterface_Expecter) ReadReadingLists(userId interface{}) *MockBookmarkRepositoryInterface_ReadReadingLists_Call {
+	return &MockBookmarkRepositoryInterface_ReadReadingLists_Call{Call: _e.mock.On("ReadReadingLists", userId)}
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadReadingLists_Call) Run(run func(userId int)) *MockBookmarkRepositoryInterface_ReadReadingLists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadReadingLists_Call) Return(readingLists []domain.ReadingList, err error) *MockBookmarkRepositoryInterface_ReadReadingLists_Call {
+	_c.Call.Return(readingLists, err)
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_ReadReadingLists_Call) RunAndReturn(run func(userId int) ([]domain.ReadingList, error)) *MockBookmarkRepositoryInterface_ReadReadingLists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveListItem provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) RemoveListItem(listId int, postId int) error {
+	ret := _mock.Called(listId, postId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveListItem")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = returnFunc(listId, postId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBookmarkRepositoryInterface_RemoveListItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveListItem'
+type MockBookmarkRepositoryInterface_RemoveListItem_Call struct {
+	*mock.Call
+}
+
+// RemoveListItem is a helper method to define mock.On call
+//   - listId int
+//   - postId int
+func (_e *MockBookmarkRepositoryInterface_Expecter) RemoveListItem(listId interface{}, postId interface{}) *MockBookmarkRepositoryInterface_RemoveListItem_Call {
+	return &MockBookmarkRepositoryInterface_RemoveListItem_Call{Call: _e.mock.On("RemoveListItem", listId, postId)}
+}
+
+func (_c *MockBookmarkRepositoryInterface_RemoveListItem_Call) Run(run func(listId int, postId int)) *MockBookmarkRepositoryInterface_RemoveListItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_RemoveListItem_Call) Return(err error) *MockBookmarkRepositoryInterface_RemoveListItem_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_RemoveListItem_Call) RunAndReturn(run func(listId int, postId int) error) *MockBookmarkRepositoryInterface_RemoveListItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveBookmark provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) SaveBookmark(userId int, postId int, note string) error {
+	ret := _mock.Called(userId, postId, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveBookmark")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, string) error); ok {
+		r0 = returnFunc(userId, postId, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBookmarkRepositoryInterface_SaveBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBookmark'
+type MockBookmarkRepositoryInterface_SaveBookmark_Call struct {
+	*mock.Call
+}
+
+// SaveBookmark is a helper method to define mock.On call
+//   - userId int
+//   - postId int
+//   - note string
+func (_e *MockBookmarkRepositoryInterface_Expecter) SaveBookmark(userId interface{}, postId interface{}, note interface{}) *MockBookmarkRepositoryInterface_SaveBookmark_Call {
+	return &MockBookmarkRepositoryInterface_SaveBookmark_Call{Call: _e.mock.On("SaveBookmark", userId, postId, note)}
+}
+
+func (_c *MockBookmarkRepositoryInterface_SaveBookmark_Call) Run(run func(userId int, postId int, note string)) *MockBookmarkRepositoryInterface_SaveBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_SaveBookmark_Call) Return(err error) *MockBookmarkRepositoryInterface_SaveBookmark_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_SaveBookmark_Call) RunAndReturn(run func(userId int, postId int, note string) error) *MockBookmarkRepositoryInterface_SaveBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateListItemNote provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) UpdateListItemNote(listId int, postId int, note string) (bool, error) {
+	ret := _mock.Called(listId, postId, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateListItemNote")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, string) (bool, error)); ok {
+		return returnFunc(listId, postId, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, string) bool); ok {
+		r0 = returnFunc(listId, postId, note)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, string) error); ok {
+		r1 = returnFunc(listId, postId, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookmarkRepositoryInterface_UpdateListItemNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateListItemNote'
+type MockBookmarkRepositoryInterface_UpdateListItemNote_Call struct {
+	*mock.Call
+}
+
+// UpdateListItemNote is a helper method to define mock.On call
+//   - listId int
+//   - postId int
+//   - note string
+func (_e *MockBookmarkRepositoryInterface_Expecter) UpdateListItemNote(listId interface{}, postId interface{}, note interface{}) *MockBookmarkRepositoryInterface_UpdateListItemNote_Call {
+	return &MockBookmarkRepositoryInterface_UpdateListItemNote_Call{Call: _e.mock.On("UpdateListItemNote", listId, postId, note)}
+}
+
+func (_c *MockBookmarkRepositoryInterface_UpdateListItemNote_Call) Run(run func(listId int, postId int, note string)) *MockBookmarkRepositoryInterface_UpdateListItemNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_UpdateListItemNote_Call) Return(b bool, err error) *MockBookmarkRepositoryInterface_UpdateListItemNote_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_UpdateListItemNote_Call) RunAndReturn(run func(listId int, postId int, note string) (bool, error)) *MockBookmarkRepositoryInterface_UpdateListItemNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReadingList provides a mock function for the type MockBookmarkRepositoryInterface
+func (_mock *MockBookmarkRepositoryInterface) UpdateReadingList(list *domain.ReadingList) error {
+	ret := _mock.Called(list)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReadingList")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.ReadingList) error); ok {
+		r0 = returnFunc(list)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBookmarkRepositoryInterface_UpdateReadingList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReadingList'
+type MockBookmarkRepositoryInterface_UpdateReadingList_Call struct {
+	*mock.Call
+}
+
+// UpdateReadingList is a helper method to define mock.On call
+//   - list *domain.ReadingList
+func (_e *MockBookmarkRepositoryInterface_Expecter) UpdateReadingList(list interface{}) *MockBookmarkRepositoryInterface_UpdateReadingList_Call {
+	return &MockBookmarkRepositoryInterface_UpdateReadingList_Call{Call: _e.mock.On("UpdateReadingList", list)}
+}
+
+func (_c *MockBookmarkRepositoryInterface_UpdateReadingList_Call) Run(run func(list *domain.ReadingList)) *MockBookmarkRepositoryInterface_UpdateReadingList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.ReadingList
+		if args[0] != nil {
+			arg0 = args[0].(*domain.ReadingList)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_UpdateReadingList_Call) Return(err error) *MockBookmarkRepositoryInterface_UpdateReadingList_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookmarkRepositoryInterface_UpdateReadingList_Call) RunAndReturn(run func(list *domain.ReadingList) error) *MockBookmarkRepositoryInterface_UpdateReadingList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockClassifierRepositoryInterface creates a new instance of MockClassifierRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClassifierRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClassifierRepositoryInterface {
+	mock := &MockClassifierRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockClassifierRepositoryInterface is an autogenerated mock type for the ClassifierRepositoryInterface type
+type MockClassifierRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockClassifierRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClassifierRepositoryInterface) EXPECT() *MockClassifierRepositoryInterface_Expecter {
+	return &MockClassifierRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// ReadDocumentCounts provides a mock function for the type MockClassifierRepositoryInterface
+func (_mock *MockClassifierRepositoryInterface) ReadDocumentCounts() (int, int, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDocumentCounts")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func() (int, int, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func() int); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func() error); ok {
+		r2 = returnFunc()
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockClassifierRepositoryInterface_ReadDocumentCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDocumentCounts'
+type MockClassifierRepositoryInterface_ReadDocumentCounts_Call struct {
+	*mock.Call
+}
+
+// ReadDocumentCounts is a helper method to define mock.On call
+func (_e *MockClassifierRepositoryInterface_Expecter) ReadDocumentCounts() *MockClassifierRepositoryInterface_ReadDocumentCounts_Call {
+	return &MockClassifierRepositoryInterface_ReadDocumentCounts_Call{Call: _e.mock.On("ReadDocumentCounts")}
+}
+
+func (_c *MockClassifierRepositoryInterface_ReadDocumentCounts_Call) Run(run func()) *MockClassifierRepositoryInterface_ReadDocumentCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClassifierRepositoryInterface_ReadDocumentCounts_Call) Return(n int, n1 int, err error) *MockClassifierRepositoryInterface_ReadDocumentCounts_Call {
+	_c.Call.Return(n, n1, err)
+	return _c
+}
+
+func (_c *MockClassifierRepositoryInterface_ReadDocumentCounts_Call) RunAndReturn(run func() (int, int, error)) *MockClassifierRepositoryInterface_ReadDocumentCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTokenCounts provides a mock function for the type MockClassifierRepositoryInterface
+func (_mock *MockClassifierRepositoryInterface) ReadTokenCounts(tokens []string) (map[string]domain.TokenCount, error) {
+	ret := _mock.Called(tokens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTokenCounts")
+	}
+
+	var r0 map[string]domain.TokenCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string) (map[string]domain.TokenCount, error)); ok {
+		return returnFunc(tokens)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string) map[string]domain.TokenCount); ok {
+		r0 = returnFunc(tokens)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]domain.TokenCount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = returnFunc(tokens)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClassifierRepositoryInterface_ReadTokenCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTokenCounts'
+type MockClassifierRepositoryInterface_ReadTokenCounts_Call struct {
+	*mock.Call
+}
+
+// ReadTokenCounts is a helper method to define mock.On call
+//   - tokens []string
+func (_e *MockClassifierRepositoryInterface_Expecter) ReadTokenCounts(tokens interface{}) *MockClassifierRepositoryInterface_ReadTokenCounts_Call {
+	return &MockClassifierRepositoryInterface_ReadTokenCounts_Call{Call: _e.mock.On("ReadTokenCounts", tokens)}
+}
+
+func (_c *MockClassifierRepositoryInterface_ReadTokenCounts_Call) Run(run func(tokens []string)) *MockClassifierRepositoryInterface_ReadTokenCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClassifierRepositoryInterface_ReadTokenCounts_Call) Return(stringToTokenCount map[string]domain.TokenCount, err error) *MockClassifierRepositoryInterface_ReadTokenCounts_Call {
+	_c.Call.Return(stringToTokenCount, err)
+	return _c
+}
+
+func (_c *MockClassifierRepositoryInterface_ReadTokenCounts_Call) RunAndReturn(run func(tokens []string) (map[string]domain.TokenCount, error)) *MockClassifierRepositoryInterface_ReadTokenCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Train provides a mock function for the type MockClassifierRepositoryInterface
+func (_mock *MockClassifierRepositoryInterface) Train(postId int, spam bool, tokens []string) error {
+	ret := _mock.Called(postId, spam, tokens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Train")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, bool, []string) error); ok {
+		r0 = returnFunc(postId, spam, tokens)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClassifierRepositoryInterface_Train_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Train'
+type MockClassifierRepositoryInterface_Train_Call struct {
+	*mock.Call
+}
+
+// Train is a helper method to define mock.On call
+//   - postId int
+//   - spam bool
+//   - tokens []string
+func (_e *MockClassifierRepositoryInterface_Expecter) Train(postId interface{}, spam interface{}, tokens interface{}) *MockClassifierRepositoryInterface_Train_Call {
+	return &MockClassifierRepositoryInterface_Train_Call{Call: _e.mock.On("Train", postId, spam, tokens)}
+}
+
+func (_c *MockClassifierRepositoryInterface_Train_Call) Run(run func(postId int, spam bool, tokens []string)) *MockClassifierRepositoryInterface_Train_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClassifierRepositoryInterface_Train_Call) Return(err error) *MockClassifierRepositoryInterface_Train_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClassifierRepositoryInterface_Train_Call) RunAndReturn(run func(postId int, spam bool, tokens []string) error) *MockClassifierRepositoryInterface_Train_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDataExportRepositoryInterface creates a new instance of MockDataExportRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDataExportRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDataExportRepositoryInterface {
+	mock := &MockDataExportRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDataExportRepositoryInterface is an autogenerated mock type for the DataExportRepositoryInterface type
+type MockDataExportRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockDataExportRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDataExportRepositoryInterface) EXPECT() *MockDataExportRepositoryInterface_Expecter {
+	return &MockDataExportRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDataExportDownload provides a mock function for the type MockDataExportRepositoryInterface
+func (_mock *MockDataExportRepositoryInterface) ClaimDataExportDownload(id int, now time.Time) (bool, error) {
+	ret := _mock.Called(id, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDataExportDownload")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, time.Time) (bool, error)); ok {
+		return returnFunc(id, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, time.Time) bool); ok {
+		r0 = returnFunc(id, now)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, time.Time) error); ok {
+		r1 = returnFunc(id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDataExportRepositoryInterface_ClaimDataExportDownload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDataExportDownload'
+type MockDataExportRepositoryInterface_ClaimDataExportDownload_Call struct {
+	*mock.Call
+}
+
+// ClaimDataExportDownload is a helper method to define mock.On call
+//   - id int
+//   - now time.Time
+func (_e *MockDataExportRepositoryInterface_Expecter) ClaimDataExportDownload(id interface{}, now interface{}) *MockDataExportRepositoryInterface_ClaimDataExportDownload_Call {
+	return &MockDataExportRepositoryInterface_ClaimDataExportDownload_Call{Call: _e.mock.On("ClaimDataExportDownload", id, now)}
+}
+
+func (_c *MockDataExportRepositoryInterface_ClaimDataExportDownload_Call) Run(run func(id int, now time.Time)) *MockDataExportRepositoryInterface_ClaimDataExportDownload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ClaimDataExportDownload_Call) Return(b bool, err error) *MockDataExportRepositoryInterface_ClaimDataExportDownload_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ClaimDataExportDownload_Call) RunAndReturn(run func(id int, now time.Time) (bool, error)) *MockDataExportRepositoryInterface_ClaimDataExportDownload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClearDataExportBlob provides a mock function for the type MockDataExportRepositoryInterface
+func (_mock *MockDataExportRepositoryInterface) ClearDataExportBlob(id int) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearDataExportBlob")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDataExportRepositoryInterface_ClearDataExportBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearDataExportBlob'
+type MockDataExportRepositoryInterface_ClearDataExportBlob_Call struct {
+	*mock.Call
+}
+
+// ClearDataExportBlob is a helper method to define mock.On call
+//   - id int
+func (_e *MockDataExportRepositoryInterface_Expecter) ClearDataExportBlob(id interface{}) *MockDataExportRepositoryInterface_ClearDataExportBlob_Call {
+	return &MockDataExportRepositoryInterface_ClearDataExportBlob_Call{Call: _e.mock.On("ClearDataExportBlob", id)}
+}
+
+func (_c *MockDataExportRepositoryInterface_ClearDataExportBlob_Call) Run(run func(id int)) *MockDataExportRepositoryInterface_ClearDataExportBlob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ClearDataExportBlob_Call) Return(err error) *MockDataExportRepositoryInterface_ClearDataExportBlob_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ClearDataExportBlob_Call) RunAndReturn(run func(id int) error) *MockDataExportRepositoryInterface_ClearDataExportBlob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDataExport provides a mock function for the type MockDataExportRepositoryInterface
+func (_mock *MockDataExportRepositoryInterface) CreateDataExport(export *domain.DataExport) error {
+	ret := _mock.Called(export)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDataExport")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.DataExport) error); ok {
+		r0 = returnFunc(export)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDataExportRepositoryInterface_CreateDataExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDataExport'
+type MockDataExportRepositoryInterface_CreateDataExport_Call struct {
+	*mock.Call
+}
+
+// CreateDataExport is a helper method to define mock.On call
+//   - export *domain.DataExport
+func (_e *MockDataExportRepositoryInterface_Expecter) CreateDataExport(export interface{}) *MockDataExportRepositoryInterface_CreateDataExport_Call {
+	return &MockDataExportRepositoryInterface_CreateDataExport_Call{Call: _e.mock.On("CreateDataExport", export)}
+}
+
+func (_c *MockDataExportRepositoryInterface_CreateDataExport_Call) Run(run func(export *domain.DataExport)) *MockDataExportRepositoryInterface_CreateDataExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.DataExport
+		if args[0] != nil {
+			arg0 = args[0].(*domain.DataExport)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_CreateDataExport_Call) Return(err error) *MockDataExportRepositoryInterface_CreateDataExport_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_CreateDataExport_Call) RunAndReturn(run func(export *domain.DataExport) error) *MockDataExportRepositoryInterface_CreateDataExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkDataExportFailed provides a mock function for the type MockDataExportRepositoryInterface
+func (_mock *MockDataExportRepositoryInterface) MarkDataExportFailed(id int, reason string) error {
+	ret := _mock.Called(id, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDataExportFailed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = returnFunc(id, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDataExportRepositoryInterface_MarkDataExportFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDataExportFailed'
+type MockDataExportRepositoryInterface_MarkDataExportFailed_Call struct {
+	*mock.Call
+}
+
+// MarkDataExportFailed is a helper method to define mock.On call
+//   - id int
+//   - reason string
+func (_e *MockDataExportRepositoryInterface_Expecter) MarkDataExportFailed(id interface{}, reason interface{}) *MockDataExportRepositoryInterface_MarkDataExportFailed_Call {
+	return &MockDataExportRepositoryInterface_MarkDataExportFailed_Call{Call: _e.mock.On("MarkDataExportFailed", id, reason)}
+}
+
+func (_c *MockDataExportRepositoryInterface_MarkDataExportFailed_Call) Run(run func(id int, reason string)) *MockDataExportRepositoryInterface_MarkDataExportFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_MarkDataExportFailed_Call) Return(err error) *MockDataExportRepositoryInterface_MarkDataExportFailed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_MarkDataExportFailed_Call) RunAndReturn(run func(id int, reason string) error) *MockDataExportRepositoryInterface_MarkDataExportFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkDataExportReady provides a mock function for the type MockDataExportRepositoryInterface
+func (_mock *MockDataExportRepositoryInterface) MarkDataExportReady(id int, storageKey string, size int64, expiresAt time.Time) (bool, error) {
+	ret := _mock.Called(id, storageKey, size, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDataExportReady")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, int64, time.Time) (bool, error)); ok {
+		return returnFunc(id, storageKey, size, expiresAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string, int64, time.Time) bool); ok {
+		r0 = returnFunc(id, storageKey, size, expiresAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string, int64, time.Time) error); ok {
+		r1 = returnFunc(id, storageKey, size, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDataExportRepositoryInterface_MarkDataExportReady_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDataExportReady'
+type MockDataExportRepositoryInterface_MarkDataExportReady_Call struct {
+	*mock.Call
+}
+
+// MarkDataExportReady is a helper method to define mock.On call
+//   - id int
+//   - storageKey string
+//   - size int64
+//   - expiresAt time.Time
+func (_e *MockDataExportRepositoryInterface_Expecter) MarkDataExportReady(id interface{}, storageKey interface{}, size interface{}, expiresAt interface{}) *MockDataExportRepositoryInterface_MarkDataExportReady_Call {
+	return &MockDataExportRepositoryInterface_MarkDataExportReady_Call{Call: _e.mock.On("MarkDataExportReady", id, storageKey, size, expiresAt)}
+}
+
+func (_c *MockDataExportRepositoryInterface_MarkDataExportReady_Call) Run(run func(id int, storageKey string, size int64, expiresAt time.Time)) *MockDataExportRepositoryInterface_MarkDataExportReady_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_MarkDataExportReady_Call) Return(b bool, err error) *MockDataExportRepositoryInterface_MarkDataExportReady_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_MarkDataExportReady_Call) RunAndReturn(run func(id int, storageKey string, size int64, expiresAt time.Time) (bool, error)) *MockDataExportRepositoryInterface_MarkDataExportReady_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadDataExport provides a mock function for the type MockDataExportRepositoryInterface
+func (_mock *MockDataExportRepositoryInterface) ReadDataExport(id int) (*domain.DataExport, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDataExport")
+	}
+
+	var r0 *domain.DataExport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (*domain.DataExport, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) *domain.DataExport); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DataExport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDataExportRepositoryInterface_ReadDataExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDataExport'
+type MockDataExportRepositoryInterface_ReadDataExport_Call struct {
+	*mock.Call
+}
+
+// ReadDataExport is a helper method to define mock.On call
+//   - id int
+func (_e *MockDataExportRepositoryInterface_Expecter) ReadDataExport(id interface{}) *MockDataExportRepositoryInterface_ReadDataExport_Call {
+	return &MockDataExportRepositoryInterface_ReadDataExport_Call{Call: _e.mock.On("ReadDataExport", id)}
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadDataExport_Call) Run(run func(id int)) *MockDataExportRepositoryInterface_ReadDataExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadDataExport_Call) Return(dataExport *domain.DataExport, err error) *MockDataExportRepositoryInterface_ReadDataExport_Call {
+	_c.Call.Return(dataExport, err)
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadDataExport_Call) RunAndReturn(run func(id int) (*domain.DataExport, error)) *MockDataExportRepositoryInterface_ReadDataExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadPendingDataExports provides a mock function for the type MockDataExportRepositoryInterface
+func (_mock *MockDataExportRepositoryInterface) ReadPendingDataExports(limit int) ([]domain.DataExport, error) {
+	ret := _mock.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPendingDataExports")
+	}
+
+	var r0 []domain.DataExport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.DataExport, error)); ok {
+		return returnFunc(limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.DataExport); ok {
+		r0 = returnFunc(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.DataExport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDataExportRepositoryInterface_ReadPendingDataExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPendingDataExports'
+type MockDataExportRepositoryInterface_ReadPendingDataExports_Call struct {
+	*mock.Call
+}
+
+// ReadPendingDataExports is a helper method to define mock.On call
+//   - limit int
+func (_e *MockDataExportRepositoryInterface_Expecter) ReadPendingDataExports(limit interface{}) *MockDataExportRepositoryInterface_ReadPendingDataExports_Call {
+	return &MockDataExportRepositoryInterface_ReadPendingDataExports_Call{Call: _e.mock.On("ReadPendingDataExports", limit)}
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadPendingDataExports_Call) Run(run func(limit int)) *MockDataExportRepositoryInterface_ReadPendingDataExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadPendingDataExports_Call) Return(dataExports []domain.DataExport, err error) *MockDataExportRepositoryInterface_ReadPendingDataExports_Call {
+	_c.Call.Return(dataExports, err)
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadPendingDataExports_Call) RunAndReturn(run func(limit int) ([]domain.DataExport, error)) *MockDataExportRepositoryInterface_ReadPendingDataExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadPersonalData provides a mock function for the type MockDataExportRepositoryInterface
+func (_mock *MockDataExportRepositoryInterface) ReadPersonalData(userId int) ([]domain.DataSection, error) {
+	ret := _mock.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPersonalData")
+	}
+
+	var r0 []domain.DataSection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.DataSection, error)); ok {
+		return returnFunc(userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.DataSection); ok {
+		r0 = returnFunc(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.DataSection)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDataExportRepositoryInterface_ReadPersonalData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPersonalData'
+type MockDataExportRepositoryInterface_ReadPersonalData_Call struct {
+	*mock.Call
+}
+
+// ReadPersonalData is a helper method to define mock.On call
+//   - userId int
+func (_e *MockDataExportRepositoryInterface_Expecter) ReadPersonalData(userId interface{}) *MockDataExportRepositoryInterface_ReadPersonalData_Call {
+	return &MockDataExportRepositoryInterface_ReadPersonalData_Call{Call: _e.mock.On("ReadPersonalData", userId)}
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadPersonalData_Call) Run(run func(userId int)) *MockDataExportRepositoryInterface_ReadPersonalData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadPersonalData_Call) Return(dataSections []domain.DataSection, err error) *MockDataExportRepositoryInterface_ReadPersonalData_Call {
+	_c.Call.Return(dataSections, err)
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadPersonalData_Call) RunAndReturn(run func(userId int) ([]domain.DataSection, error)) *MockDataExportRepositoryInterface_ReadPersonalData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadStaleDataExports provides a mock function for the type MockDataExportRepositoryInterface
+func (_mock *MockDataExportRepositoryInterface) ReadStaleDataExports(now time.Time) ([]domain.DataExport, error) {
+	ret := _mock.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadStaleDataExports")
+	}
+
+	var r0 []domain.DataExport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(time.Time) ([]domain.DataExport, error)); ok {
+		return returnFunc(now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(time.Time) []domain.DataExport); ok {
+		r0 = returnFunc(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.DataExport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = returnFunc(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDataExportRepositoryInterface_ReadStaleDataExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadStaleDataExports'
+type MockDataExportRepositoryInterface_ReadStaleDataExports_Call struct {
+	*mock.Call
+}
+
+// ReadStaleDataExports is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockDataExportRepositoryInterface_Expecter) ReadStaleDataExports(now interface{}) *MockDataExportRepositoryInterface_ReadStaleDataExports_Call {
+	return &MockDataExportRepositoryInterface_ReadStaleDataExports_Call{Call: _e.mock.On("ReadStaleDataExports", now)}
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadStaleDataExports_Call) Run(run func(now time.Time)) *MockDataExportRepositoryInterface_ReadStaleDataExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Time
+		if args[0] != nil {
+			arg0 = args[0].(time.Time)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadStaleDataExports_Call) Return(dataExports []domain.DataExport, err error) *MockDataExportRepositoryInterface_ReadStaleDataExports_Call {
+	_c.Call.Return(dataExports, err)
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadStaleDataExports_Call) RunAndReturn(run func(now time.Time) ([]domain.DataExport, error)) *MockDataExportRepositoryInterface_ReadStaleDataExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUserDataExports provides a mock function for the type MockDataExportRepositoryInterface
+func (_mock *MockDataExportRepositoryInterface) ReadUserDataExports(userId int) ([]domain.DataExport, error) {
+	ret := _mock.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUserDataExports")
+	}
+
+	var r0 []domain.DataExport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.DataExport, error)); ok {
+		return returnFunc(userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.DataExport); ok {
+		r0 = returnFunc(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.DataExport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDataExportRepositoryInterface_ReadUserDataExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUserDataExports'
+type MockDataExportRepositoryInterface_ReadUserDataExports_Call struct {
+	*mock.Call
+}
+
+// ReadUserDataExports is a helper method to define mock.On call
+//   - userId int
+func (_e *MockDataExportRepositoryInterface_Expecter) ReadUserDataExports(userId interface{}) *MockDataExportRepositoryInterface_ReadUserDataExports_Call {
+	return &MockDataExportRepositoryInterface_ReadUserDataExports_Call{Call: _e.mock.On("ReadUserDataExports", userId)}
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadUserDataExports_Call) Run(run func(userId int)) *MockDataExportRepositoryInterface_ReadUserDataExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadUserDataExports_Call) Return(dataExports []domain.DataExport, err error) *MockDataExportRepositoryInterface_ReadUserDataExports_Call {
+	_c.Call.Return(dataExports, err)
+	return _c
+}
+
+func (_c *MockDataExportRepositoryInterface_ReadUserDataExports_Call) RunAndReturn(run func(userId int) ([]domain.DataExport, error)) *MockDataExportRepositoryInterface_ReadUserDataExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFollowRepositoryInterface creates a new instance of MockFollowRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFollowRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFollowRepositoryInterface {
+	mock := &MockFollowRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockFollowRepositoryInterface is an autogenerated mock type for the FollowRepositoryInterface type
+type MockFollowRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockFollowRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFollowRepositoryInterface) EXPECT() *MockFollowRepositoryInterface_Expecter {
+	return &MockFollowRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// Block provides a mock function for the type MockFollowRepositoryInterface
+func (_mock *MockFollowRepositoryInterface) Block(blockerId int, blockedId int) error {
+	ret := _mock.Called(blockerId, blockedId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Block")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = returnFunc(blockerId, blockedId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFollowRepositoryInterface_Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Block'
+type MockFollowRepositoryInterface_Block_Call struct {
+	*mock.Call
+}
+
+// Block is a helper method to define mock.On call
+//   - blockerId int
+//   - blockedId int
+func (_e *MockFollowRepositoryInterface_Expecter) Block(blockerId interface{}, blockedId interface{}) *MockFollowRepositoryInterface_Block_Call {
+	return &MockFollowRepositoryInterface_Block_Call{Call: _e.mock.On("Block", blockerId, blockedId)}
+}
+
+func (_c *MockFollowRepositoryInterface_Block_Call) Run(run func(blockerId int, blockedId int)) *MockFollowRepositoryInterface_Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_Block_Call) Return(err error) *MockFollowRepositoryInterface_Block_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_Block_Call) RunAndReturn(run func(blockerId int, blockedId int) error) *MockFollowRepositoryInterface_Block_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Follow provides a mock function for the type MockFollowRepositoryInterface
+func (_mock *MockFollowRepositoryInterface) Follow(followerId int, followeeId int) (bool, error) {
+	ret := _mock.Called(followerId, followeeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) (bool, error)); ok {
+		return returnFunc(followerId, followeeId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int) bool); ok {
+		r0 = returnFunc(followerId, followeeId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = returnFunc(followerId, followeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFollowRepositoryInterface_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockFollowRepositoryInterface_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - followerId int
+//   - followeeId int
+func (_e *MockFollowRepositoryInterface_Expecter) Follow(followerId interface{}, followeeId interface{}) *MockFollowRepositoryInterface_Follow_Call {
+	return &MockFollowRepositoryInterface_Follow_Call{Call: _e.mock.On("Follow", followerId, followeeId)}
+}
+
+func (_c *MockFollowRepositoryInterface_Follow_Call) Run(run func(followerId int, followeeId int)) *MockFollowRepositoryInterface_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_Follow_Call) Return(b bool, err error) *MockFollowRepositoryInterface_Follow_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_Follow_Call) RunAndReturn(run func(followerId int, followeeId int) (bool, error)) *MockFollowRepositoryInterface_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsBlocked provides a mock function for the type MockFollowRepositoryInterface
+func (_mock *MockFollowRepositoryInterface) IsBlocked(userId int, otherId int) (bool, error) {
+	ret := _mock.Called(userId, otherId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBlocked")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) (bool, error)); ok {
+		return returnFunc(userId, otherId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int) bool); ok {
+		r0 = returnFunc(userId, otherId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = returnFunc(userId, otherId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFollowRepositoryInterface_IsBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBlocked'
+type MockFollowRepositoryInterface_IsBlocked_Call struct {
+	*mock.Call
+}
+
+// IsBlocked is a helper method to define mock.On call
+//   - userId int
+//   - otherId int
+func (_e *MockFollowRepositoryInterface_Expecter) IsBlocked(userId interface{}, otherId interface{}) *MockFollowRepositoryInterface_IsBlocked_Call {
+	return &MockFollowRepositoryInterface_IsBlocked_Call{Call: _e.mock.On("IsBlocked", userId, otherId)}
+}
+
+func (_c *MockFollowRepositoryInterface_IsBlocked_Call) Run(run func(userId int, otherId int)) *MockFollowRepositoryInterface_IsBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_IsBlocked_Call) Return(b bool, err error) *MockFollowRepositoryInterface_IsBlocked_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_IsBlocked_Call) RunAndReturn(run func(userId int, otherId int) (bool, error)) *MockFollowRepositoryInterface_IsBlocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsFollowing provides a mock function for the type MockFollowRepositoryInterface
+func (_mock *MockFollowRepositoryInterface) IsFollowing(followerId int, followeeId int) (bool, error) {
+	ret := _mock.Called(followerId, followeeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFollowing")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) (bool, error)); ok {
+		return returnFunc(followerId, followeeId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int) bool); ok {
+		r0 = returnFunc(followerId, followeeId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = returnFunc(followerId, followeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFollowRepositoryInterface_IsFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFollowing'
+type MockFollowRepositoryInterface_IsFollowing_Call struct {
+	*mock.Call
+}
+
+// IsFollowing is a helper method to define mock.On call
+//   - followerId int
+//   - followeeId int
+func (_e *MockFollowRepositoryInterface_Expecter) IsFollowing(followerId interface{}, followeeId interface{}) *MockFollowRepositoryInterface_IsFollowing_Call {
+	return &MockFollowRepositoryInterface_IsFollowing_Call{Call: _e.mock.On("IsFollowing", followerId, followeeId)}
+}
+
+func (_c *MockFollowRepositoryInterface_IsFollowing_Call) Run(run func(followerId int, followeeId int)) *MockFollowRepositoryInterface_IsFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_IsFollowing_Call) Return(b bool, err error) *MockFollowRepositoryInterface_IsFollowing_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_IsFollowing_Call) RunAndReturn(run func(followerId int, followeeId int) (bool, error)) *MockFollowRepositoryInterface_IsFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadFollowCounts provides a mock function for the type MockFollowRepositoryInterface
+func (_mock *MockFollowRepositoryInterface) ReadFollowCounts(userId int) (*domain.FollowCounts, error) {
+	ret := _mock.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadFollowCounts")
+	}
+
+	var r0 *domain.FollowCounts
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (*domain.FollowCounts, error)); ok {
+		return returnFunc(userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) *domain.FollowCounts); ok {
+		r0 = returnFunc(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.FollowCounts)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFollowRepositoryInterface_ReadFollowCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadFollowCounts'
+type MockFollowRepositoryInterface_ReadFollowCounts_Call struct {
+	*mock.Call
+}
+
+// ReadFollowCounts is a helper method to define mock.On call
+//   - userId int
+func (_e *MockFollowRepositoryInterface_Expecter) ReadFollowCounts(userId interface{}) *MockFollowRepositoryInterface_ReadFollowCounts_Call {
+	return &MockFollowRepositoryInterface_ReadFollowCounts_Call{Call: _e.mock.On("ReadFollowCounts", userId)}
+}
+
+func (_c *MockFollowRepositoryInterface_ReadFollowCounts_Call) Run(run func(userId int)) *MockFollowRepositoryInterface_ReadFollowCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_ReadFollowCounts_Call) Return(followCounts *domain.FollowCounts, err error) *MockFollowRepositoryInterface_ReadFollowCounts_Call {
+	_c.Call.Return(followCounts, err)
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_ReadFollowCounts_Call) RunAndReturn(run func(userId int) (*domain.FollowCounts, error)) *MockFollowRepositoryInterface_ReadFollowCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadFollowers provides a mock function for the type MockFollowRepositoryInterface
+func (_mock *MockFollowRepositoryInterface) ReadFollowers(userId int, beforeId int, limit int) ([]domain.Follow, error) {
+	ret := _mock.Called(userId, beforeId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadFollowers")
+	}
+
+	var r0 []domain.Follow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) ([]domain.Follow, error)); ok {
+		return returnFunc(userId, beforeId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) []domain.Follow); ok {
+		r0 = returnFunc(userId, beforeId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, int) error); ok {
+		r1 = returnFunc(userId, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFollowRepositoryInterface_ReadFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadFollowers'
+type MockFollowRepositoryInterface_ReadFollowers_Call struct {
+	*mock.Call
+}
+
+// ReadFollowers is a helper method to define mock.On call
+//   - userId int
+//   - beforeId int
+//   - limit int
+func (_e *MockFollowRepositoryInterface_Expecter) ReadFollowers(userId interface{}, beforeId interface{}, limit interface{}) *MockFollowRepositoryInterface_ReadFollowers_Call {
+	return &MockFollowRepositoryInterface_ReadFollowers_Call{Call: _e.mock.On("ReadFollowers", userId, beforeId, limit)}
+}
+
+func (_c *MockFollowRepositoryInterface_ReadFollowers_Call) Run(run func(userId int, beforeId int, limit int)) *MockFollowRepositoryInterface_ReadFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_ReadFollowers_Call) Return(follows []domain.Follow, err error) *MockFollowRepositoryInterface_ReadFollowers_Call {
+	_c.Call.Return(follows, err)
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_ReadFollowers_Call) RunAndReturn(run func(userId int, beforeId int, limit int) ([]domain.Follow, error)) *MockFollowRepositoryInterface_ReadFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadFollowing provides a mock function for the type MockFollowRepositoryInterface
+func (_mock *MockFollowRepositoryInterface) ReadFollowing(userId int, beforeId int, limit int) ([]domain.Follow, error) {
+	ret := _mock.Called(userId, beforeId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadFollowing")
+	}
+
+	var r0 []domain.Follow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) ([]domain.Follow, error)); ok {
+		return returnFunc(userId, beforeId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) []domain.Follow); ok {
+		r0 = returnFunc(userId, beforeId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, int) error); ok {
+		r1 = returnFunc(userId, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFollowRepositoryInterface_ReadFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadFollowing'
+type MockFollowRepositoryInterface_ReadFollowing_Call struct {
+	*mock.Call
+}
+
+// ReadFollowing is a helper method to define mock.On call
+//   - userId int
+//   - beforeId int
+//   - limit int
+func (_e *MockFollowRepositoryInterface_Expecter) ReadFollowing(userId interface{}, beforeId interface{}, limit interface{}) *MockFollowRepositoryInterface_ReadFollowing_Call {
+	return &MockFollowRepositoryInterface_ReadFollowing_Call{Call: _e.mock.On("ReadFollowing", userId, beforeId, limit)}
+}
+
+func (_c *MockFollowRepositoryInterface_ReadFollowing_Call) Run(run func(userId int, beforeId int, limit int)) *MockFollowRepositoryInterface_ReadFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_ReadFollowing_Call) Return(follows []domain.Follow, err error) *MockFollowRepositoryInterface_ReadFollowing_Call {
+	_c.Call.Return(follows, err)
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_ReadFollowing_Call) RunAndReturn(run func(userId int, beforeId int, limit int) ([]domain.Follow, error)) *MockFollowRepositoryInterface_ReadFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unblock provides a mock function for the type MockFollowRepositoryInterface
+func (_mock *MockFollowRepositoryInterface) Unblock(blockerId int, blockedId int) error {
+	ret := _mock.Called(blockerId, blockedId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unblock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = returnFunc(blockerId, blockedId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFollowRepositoryInterface_Unblock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unblock'
+type MockFollowRepositoryInterface_Unblock_Call struct {
+	*mock.Call
+}
+
+// Unblock is a helper method to define mock.On call
+//   - blockerId int
+//   - blockedId int
+func (_e *MockFollowRepositoryInterface_Expecter) Unblock(blockerId interface{}, blockedId interface{}) *MockFollowRepositoryInterface_Unblock_Call {
+	return &MockFollowRepositoryInterface_Unblock_Call{Call: _e.mock.On("Unblock", blockerId, blockedId)}
+}
+
+func (_c *MockFollowRepositoryInterface_Unblock_Call) Run(run func(blockerId int, blockedId int)) *MockFollowRepositoryInterface_Unblock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_Unblock_Call) Return(err error) *MockFollowRepositoryInterface_Unblock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_Unblock_Call) RunAndReturn(run func(blockerId int, blockedId int) error) *MockFollowRepositoryInterface_Unblock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unfollow provides a mock function for the type MockFollowRepositoryInterface
+func (_mock *MockFollowRepositoryInterface) Unfollow(followerId int, followeeId int) error {
+	ret := _mock.Called(followerId, followeeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfollow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = returnFunc(followerId, followeeId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFollowRepositoryInterface_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type MockFollowRepositoryInterface_Unfollow_Call struct {
+	*mock.Call
+}
+
+// Unfollow is a helper method to define mock.On call
+//   - followerId int
+//   - followeeId int
+func (_e *MockFollowRepositoryInterface_Expecter) Unfollow(followerId interface{}, followeeId interface{}) *MockFollowRepositoryInterface_Unfollow_Call {
+	return &MockFollowRepositoryInterface_Unfollow_Call{Call: _e.mock.On("Unfollow", followerId, followeeId)}
+}
+
+func (_c *MockFollowRepositoryInterface_Unfollow_Call) Run(run func(followerId int, followeeId int)) *MockFollowRepositoryInterface_Unfollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_Unfollow_Call) Return(err error) *MockFollowRepositoryInterface_Unfollow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFollowRepositoryInterface_Unfollow_Call) RunAndReturn(run func(followerId int, followeeId int) error) *MockFollowRepositoryInterface_Unfollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockImportRepositoryInterface creates a new instance of MockImportRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockImportRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockImportRepositoryInterface {
+	mock := &MockImportRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockImportRepositoryInterface is an autogenerated mock type for the ImportRepositoryInterface type
+type MockImportRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockImportRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockImportRepositoryInterface) EXPECT() *MockImportRepositoryInterface_Expecter {
+	return &MockImportRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// ImportPosts provides a mock function for the type MockImportRepositoryInterface
+func (_mock *MockImportRepositoryInterface) ImportPosts(posts []domain.PostImport, series []domain.SeriesImport) error {
+	ret := _mock.Called(posts, series)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportPosts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func([]domain.PostImport, []domain.SeriesImport) error); ok {
+		r0 = returnFunc(posts, series)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockImportRepositoryInterface_ImportPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportPosts'
+type MockImportRepositoryInterface_ImportPosts_Call struct {
+	*mock.Call
+}
+
+// ImportPosts is a helper method to define mock.On call
+//   - posts []domain.PostImport
+//   - series []domain.SeriesImport
+func (_e *MockImportRepositoryInterface_Expecter) ImportPosts(posts interface{}, series interface{}) *MockImportRepositoryInterface_ImportPosts_Call {
+	return &MockImportRepositoryInterface_ImportPosts_Call{Call: _e.mock.On("ImportPosts", posts, series)}
+}
+
+func (_c *MockImportRepositoryInterface_ImportPosts_Call) Run(run func(posts []domain.PostImport, series []domain.SeriesImport)) *MockImportRepositoryInterface_ImportPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []domain.PostImport
+		if args[0] != nil {
+			arg0 = args[0].([]domain.PostImport)
+		}
+		var arg1 []domain.SeriesImport
+		if args[1] != nil {
+			arg1 = args[1].([]domain.SeriesImport)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockImportRepositoryInterface_ImportPosts_Call) Return(err error) *MockImportRepositoryInterface_ImportPosts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockImportRepositoryInterface_ImportPosts_Call) RunAndReturn(run func(posts []domain.PostImport, series []domain.SeriesImport) error) *MockImportRepositoryInterface_ImportPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModerationRepositoryInterface creates a new instance of MockModerationRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModerationRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModerationRepositoryInterface {
+	mock := &MockModerationRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModerationRepositoryInterface is an autogenerated mock type for the ModerationRepositoryInterface type
+type MockModerationRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockModerationRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModerationRepositoryInterface) EXPECT() *MockModerationRepositoryInterface_Expecter {
+	return &MockModerationRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// ApplyModeration provides a mock function for the type MockModerationRepositoryInterface
+func (_mock *MockModerationRepositoryInterface) ApplyModeration(postId int, moderatorId *int, action domain.ModerationAction, note *string) error {
+	ret := _mock.Called(postId, moderatorId, action, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyModeration")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, *int, domain.ModerationAction, *string) error); ok {
+		r0 = returnFunc(postId, moderatorId, action, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationRepositoryInterface_ApplyModeration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyModeration'
+type MockModerationRepositoryInterface_ApplyModeration_Call struct {
+	*mock.Call
+}
+
+// ApplyModeration is a helper method to define mock.On call
+//   - postId int
+//   - moderatorId *int
+//   - action domain.ModerationAction
+//   - note *string
+func (_e *MockModerationRepositoryInterface_Expecter) ApplyModeration(postId interface{}, moderatorId interface{}, action interface{}, note interface{}) *MockModerationRepositoryInterface_ApplyModeration_Call {
+	return &MockModerationRepositoryInterface_ApplyModeration_Call{Call: _e.mock.On("ApplyModeration", postId, moderatorId, action, note)}
+}
+
+func (_c *MockModerationRepositoryInterface_ApplyModeration_Call) Run(run func(postId int, moderatorId *int, action domain.ModerationAction, note *string)) *MockModerationRepositoryInterface_ApplyModeration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 *int
+		if args[1] != nil {
+			arg1 = args[1].(*int)
+		}
+		var arg2 domain.ModerationAction
+		if args[2] != nil {
+			arg2 = args[2].(domain.ModerationAction)
+		}
+		var arg3 *string
+		if args[3] != nil {
+			arg3 = args[3].(*string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_ApplyModeration_Call) Return(err error) *MockModerationRepositoryInterface_ApplyModeration_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_ApplyModeration_Call) RunAndReturn(run func(postId int, moderatorId *int, action domain.ModerationAction, note *string) error) *MockModerationRepositoryInterface_ApplyModeration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountOpenReports provides a mock function for the type MockModerationRepositoryInterface
+func (_mock *MockModerationRepositoryInterface) CountOpenReports(postId int) (int, error) {
+	ret := _mock.Called(postId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOpenReports")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (int, error)); ok {
+		return returnFunc(postId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) int); ok {
+		r0 = returnFunc(postId)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(postId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationRepositoryInterface_CountOpenReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOpenReports'
+type MockModerationRepositoryInterface_CountOpenReports_Call struct {
+	*mock.Call
+}
+
+// CountOpenReports is a helper method to define mock.On call
+//   - postId int
+func (_e *MockModerationRepositoryInterface_Expecter) CountOpenReports(postId interface{}) *MockModerationRepositoryInterface_CountOpenReports_Call {
+	return &MockModerationRepositoryInterface_CountOpenReports_Call{Call: _e.mock.On("CountOpenReports", postId)}
+}
+
+func (_c *MockModerationRepositoryInterface_CountOpenReports_Call) Run(run func(postId int)) *MockModerationRepositoryInterface_CountOpenReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_CountOpenReports_Call) Return(n int, err error) *MockModerationRepositoryInterface_CountOpenReports_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_CountOpenReports_Call) RunAndReturn(run func(postId int) (int, error)) *MockModerationRepositoryInterface_CountOpenReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReport provides a mock function for the type MockModerationRepositoryInterface
+func (_mock *MockModerationRepositoryInterface) CreateReport(report *domain.PostReport) (bool, error) {
+	ret := _mock.Called(report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReport")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.PostReport) (bool, error)); ok {
+		return returnFunc(report)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*domain.PostReport) bool); ok {
+		r0 = returnFunc(report)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*domain.PostReport) error); ok {
+		r1 = returnFunc(report)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationRepositoryInterface_CreateReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReport'
+type MockModerationRepositoryInterface_CreateReport_Call struct {
+	*mock.Call
+}
+
+// CreateReport is a helper method to define mock.On call
+//   - report *domain.PostReport
+func (_e *MockModerationRepositoryInterface_Expecter) CreateReport(report interface{}) *MockModerationRepositoryInterface_CreateReport_Call {
+	return &MockModerationRepositoryInterface_CreateReport_Call{Call: _e.mock.On("CreateReport", report)}
+}
+
+func (_c *MockModerationRepositoryInterface_CreateReport_Call) Run(run func(report *domain.PostReport)) *MockModerationRepositoryInterface_CreateReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.PostReport
+		if args[0] != nil {
+			arg0 = args[0].(*domain.PostReport)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_CreateReport_Call) Return(b bool, err error) *MockModerationRepositoryInterface_CreateReport_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_CreateReport_Call) RunAndReturn(run func(report *domain.PostReport) (bool, error)) *MockModerationRepositoryInterface_CreateReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadFlags provides a mock function for the type MockModerationRepositoryInterface
+func (_mock *MockModerationRepositoryInterface) ReadFlags(postId int) ([]domain.PostFlag, error) {
+	ret := _mock.Called(postId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadFlags")
+	}
+
+	var r0 []domain.PostFlag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.PostFlag, error)); ok {
+		return returnFunc(postId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.PostFlag); ok {
+		r0 = returnFunc(postId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PostFlag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(postId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationRepositoryInterface_ReadFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadFlags'
+type MockModerationRepositoryInterface_ReadFlags_Call struct {
+	*mock.Call
+}
+
+// ReadFlags is a helper method to define mock.On call
+//   - postId int
+func (_e *MockModerationRepositoryInterface_Expecter) ReadFlags(postId interface{}) *MockModerationRepositoryInterface_ReadFlags_Call {
+	return &MockModerationRepositoryInterface_ReadFlags_Call{Call: _e.mock.On("ReadFlags", postId)}
+}
+
+func (_c *MockModerationRepositoryInterface_ReadFlags_Call) Run(run func(postId int)) *MockModerationRepositoryInterface_ReadFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_ReadFlags_Call) Return(postFlags []domain.PostFlag, err error) *MockModerationRepositoryInterface_ReadFlags_Call {
+	_c.Call.Return(postFlags, err)
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_ReadFlags_Call) RunAndReturn(run func(postId int) ([]domain.PostFlag, error)) *MockModerationRepositoryInterface_ReadFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadModerationLog provides a mock function for the type MockModerationRepositoryInterface
+func (_mock *MockModerationRepositoryInterface) ReadModerationLog(postId int) ([]domain.ModerationLogEntry, error) {
+	ret := _mock.Called(postId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadModerationLog")
+	}
+
+	var r0 []domain.ModerationLogEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.ModerationLogEntry, error)); ok {
+		return returnFunc(postId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.ModerationLogEntry); ok {
+		r0 = returnFunc(postId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ModerationLogEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(postId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationRepositoryInterface_ReadModerationLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadModerationLog'
+type MockModerationRepositoryInterface_ReadModerationLog_Call struct {
+	*mock.Call
+}
+
+// ReadModerationLog is a helper method to define mock.On call
+//   - postId int
+func (_e *MockModerationRepositoryInterface_Expecter) ReadModerationLog(postId interface{}) *MockModerationRepositoryInterface_ReadModerationLog_Call {
+	return &MockModerationRepositoryInterface_ReadModerationLog_Call{Call: _e.mock.On("ReadModerationLog", postId)}
+}
+
+func (_c *MockModerationRepositoryInterface_ReadModerationLog_Call) Run(run func(postId int)) *MockModerationRepositoryInterface_ReadModerationLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_ReadModerationLog_Call) Return(moderationLogEntrys []domain.ModerationLogEntry, err error) *MockModerationRepositoryInterface_ReadModerationLog_Call {
+	_c.Call.Return(moderationLogEntrys, err)
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_ReadModerationLog_Call) RunAndReturn(run func(postId int) ([]domain.ModerationLogEntry, error)) *MockModerationRepositoryInterface_ReadModerationLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadQueue provides a mock function for the type MockModerationRepositoryInterface
+func (_mock *MockModerationRepositoryInterface) ReadQueue(filter repository.QueueFilter, beforeActivityAt time.Time, beforePostId int, limit int) ([]domain.ModerationQueueItem, error) {
+	ret := _mock.Called(filter, beforeActivityAt, beforePostId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadQueue")
+	}
+
+	var r0 []domain.ModerationQueueItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(repository.QueueFilter, time.Time, int, int) ([]domain.ModerationQueueItem, error)); ok {
+		return returnFunc(filter, beforeActivityAt, beforePostId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(repository.QueueFilter, time.Time, int, int) []domain.ModerationQueueItem); ok {
+		r0 = returnFunc(filter, beforeActivityAt, beforePostId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ModerationQueueItem)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(repository.QueueFilter, time.Time, int, int) error); ok {
+		r1 = returnFunc(filter, beforeActivityAt, beforePostId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationRepositoryInterface_ReadQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadQueue'
+type MockModerationRepositoryInterface_ReadQueue_Call struct {
+	*mock.Call
+}
+
+// ReadQueue is a helper method to define mock.On call
+//   - filter repository.QueueFilter
+//   - beforeActivityAt time.Time
+//   - beforePostId int
+//   - limit int
+func (_e *MockModerationRepositoryInterface_Expecter) ReadQueue(filter interface{}, beforeActivityAt interface{}, beforePostId interface{}, limit interface{}) *MockModerationRepositoryInterface_ReadQueue_Call {
+	return &MockModerationRepositoryInterface_ReadQueue_Call{Call: _e.mock.On("ReadQueue", filter, beforeActivityAt, beforePostId, limit)}
+}
+
+func (_c *MockModerationRepositoryInterface_ReadQueue_Call) Run(run func(filter repository.QueueFilter, beforeActivityAt time.Time, beforePostId int, limit int)) *MockModerationRepositoryInterface_ReadQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 repository.QueueFilter
+		if args[0] != nil {
+			arg0 = args[0].(repository.QueueFilter)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_ReadQueue_Call) Return(moderationQueueItems []domain.ModerationQueueItem, err error) *MockModerationRepositoryInterface_ReadQueue_Call {
+	_c.Call.Return(moderationQueueItems, err)
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_ReadQueue_Call) RunAndReturn(run func(filter repository.QueueFilter, beforeActivityAt time.Time, beforePostId int, limit int) ([]domain.ModerationQueueItem, error)) *MockModerationRepositoryInterface_ReadQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadReports provides a mock function for the type MockModerationRepositoryInterface
+func (_mock *MockModerationRepositoryInterface) ReadReports(postId int) ([]domain.PostReport, error) {
+	ret := _mock.Called(postId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadReports")
+	}
+
+	var r0 []domain.PostReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.PostReport, error)); ok {
+		return returnFunc(postId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.PostReport); ok {
+		r0 = returnFunc(postId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PostReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(postId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationRepositoryInterface_ReadReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadReports'
+type MockModerationRepositoryInterface_ReadReports_Call struct {
+	*mock.Call
+}
+
+// ReadReports is a helper method to define mock.On call
+//   - postId int
+func (_e *MockModerationRepositoryInterface_Expecter) ReadReports(postId interface{}) *MockModerationRepositoryInterface_ReadReports_Call {
+	return &MockModerationRepositoryInterface_ReadReports_Call{Call: _e.mock.On("ReadReports", postId)}
+}
+
+func (_c *MockModerationRepositoryInterface_ReadReports_Call) Run(run func(postId int)) *MockModerationRepositoryInterface_ReadReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_ReadReports_Call) Return(postReports []domain.PostReport, err error) *MockModerationRepositoryInterface_ReadReports_Call {
+	_c.Call.Return(postReports, err)
+	return _c
+}
+
+func (_c *MockModerationRepositoryInterface_ReadReports_Call) RunAndReturn(run func(postId int) ([]domain.PostReport, error)) *MockModerationRepositoryInterface_ReadReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationRepositoryInterface creates a new instance of MockNotificationRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationRepositoryInterface {
+	mock := &MockNotificationRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationRepositoryInterface is an autogenerated mock type for the NotificationRepositoryInterface type
+type MockNotificationRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockNotificationRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationRepositoryInterface) EXPECT() *MockNotificationRepositoryInterface_Expecter {
+	return &MockNotificationRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// CountUnread provides a mock function for the type MockNotificationRepositoryInterface
+func (_mock *MockNotificationRepositoryInterface) CountUnread(userId int) (int, error) {
+	ret := _mock.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnread")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (int, error)); ok {
+		return returnFunc(userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) int); ok {
+		r0 = returnFunc(userId)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepositoryInterface_CountUnread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnread'
+type MockNotificationRepositoryInterface_CountUnread_Call struct {
+	*mock.Call
+}
+
+// CountUnread is a helper method to define mock.On call
+//   - userId int
+func (_e *MockNotificationRepositoryInterface_Expecter) CountUnread(userId interface{}) *MockNotificationRepositoryInterface_CountUnread_Call {
+	return &MockNotificationRepositoryInterface_CountUnread_Call{Call: _e.mock.On("CountUnread", userId)}
+}
+
+func (_c *MockNotificationRepositoryInterface_CountUnread_Call) Run(run func(userId int)) *MockNotificationRepositoryInterface_CountUnread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_CountUnread_Call) Return(n int, err error) *MockNotificationRepositoryInterface_CountUnread_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_CountUnread_Call) RunAndReturn(run func(userId int) (int, error)) *MockNotificationRepositoryInterface_CountUnread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateNotification provides a mock function for the type MockNotificationRepositoryInterface
+func (_mock *MockNotificationRepositoryInterface) CreateNotification(notification *domain.Notification) error {
+	ret := _mock.Called(notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.Notification) error); ok {
+		r0 = returnFunc(notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationRepositoryInterface_CreateNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotification'
+type MockNotificationRepositoryInterface_CreateNotification_Call struct {
+	*mock.Call
+}
+
+// CreateNotification is a helper method to define mock.On call
+//   - notification *domain.Notification
+func (_e *MockNotificationRepositoryInterface_Expecter) CreateNotification(notification interface{}) *MockNotificationRepositoryInterface_CreateNotification_Call {
+	return &MockNotificationRepositoryInterface_CreateNotification_Call{Call: _e.mock.On("CreateNotification", notification)}
+}
+
+func (_c *MockNotificationRepositoryInterface_CreateNotification_Call) Run(run func(notification *domain.Notification)) *MockNotificationRepositoryInterface_CreateNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.Notification
+		if args[0] != nil {
+			arg0 = args[0].(*domain.Notification)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_CreateNotification_Call) Return(err error) *MockNotificationRepositoryInterface_CreateNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_CreateNotification_Call) RunAndReturn(run func(notification *domain.Notification) error) *MockNotificationRepositoryInterface_CreateNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAllRead provides a mock function for the type MockNotificationRepositoryInterface
+func (_mock *MockNotificationRepositoryInterface) MarkAllRead(userId int) error {
+	ret := _mock.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllRead")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationRepositoryInterface_MarkAllRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllRead'
+type MockNotificationRepositoryInterface_MarkAllRead_Call struct {
+	*mock.Call
+}
+
+// MarkAllRead is a helper method to define mock.On call
+//   - userId int
+func (_e *MockNotificationRepositoryInterface_Expecter) MarkAllRead(userId interface{}) *MockNotificationRepositoryInterface_MarkAllRead_Call {
+	return &MockNotificationRepositoryInterface_MarkAllRead_Call{Call: _e.mock.On("MarkAllRead", userId)}
+}
+
+func (_c *MockNotificationRepositoryInterface_MarkAllRead_Call) Run(run func(userId int)) *MockNotificationRepositoryInterface_MarkAllRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_MarkAllRead_Call) Return(err error) *MockNotificationRepositoryInterface_MarkAllRead_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_MarkAllRead_Call) RunAndReturn(run func(userId int) error) *MockNotificationRepositoryInterface_MarkAllRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRead provides a mock function for the type MockNotificationRepositoryInterface
+func (_mock *MockNotificationRepositoryInterface) MarkRead(userId int, id int) error {
+	ret := _mock.Called(userId, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = returnFunc(userId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationRepositoryInterface_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type MockNotificationRepositoryInterface_MarkRead_Call struct {
+	*mock.Call
+}
+
+// MarkRead is a helper method to define mock.On call
+//   - userId int
+//   - id int
+func (_e *MockNotificationRepositoryInterface_Expecter) MarkRead(userId interface{}, id interface{}) *MockNotificationRepositoryInterface_MarkRead_Call {
+	return &MockNotificationRepositoryInterface_MarkRead_Call{Call: _e.mock.On("MarkRead", userId, id)}
+}
+
+func (_c *MockNotificationRepositoryInterface_MarkRead_Call) Run(run func(userId int, id int)) *MockNotificationRepositoryInterface_MarkRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_MarkRead_Call) Return(err error) *MockNotificationRepositoryInterface_MarkRead_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_MarkRead_Call) RunAndReturn(run func(userId int, id int) error) *MockNotificationRepositoryInterface_MarkRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadNotification provides a mock function for the type MockNotificationRepositoryInterface
+func (_mock *MockNotificationRepositoryInterface) ReadNotification(id int) (*domain.Notification, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadNotification")
+	}
+
+	var r0 *domain.Notification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (*domain.Notification, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) *domain.Notification); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Notification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepositoryInterface_ReadNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadNotification'
+type MockNotificationRepositoryInterface_ReadNotification_Call struct {
+	*mock.Call
+}
+
+// ReadNotification is a helper method to define mock.On call
+//   - id int
+func (_e *MockNotificationRepositoryInterface_Expecter) ReadNotification(id interface{}) *MockNotificationRepositoryInterface_ReadNotification_Call {
+	return &MockNotificationRepositoryInterface_ReadNotification_Call{Call: _e.mock.On("ReadNotification", id)}
+}
+
+func (_c *MockNotificationRepositoryInterface_ReadNotification_Call) Run(run func(id int)) *MockNotificationRepositoryInterface_ReadNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_ReadNotification_Call) Return(notification *domain.Notification, err error) *MockNotificationRepositoryInterface_ReadNotification_Call {
+	_c.Call.Return(notification, err)
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_ReadNotification_Call) RunAndReturn(run func(id int) (*domain.Notification, error)) *MockNotificationRepositoryInterface_ReadNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadNotifications provides a mock function for the type MockNotificationRepositoryInterface
+func (_mock *MockNotificationRepositoryInterface) ReadNotifications(userId int, unreadOnly bool, beforeId int, limit int) ([]domain.Notification, error) {
+	ret := _mock.Called(userId, unreadOnly, beforeId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadNotifications")
+	}
+
+	var r0 []domain.Notification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, bool, int, int) ([]domain.Notification, error)); ok {
+		return returnFunc(userId, unreadOnly, beforeId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, bool, int, int) []domain.Notification); ok {
+		r0 = returnFunc(userId, unreadOnly, beforeId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Notification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, bool, int, int) error); ok {
+		r1 = returnFunc(userId, unreadOnly, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepositoryInterface_ReadNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadNotifications'
+type MockNotificationRepositoryInterface_ReadNotifications_Call struct {
+	*mock.Call
+}
+
+// ReadNotifications is a helper method to define mock.On call
+//   - userId int
+//   - unreadOnly bool
+//   - beforeId int
+//   - limit int
+func (_e *MockNotificationRepositoryInterface_Expecter) ReadNotifications(userId interface{}, unreadOnly interface{}, beforeId interface{}, limit interface{}) *MockNotificationRepositoryInterface_ReadNotifications_Call {
+	return &MockNotificationRepositoryInterface_ReadNotifications_Call{Call: _e.mock.On("ReadNotifications", userId, unreadOnly, beforeId, limit)}
+}
+
+func (_c *MockNotificationRepositoryInterface_ReadNotifications_Call) Run(run func(userId int, unreadOnly bool, beforeId int, limit int)) *MockNotificationRepositoryInterface_ReadNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_ReadNotifications_Call) Return(notifications []domain.Notification, err error) *MockNotificationRepositoryInterface_ReadNotifications_Call {
+	_c.Call.Return(notifications, err)
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_ReadNotifications_Call) RunAndReturn(run func(userId int, unreadOnly bool, beforeId int, limit int) ([]domain.Notification, error)) *MockNotificationRepositoryInterface_ReadNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadNotificationsAfter provides a mock function for the type MockNotificationRepositoryInterface
+func (_mock *MockNotificationRepositoryInterface) ReadNotificationsAfter(userId int, afterId int, limit int) ([]domain.Notification, error) {
+	ret := _mock.Called(userId, afterId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadNotificationsAfter")
+	}
+
+	var r0 []domain.Notification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) ([]domain.Notification, error)); ok {
+		return returnFunc(userId, afterId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) []domain.Notification); ok {
+		r0 = returnFunc(userId, afterId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Notification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, int) error); ok {
+		r1 = returnFunc(userId, afterId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepositoryInterface_ReadNotificationsAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadNotificationsAfter'
+type MockNotificationRepositoryInterface_ReadNotificationsAfter_Call struct {
+	*mock.Call
+}
+
+// ReadNotificationsAfter is a helper method to define mock.On call
+//   - userId int
+//   - afterId int
+//   - limit int
+func (_e *MockNotificationRepositoryInterface_Expecter) ReadNotificationsAfter(userId interface{}, afterId interface{}, limit interface{}) *MockNotificationRepositoryInterface_ReadNotificationsAfter_Call {
+	return &MockNotificationRepositoryInterface_ReadNotificationsAfter_Call{Call: _e.mock.On("ReadNotificationsAfter", userId, afterId, limit)}
+}
+
+func (_c *MockNotificationRepositoryInterface_ReadNotificationsAfter_Call) Run(run func(userId int, afterId int, limit int)) *MockNotificationRepositoryInterface_ReadNotificationsAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_ReadNotificationsAfter_Call) Return(notifications []domain.Notification, err error) *MockNotificationRepositoryInterface_ReadNotificationsAfter_Call {
+	_c.Call.Return(notifications, err)
+	return _c
+}
+
+func (_c *MockNotificationRepositoryInterface_ReadNotificationsAfter_Call) RunAndReturn(run func(userId int, afterId int, limit int) ([]domain.Notification, error)) *MockNotificationRepositoryInterface_ReadNotificationsAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPinRepositoryInterface creates a new instance of MockPinRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPinRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPinRepositoryInterface {
+	mock := &MockPinRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPinRepositoryInterface is an autogenerated mock type for the PinRepositoryInterface type
+type MockPinRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockPinRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPinRepositoryInterface) EXPECT() *MockPinRepositoryInterface_Expecter {
+	return &MockPinRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// FeaturePost provides a mock function for the type MockPinRepositoryInterface
+func (_mock *MockPinRepositoryInterface) FeaturePost(postId int, adminId int, position int, limit int) (bool, error) {
+	ret := _mock.Called(postId, adminId, position, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeaturePost")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, int, int) (bool, error)); ok {
+		return returnFunc(postId, adminId, position, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, int, int) bool); ok {
+		r0 = returnFunc(postId, adminId, position, limit)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, int, int) error); ok {
+		r1 = returnFunc(postId, adminId, position, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPinRepositoryInterface_FeaturePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeaturePost'
+type MockPinRepositoryInterface_FeaturePost_Call struct {
+	*mock.Call
+}
+
+// FeaturePost is a helper method to define mock.On call
+//   - postId int
+//   - adminId int
+//   - position int
+//   - limit int
+func (_e *MockPinRepositoryInterface_Expecter) FeaturePost(postId interface{}, adminId interface{}, position interface{}, limit interface{}) *MockPinRepositoryInterface_FeaturePost_Call {
+	return &MockPinRepositoryInterface_FeaturePost_Call{Call: _e.mock.On("FeaturePost", postId, adminId, position, limit)}
+}
+
+func (_c *MockPinRepositoryInterface_FeaturePost_Call) Run(run func(postId int, adminId int, position int, limit int)) *MockPinRepositoryInterface_FeaturePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPinRepositoryInterface_FeaturePost_Call) Return(b bool, err error) *MockPinRepositoryInterface_FeaturePost_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPinRepositoryInterface_FeaturePost_Call) RunAndReturn(run func(postId int, adminId int, position int, limit int) (bool, error)) *MockPinRepositoryInterface_FeaturePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PinPost provides a mock function for the type MockPinRepositoryInterface
+func (_mock *MockPinRepositoryInterface) PinPost(userId int, postId int, position int, limit int) (bool, error) {
+	ret := _mock.Called(userId, postId, position, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PinPost")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, int, int) (bool, error)); ok {
+		return returnFunc(userId, postId, position, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, int, int) bool); ok {
+		r0 = returnFunc(userId, postId, position, limit)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, int, int) error); ok {
+		r1 = returnFunc(userId, postId, position, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPinRepositoryInterface_PinPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PinPost'
+type MockPinRepositoryInterface_PinPost_Call struct {
+	*mock.Call
+}
+
+// PinPost is a helper method to define mock.On call
+//   - userId int
+//   - postId int
+//   - position int
+//   - limit int
+func (_e *MockPinRepositoryInterface_Expecter) PinPost(userId interface{}, postId interface{}, position interface{}, limit interface{}) *MockPinRepositoryInterface_PinPost_Call {
+	return &MockPinRepositoryInterface_PinPost_Call{Call: _e.mock.On("PinPost", userId, postId, position, limit)}
+}
+
+func (_c *MockPinRepositoryInterface_PinPost_Call) Run(run func(userId int, postId int, position int, limit int)) *MockPinRepositoryInterface_PinPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPinRepositoryInterface_PinPost_Call) Return(b bool, err error) *MockPinRepositoryInterface_PinPost_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPinRepositoryInterface_PinPost_Call) RunAndReturn(run func(userId int, postId int, position int, limit int) (bool, error)) *MockPinRepositoryInterface_PinPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnfeaturePost provides a mock function for the type MockPinRepositoryInterface
+func (_mock *MockPinRepositoryInterface) UnfeaturePost(postId int) (bool, error) {
+	ret := _mock.Called(postId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnfeaturePost")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (bool, error)); ok {
+		return returnFunc(postId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) bool); ok {
+		r0 = returnFunc(postId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(postId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPinRepositoryInterface_UnfeaturePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnfeaturePost'
+type MockPinRepositoryInterface_UnfeaturePost_Call struct {
+	*mock.Call
+}
+
+// UnfeaturePost is a helper method to define mock.On call
+//   - postId int
+func (_e *MockPinRepositoryInterface_Expecter) UnfeaturePost(postId interface{}) *MockPinRepositoryInterface_UnfeaturePost_Call {
+	return &MockPinRepositoryInterface_UnfeaturePost_Call{Call: _e.mock.On("UnfeaturePost", postId)}
+}
+
+func (_c *MockPinRepositoryInterface_UnfeaturePost_Call) Run(run func(postId int)) *MockPinRepositoryInterface_UnfeaturePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPinRepositoryInterface_UnfeaturePost_Call) Return(b bool, err error) *MockPinRepositoryInterface_UnfeaturePost_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPinRepositoryInterface_UnfeaturePost_Call) RunAndReturn(run func(postId int) (bool, error)) *MockPinRepositoryInterface_UnfeaturePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnpinPost provides a mock function for the type MockPinRepositoryInterface
+func (_mock *MockPinRepositoryInterface) UnpinPost(userId int, postId int) (bool, error) {
+	ret := _mock.Called(userId, postId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnpinPost")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) (bool, error)); ok {
+		return returnFunc(userId, postId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int) bool); ok {
+		r0 = returnFunc(userId, postId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = returnFunc(userId, postId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPinRepositoryInterface_UnpinPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnpinPost'
+type MockPinRepositoryInterface_UnpinPost_Call struct {
+	*mock.Call
+}
+
+// UnpinPost is a helper method to define mock.On call
+//   - userId int
+//   - postId int
+func (_e *MockPinRepositoryInterface_Expecter) UnpinPost(userId interface{}, postId interface{}) *MockPinRepositoryInterface_UnpinPost_Call {
+	return &MockPinRepositoryInterface_UnpinPost_Call{Call: _e.mock.On("UnpinPost", userId, postId)}
+}
+
+func (_c *MockPinRepositoryInterface_UnpinPost_Call) Run(run func(userId int, postId int)) *MockPinRepositoryInterface_UnpinPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPinRepositoryInterface_UnpinPost_Call) Return(b bool, err error) *MockPinRepositoryInterface_UnpinPost_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPinRepositoryInterface_UnpinPost_Call) RunAndReturn(run func(userId int, postId int) (bool, error)) *MockPinRepositoryInterface_UnpinPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostAuthorRepositoryInterface creates a new instance of MockPostAuthorRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostAuthorRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostAuthorRepositoryInterface {
+	mock := &MockPostAuthorRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostAuthorRepositoryInterface is an autogenerated mock type for the PostAuthorRepositoryInterface type
+type MockPostAuthorRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockPostAuthorRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostAuthorRepositoryInterface) EXPECT() *MockPostAuthorRepositoryInterface_Expecter {
+	return &MockPostAuthorRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// AcceptInvite provides a mock function for the type MockPostAuthorRepositoryInterface
+func (_mock *MockPostAuthorRepositoryInterface) AcceptInvite(postId int, userId int) (bool, error) {
+	ret := _mock.Called(postId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptInvite")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) (bool, error)); ok {
+		return returnFunc(postId, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int) bool); ok {
+		r0 = returnFunc(postId, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = returnFunc(postId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostAuthorRepositoryInterface_AcceptInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptInvite'
+type MockPostAuthorRepositoryInterface_AcceptInvite_Call struct {
+	*mock.Call
+}
+
+// AcceptInvite is a helper method to define mock.On call
+//   - postId int
+//   - userId int
+func (_e *MockPostAuthorRepositoryInterface_Expecter) AcceptInvite(postId interface{}, userId interface{}) *MockPostAuthorRepositoryInterface_AcceptInvite_Call {
+	return &MockPostAuthorRepositoryInterface_AcceptInvite_Call{Call: _e.mock.On("AcceptInvite", postId, userId)}
+}
+
+func (_c *MockPostAuthorRepositoryInterface_AcceptInvite_Call) Run(run func(postId int, userId int)) *MockPostAuthorRepositoryInterface_AcceptInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostAuthorRepositoryInterface_AcceptInvite_Call) Return(b bool, err error) *MockPostAuthorRepositoryInterface_AcceptInvite_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPostAuthorRepositoryInterface_AcceptInvite_Call) RunAndReturn(run func(postId int, userId int) (bool, error)) *MockPostAuthorRepositoryInterface_AcceptInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InviteAuthor provides a mock function for the type MockPostAuthorRepositoryInterface
+func (_mock *MockPostAuthorRepositoryInterface) InviteAuthor(postId int, userId int, invitedBy int) (bool, error) {
+	ret := _mock.Called(postId, userId, invitedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InviteAuthor")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) (bool, error)); ok {
+		return returnFunc(postId, userId, invitedBy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) bool); ok {
+		r0 = returnFunc(postId, userId, invitedBy)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, int) error); ok {
+		r1 = returnFunc(postId, userId, invitedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostAuthorRepositoryInterface_InviteAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InviteAuthor'
+type MockPostAuthorRepositoryInterface_InviteAuthor_Call struct {
+	*mock.Call
+}
+
+// InviteAuthor is a helper method to define mock.On call
+//   - postId int
+//   - userId int
+//   - invitedBy int
+func (_e *MockPostAuthorRepositoryInterface_Expecter) InviteAuthor(postId interface{}, userId interface{}, invitedBy interface{}) *MockPostAuthorRepositoryInterface_InviteAuthor_Call {
+	return &MockPostAuthorRepositoryInterface_InviteAuthor_Call{Call: _e.mock.On("InviteAuthor", postId, userId, invitedBy)}
+}
+
+func (_c *MockPostAuthorRepositoryInterface_InviteAuthor_Call) Run(run func(postId int, userId int, invitedBy int)) *MockPostAuthorRepositoryInterface_InviteAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostAuthorRepositoryInterface_InviteAuthor_Call) Return(b bool, err error) *MockPostAuthorRepositoryInterface_InviteAuthor_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPostAuthorRepositoryInterface_InviteAuthor_Call) RunAndReturn(run func(postId int, userId int, invitedBy int) (bool, error)) *MockPostAuthorRepositoryInterface_InviteAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadPostAuthors provides a mock function for the type MockPostAuthorRepositoryInterface
+func (_mock *MockPostAuthorRepositoryInterface) ReadPostAuthors(postId int) ([]domain.PostAuthor, error) {
+	ret := _mock.Called(postId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPostAuthors")
+	}
+
+	var r0 []domain.PostAuthor
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.PostAuthor, error)); ok {
+		return returnFunc(postId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.PostAuthor); ok {
+		r0 = returnFunc(postId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PostAuthor)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(postId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostAuthorRepositoryInterface_ReadPostAuthors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPostAuthors'
+type MockPostAuthorRepositoryInterface_ReadPostAuthors_Call struct {
+	*mock.Call
+}
+
+// ReadPostAuthors is a helper method to define mock.On call
+//   - postId int
+func (_e *MockPostAuthorRepositoryInterface_Expecter) ReadPostAuthors(postId interface{}) *MockPostAuthorRepositoryInterface_ReadPostAuthors_Call {
+	return &MockPostAuthorRepositoryInterface_ReadPostAuthors_Call{Call: _e.mock.On("ReadPostAuthors", postId)}
+}
+
+func (_c *MockPostAuthorRepositoryInterface_ReadPostAuthors_Call) Run(run func(postId int)) *MockPostAuthorRepositoryInterface_ReadPostAuthors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostAuthorRepositoryInterface_ReadPostAuthors_Call) Return(postAuthors []domain.PostAuthor, err error) *MockPostAuthorRepositoryInterface_ReadPostAuthors_Call {
+	_c.Call.Return(postAuthors, err)
+	return _c
+}
+
+func (_c *MockPostAuthorRepositoryInterface_ReadPostAuthors_Call) RunAndReturn(run func(postId int) ([]domain.PostAuthor, error)) *MockPostAuthorRepositoryInterface_ReadPostAuthors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAuthor provides a mock function for the type MockPostAuthorRepositoryInterface
+func (_mock *MockPostAuthorRepositoryInterface) RemoveAuthor(postId int, userId int) (bool, error) {
+	ret := _mock.Called(postId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAuthor")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) (bool, error)); ok {
+		return returnFunc(postId, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int) bool); ok {
+		r0 = returnFunc(postId, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = returnFunc(postId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostAuthorRepositoryInterface_RemoveAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAuthor'
+type MockPostAuthorRepositoryInterface_RemoveAuthor_Call struct {
+	*mock.Call
+}
+
+// RemoveAuthor is a helper method to define mock.On call
+//   - postId int
+//   - userId int
+func (_e *MockPostAuthorRepositoryInterface_Expecter) RemoveAuthor(postId interface{}, userId interface{}) *MockPostAuthorRepositoryInterface_RemoveAuthor_Call {
+	return &MockPostAuthorRepositoryInterface_RemoveAuthor_Call{Call: _e.mock.On("RemoveAuthor", postId, userId)}
+}
+
+func (_c *MockPostAuthorRepositoryInterface_RemoveAuthor_Call) Run(run func(postId int, userId int)) *MockPostAuthorRepositoryInterface_RemoveAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostAuthorRepositoryInterface_RemoveAuthor_Call) Return(b bool, err error) *MockPostAuthorRepositoryInterface_RemoveAuthor_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPostAuthorRepositoryInterface_RemoveAuthor_Call) RunAndReturn(run func(postId int, userId int) (bool, error)) *MockPostAuthorRepositoryInterface_RemoveAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferOwnership provides a mock function for the type MockPostAuthorRepositoryInterface
+func (_mock *MockPostAuthorRepositoryInterface) TransferOwnership(postId int, fromUserId int, toUserId int) (bool, error) {
+	ret := _mock.Called(postId, fromUserId, toUserId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferOwnership")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) (bool, error)); ok {
+		return returnFunc(postId, fromUserId, toUserId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) bool); ok {
+		r0 = returnFunc(postId, fromUserId, toUserId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, int) error); ok {
+		r1 = returnFunc(postId, fromUserId, toUserId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostAuthorRepositoryInterface_TransferOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferOwnership'
+type MockPostAuthorRepositoryInterface_TransferOwnership_Call struct {
+	*mock.Call
+}
+
+// TransferOwnership is a helper method to define mock.On call
+//   - postId int
+//   - fromUserId int
+//   - toUserId int
+func (_e *MockPostAuthorRepositoryInterface_Expecter) TransferOwnership(postId interface{}, fromUserId interface{}, toUserId interface{}) *MockPostAuthorRepositoryInterface_TransferOwnership_Call {
+	return &MockPostAuthorRepositoryInterface_TransferOwnership_Call{Call: _e.mock.On("TransferOwnership", postId, fromUserId, toUserId)}
+}
+
+func (_c *MockPostAuthorRepositoryInterface_TransferOwnership_Call) Run(run func(postId int, fromUserId int, toUserId int)) *MockPostAuthorRepositoryInterface_TransferOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostAuthorRepositoryInterface_TransferOwnership_Call) Return(b bool, err error) *MockPostAuthorRepositoryInterface_TransferOwnership_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPostAuthorRepositoryInterface_TransferOwnership_Call) RunAndReturn(run func(postId int, fromUserId int, toUserId int) (bool, error)) *MockPostAuthorRepositoryInterface_TransferOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostRepositoryInterface creates a new instance of MockPostRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostRepositoryInterface {
+	mock := &MockPostRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostRepositoryInterface is an autogenerated mock type for the PostRepositoryInterface type
+type MockPostRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockPostRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostRepositoryInterface) EXPECT() *MockPostRepositoryInterface_Expecter {
+	return &MockPostRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// CacheContentHTML provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) CacheContentHTML(id int, contentHTML string) error {
+	ret := _mock.Called(id, contentHTML)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CacheContentHTML")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = returnFunc(id, contentHTML)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryInterface_CacheContentHTML_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CacheContentHTML'
+type MockPostRepositoryInterface_CacheContentHTML_Call struct {
+	*mock.Call
+}
+
+// CacheContentHTML is a helper method to define mock.On call
+//   - id int
+//   - contentHTML string
+func (_e *MockPostRepositoryInterface_Expecter) CacheContentHTML(id interface{}, contentHTML interface{}) *MockPostRepositoryInterface_CacheContentHTML_Call {
+	return &MockPostRepositoryInterface_CacheContentHTML_Call{Call: _e.mock.On("CacheContentHTML", id, contentHTML)}
+}
+
+func (_c *MockPostRepositoryInterface_CacheContentHTML_Call) Run(run func(id int, contentHTML string)) *MockPostRepositoryInterface_CacheContentHTML_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_CacheContentHTML_Call) Return(err error) *MockPostRepositoryInterface_CacheContentHTML_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_CacheContentHTML_Call) RunAndReturn(run func(id int, contentHTML string) error) *MockPostRepositoryInterface_CacheContentHTML_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePost provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) CreatePost(post *domain.Post) error {
+	ret := _mock.Called(post)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.Post) error); ok {
+		r0 = returnFunc(post)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryInterface_CreatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePost'
+type MockPostRepositoryInterface_CreatePost_Call struct {
+	*mock.Call
+}
+
+// CreatePost is a helper method to define mock.On call
+//   - post *domain.Post
+func (_e *MockPostRepositoryInterface_Expecter) CreatePost(post interface{}) *MockPostRepositoryInterface_CreatePost_Call {
+	return &MockPostRepositoryInterface_CreatePost_Call{Call: _e.mock.On("CreatePost", post)}
+}
+
+func (_c *MockPostRepositoryInterface_CreatePost_Call) Run(run func(post *domain.Post)) *MockPostRepositoryInterface_CreatePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.Post
+		if args[0] != nil {
+			arg0 = args[0].(*domain.Post)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_CreatePost_Call) Return(err error) *MockPostRepositoryInterface_CreatePost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_CreatePost_Call) RunAndReturn(run func(post *domain.Post) error) *MockPostRepositoryInterface_CreatePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePost provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) DeletePost(id int, version int) (bool, error) {
+	ret := _mock.Called(id, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePost")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) (bool, error)); ok {
+		return returnFunc(id, version)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int) bool); ok {
+		r0 = returnFunc(id, version)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = returnFunc(id, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_DeletePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePost'
+type MockPostRepositoryInterface_DeletePost_Call struct {
+	*mock.Call
+}
+
+// DeletePost is a helper method to define mock.On call
+//   - id int
+//   - version int
+func (_e *MockPostRepositoryInterface_Expecter) DeletePost(id interface{}, version interface{}) *MockPostRepositoryInterface_DeletePost_Call {
+	return &MockPostRepositoryInterface_DeletePost_Call{Call: _e.mock.On("DeletePost", id, version)}
+}
+
+func (_c *MockPostRepositoryInterface_DeletePost_Call) Run(run func(id int, version int)) *MockPostRepositoryInterface_DeletePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_DeletePost_Call) Return(b bool, err error) *MockPostRepositoryInterface_DeletePost_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_DeletePost_Call) RunAndReturn(run func(id int, version int) (bool, error)) *MockPostRepositoryInterface_DeletePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishDuePosts provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) PublishDuePosts(now time.Time) ([]int, error) {
+	ret := _mock.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishDuePosts")
+	}
+
+	var r0 []int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(time.Time) ([]int, error)); ok {
+		return returnFunc(now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(time.Time) []int); ok {
+		r0 = returnFunc(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = returnFunc(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_PublishDuePosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishDuePosts'
+type MockPostRepositoryInterface_PublishDuePosts_Call struct {
+	*mock.Call
+}
+
+// PublishDuePosts is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockPostRepositoryInterface_Expecter) PublishDuePosts(now interface{}) *MockPostRepositoryInterface_PublishDuePosts_Call {
+	return &MockPostRepositoryInterface_PublishDuePosts_Call{Call: _e.mock.On("PublishDuePosts", now)}
+}
+
+func (_c *MockPostRepositoryInterface_PublishDuePosts_Call) Run(run func(now time.Time)) *MockPostRepositoryInterface_PublishDuePosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Time
+		if args[0] != nil {
+			arg0 = args[0].(time.Time)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_PublishDuePosts_Call) Return(ints []int, err error) *MockPostRepositoryInterface_PublishDuePosts_Call {
+	_c.Call.Return(ints, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_PublishDuePosts_Call) RunAndReturn(run func(now time.Time) ([]int, error)) *MockPostRepositoryInterface_PublishDuePosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedPosts provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) PurgeDeletedPosts(before time.Time) (int64, []string, error) {
+	ret := _mock.Called(before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedPosts")
+	}
+
+	var r0 int64
+	var r1 []string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(time.Time) (int64, []string, error)); ok {
+		return returnFunc(before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = returnFunc(before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(time.Time) []string); ok {
+		r1 = returnFunc(before)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(time.Time) error); ok {
+		r2 = returnFunc(before)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockPostRepositoryInterface_PurgeDeletedPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedPosts'
+type MockPostRepositoryInterface_PurgeDeletedPosts_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedPosts is a helper method to define mock.On call
+//   - before time.Time
+func (_e *MockPostRepositoryInterface_Expecter) PurgeDeletedPosts(before interface{}) *MockPostRepositoryInterface_PurgeDeletedPosts_Call {
+	return &MockPostRepositoryInterface_PurgeDeletedPosts_Call{Call: _e.mock.On("PurgeDeletedPosts", before)}
+}
+
+func (_c *MockPostRepositoryInterface_PurgeDeletedPosts_Call) Run(run func(before time.Time)) *MockPostRepositoryInterface_PurgeDeletedPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Time
+		if args[0] != nil {
+			arg0 = args[0].(time.Time)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_PurgeDeletedPosts_Call) Return(n int64, strings []string, err error) *MockPostRepositoryInterface_PurgeDeletedPosts_Call {
+	_c.Call.Return(n, strings, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_PurgeDeletedPosts_Call) RunAndReturn(run func(before time.Time) (int64, []string, error)) *MockPostRepositoryInterface_PurgeDeletedPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadAllPosts provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadAllPosts(viewerId int, beforeCreatedAt time.Time, beforeId int, limit int) ([]domain.Post, error) {
+	ret := _mock.Called(viewerId, beforeCreatedAt, beforeId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadAllPosts")
+	}
+
+	var r0 []domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, time.Time, int, int) ([]domain.Post, error)); ok {
+		return returnFunc(viewerId, beforeCreatedAt, beforeId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, time.Time, int, int) []domain.Post); ok {
+		r0 = returnFunc(viewerId, beforeCreatedAt, beforeId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, time.Time, int, int) error); ok {
+		r1 = returnFunc(viewerId, beforeCreatedAt, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadAllPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAllPosts'
+type MockPostRepositoryInterface_ReadAllPosts_Call struct {
+	*mock.Call
+}
+
+// ReadAllPosts is a helper method to define mock.On call
+//   - viewerId int
+//   - beforeCreatedAt time.Time
+//   - beforeId int
+//   - limit int
+func (_e *MockPostRepositoryInterface_Expecter) ReadAllPosts(viewerId interface{}, beforeCreatedAt interface{}, beforeId interface{}, limit interface{}) *MockPostRepositoryInterface_ReadAllPosts_Call {
+	return &MockPostRepositoryInterface_ReadAllPosts_Call{Call: _e.mock.On("ReadAllPosts", viewerId, beforeCreatedAt, beforeId, limit)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadAllPosts_Call) Run(run func(viewerId int, beforeCreatedAt time.Time, beforeId int, limit int)) *MockPostRepositoryInterface_ReadAllPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadAllPosts_Call) Return(posts []domain.Post, err error) *MockPostRepositoryInterface_ReadAllPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadAllPosts_Call) RunAndReturn(run func(viewerId int, beforeCreatedAt time.Time, beforeId int, limit int) ([]domain.Post, error)) *MockPostRepositoryInterface_ReadAllPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadAuthorPosts provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadAuthorPosts(userId int, viewerId int, beforeCreatedAt time.Time, beforeId int, limit int) ([]domain.Post, error) {
+	ret := _mock.Called(userId, viewerId, beforeCreatedAt, beforeId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadAuthorPosts")
+	}
+
+	var r0 []domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, time.Time, int, int) ([]domain.Post, error)); ok {
+		return returnFunc(userId, viewerId, beforeCreatedAt, beforeId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, time.Time, int, int) []domain.Post); ok {
+		r0 = returnFunc(userId, viewerId, beforeCreatedAt, beforeId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, time.Time, int, int) error); ok {
+		r1 = returnFunc(userId, viewerId, beforeCreatedAt, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadAuthorPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAuthorPosts'
+type MockPostRepositoryInterface_ReadAuthorPosts_Call struct {
+	*mock.Call
+}
+
+// ReadAuthorPosts is a helper method to define mock.On call
+//   - userId int
+//   - viewerId int
+//   - beforeCreatedAt time.Time
+//   - beforeId int
+//   - limit int
+func (_e *MockPostRepositoryInterface_Expecter) ReadAuthorPosts(userId interface{}, viewerId interface{}, beforeCreatedAt interface{}, beforeId interface{}, limit interface{}) *MockPostRepositoryInterface_ReadAuthorPosts_Call {
+	return &MockPostRepositoryInterface_ReadAuthorPosts_Call{Call: _e.mock.On("ReadAuthorPosts", userId, viewerId, beforeCreatedAt, beforeId, limit)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadAuthorPosts_Call) Run(run func(userId int, viewerId int, beforeCreatedAt time.Time, beforeId int, limit int)) *MockPostRepositoryInterface_ReadAuthorPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadAuthorPosts_Call) Return(posts []domain.Post, err error) *MockPostRepositoryInterface_ReadAuthorPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadAuthorPosts_Call) RunAndReturn(run func(userId int, viewerId int, beforeCreatedAt time.Time, beforeId int, limit int) ([]domain.Post, error)) *MockPostRepositoryInterface_ReadAuthorPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadFeaturedPosts provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadFeaturedPosts() ([]domain.Post, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadFeaturedPosts")
+	}
+
+	var r0 []domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]domain.Post, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []domain.Post); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadFeaturedPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadFeaturedPosts'
+type MockPostRepositoryInterface_ReadFeaturedPosts_Call struct {
+	*mock.Call
+}
+
+// ReadFeaturedPosts is a helper method to define mock.On call
+func (_e *MockPostRepositoryInterface_Expecter) ReadFeaturedPosts() *MockPostRepositoryInterface_ReadFeaturedPosts_Call {
+	return &MockPostRepositoryInterface_ReadFeaturedPosts_Call{Call: _e.mock.On("ReadFeaturedPosts")}
+}
+
+func (_c *MockPostRepositoryInterface_ReadFeaturedPosts_Call) Run(run func()) *MockPostRepositoryInterface_ReadFeaturedPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadFeaturedPosts_Call) Return(posts []domain.Post, err error) *MockPostRepositoryInterface_ReadFeaturedPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadFeaturedPosts_Call) RunAndReturn(run func() ([]domain.Post, error)) *MockPostRepositoryInterface_ReadFeaturedPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadFeedPosts provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadFeedPosts(userId int, tag string, limit int) ([]domain.FeedPost, error) {
+	ret := _mock.Called(userId, tag, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadFeedPosts")
+	}
+
+	var r0 []domain.FeedPost
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, int) ([]domain.FeedPost, error)); ok {
+		return returnFunc(userId, tag, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string, int) []domain.FeedPost); ok {
+		r0 = returnFunc(userId, tag, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.FeedPost)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string, int) error); ok {
+		r1 = returnFunc(userId, tag, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadFeedPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadFeedPosts'
+type MockPostRepositoryInterface_ReadFeedPosts_Call struct {
+	*mock.Call
+}
+
+// ReadFeedPosts is a helper method to define mock.On call
+//   - userId int
+//   - tag string
+//   - limit int
+func (_e *MockPostRepositoryInterface_Expecter) ReadFeedPosts(userId interface{}, tag interface{}, limit interface{}) *MockPostRepositoryInterface_ReadFeedPosts_Call {
+	return &MockPostRepositoryInterface_ReadFeedPosts_Call{Call: _e.mock.On("ReadFeedPosts", userId, tag, limit)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadFeedPosts_Call) Run(run func(userId int, tag string, limit int)) *MockPostRepositoryInterface_ReadFeedPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadFeedPosts_Call) Return(feedPosts []domain.FeedPost, err error) *MockPostRepositoryInterface_ReadFeedPosts_Call {
+	_c.Call.Return(feedPosts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadFeedPosts_Call) RunAndReturn(run func(userId int, tag string, limit int) ([]domain.FeedPost, error)) *MockPostRepositoryInterface_ReadFeedPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadMentioningPosts provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadMentioningPosts(username string, beforeId int, limit int) ([]domain.Post, error) {
+	ret := _mock.Called(username, beforeId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadMentioningPosts")
+	}
+
+	var r0 []domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, int, int) ([]domain.Post, error)); ok {
+		return returnFunc(username, beforeId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, int, int) []domain.Post); ok {
+		r0 = returnFunc(username, beforeId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = returnFunc(username, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadMentioningPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadMentioningPosts'
+type MockPostRepositoryInterface_ReadMentioningPosts_Call struct {
+	*mock.Call
+}
+
+// ReadMentioningPosts is a helper method to define mock.On call
+//   - username string
+//   - beforeId int
+//   - limit int
+func (_e *MockPostRepositoryInterface_Expecter) ReadMentioningPosts(username interface{}, beforeId interface{}, limit interface{}) *MockPostRepositoryInterface_ReadMentioningPosts_Call {
+	return &MockPostRepositoryInterface_ReadMentioningPosts_Call{Call: _e.mock.On("ReadMentioningPosts", username, beforeId, limit)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadMentioningPosts_Call) Run(run func(username string, beforeId int, limit int)) *MockPostRepositoryInterface_ReadMentioningPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadMentioningPosts_Call) Return(posts []domain.Post, err error) *MockPostRepositoryInterface_ReadMentioningPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadMentioningPosts_Call) RunAndReturn(run func(username string, beforeId int, limit int) ([]domain.Post, error)) *MockPostRepositoryInterface_ReadMentioningPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadPinnedPosts provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadPinnedPosts(userId int) ([]domain.Post, error) {
+	ret := _mock.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPinnedPosts")
+	}
+
+	var r0 []domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.Post, error)); ok {
+		return returnFunc(userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.Post); ok {
+		r0 = returnFunc(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadPinnedPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPinnedPosts'
+type MockPostRepositoryInterface_ReadPinnedPosts_Call struct {
+	*mock.Call
+}
+
+// ReadPinnedPosts is a helper method to define mock.On call
+//   - userId int
+func (_e *MockPostRepositoryInterface_Expecter) ReadPinnedPosts(userId interface{}) *MockPostRepositoryInterface_ReadPinnedPosts_Call {
+	return &MockPostRepositoryInterface_ReadPinnedPosts_Call{Call: _e.mock.On("ReadPinnedPosts", userId)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadPinnedPosts_Call) Run(run func(userId int)) *MockPostRepositoryInterface_ReadPinnedPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadPinnedPosts_Call) Return(posts []domain.Post, err error) *MockPostRepositoryInterface_ReadPinnedPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadPinnedPosts_Call) RunAndReturn(run func(userId int) ([]domain.Post, error)) *MockPostRepositoryInterface_ReadPinnedPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadPost provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadPost(id int) (*domain.Post, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPost")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (*domain.Post, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) *domain.Post); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPost'
+type MockPostRepositoryInterface_ReadPost_Call struct {
+	*mock.Call
+}
+
+// ReadPost is a helper method to define mock.On call
+//   - id int
+func (_e *MockPostRepositoryInterface_Expecter) ReadPost(id interface{}) *MockPostRepositoryInterface_ReadPost_Call {
+	return &MockPostRepositoryInterface_ReadPost_Call{Call: _e.mock.On("ReadPost", id)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadPost_Call) Run(run func(id int)) *MockPostRepositoryInterface_ReadPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadPost_Call) Return(post *domain.Post, err error) *MockPostRepositoryInterface_ReadPost_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadPost_Call) RunAndReturn(run func(id int) (*domain.Post, error)) *MockPostRepositoryInterface_ReadPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadPostBySlug provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadPostBySlug(slug string) (*domain.Post, error) {
+	ret := _mock.Called(slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPostBySlug")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.Post, error)); ok {
+		return returnFunc(slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.Post); ok {
+		r0 = returnFunc(slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadPostBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPostBySlug'
+type MockPostRepositoryInterface_ReadPostBySlug_Call struct {
+	*mock.Call
+}
+
+// ReadPostBySlug is a helper method to define mock.On call
+//   - slug string
+func (_e *MockPostRepositoryInterface_Expecter) ReadPostBySlug(slug interface{}) *MockPostRepositoryInterface_ReadPostBySlug_Call {
+	return &MockPostRepositoryInterface_ReadPostBySlug_Call{Call: _e.mock.On("ReadPostBySlug", slug)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadPostBySlug_Call) Run(run func(slug string)) *MockPostRepositoryInterface_ReadPostBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadPostBySlug_Call) Return(post *domain.Post, err error) *MockPostRepositoryInterface_ReadPostBySlug_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadPostBySlug_Call) RunAndReturn(run func(slug string) (*domain.Post, error)) *MockPostRepositoryInterface_ReadPostBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadPostsByIds provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadPostsByIds(ids []int) ([]domain.Post, error) {
+	ret := _mock.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPostsByIds")
+	}
+
+	var r0 []domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]int) ([]domain.Post, error)); ok {
+		return returnFunc(ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]int) []domain.Post); ok {
+		r0 = returnFunc(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]int) error); ok {
+		r1 = returnFunc(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadPostsByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPostsByIds'
+type MockPostRepositoryInterface_ReadPostsByIds_Call struct {
+	*mock.Call
+}
+
+// ReadPostsByIds is a helper method to define mock.On call
+//   - ids []int
+func (_e *MockPostRepositoryInterface_Expecter) ReadPostsByIds(ids interface{}) *MockPostRepositoryInterface_ReadPostsByIds_Call {
+	return &MockPostRepositoryInterface_ReadPostsByIds_Call{Call: _e.mock.On("ReadPostsByIds", ids)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadPostsByIds_Call) Run(run func(ids []int)) *MockPostRepositoryInterface_ReadPostsByIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []int
+		if args[0] != nil {
+			arg0 = args[0].([]int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadPostsByIds_Call) Return(posts []domain.Post, err error) *MockPostRepositoryInterface_ReadPostsByIds_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadPostsByIds_Call) RunAndReturn(run func(ids []int) ([]domain.Post, error)) *MockPostRepositoryInterface_ReadPostsByIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadPostsWithoutSlug provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadPostsWithoutSlug() ([]domain.Post, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPostsWithoutSlug")
+	}
+
+	var r0 []domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]domain.Post, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []domain.Post); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadPostsWithoutSlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPostsWithoutSlug'
+type MockPostRepositoryInterface_ReadPostsWithoutSlug_Call struct {
+	*mock.Call
+}
+
+// ReadPostsWithoutSlug is a helper method to define mock.On call
+func (_e *MockPostRepositoryInterface_Expecter) ReadPostsWithoutSlug() *MockPostRepositoryInterface_ReadPostsWithoutSlug_Call {
+	return &MockPostRepositoryInterface_ReadPostsWithoutSlug_Call{Call: _e.mock.On("ReadPostsWithoutSlug")}
+}
+
+func (_c *MockPostRepositoryInterface_ReadPostsWithoutSlug_Call) Run(run func()) *MockPostRepositoryInterface_ReadPostsWithoutSlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadPostsWithoutSlug_Call) Return(posts []domain.Post, err error) *MockPostRepositoryInterface_ReadPostsWithoutSlug_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadPostsWithoutSlug_Call) RunAndReturn(run func() ([]domain.Post, error)) *MockPostRepositoryInterface_ReadPostsWithoutSlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadRecentPosts provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadRecentPosts(userId int, limit int) ([]domain.Post, error) {
+	ret := _mock.Called(userId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadRecentPosts")
+	}
+
+	var r0 []domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) ([]domain.Post, error)); ok {
+		return returnFunc(userId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int) []domain.Post); ok {
+		r0 = returnFunc(userId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = returnFunc(userId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadRecentPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadRecentPosts'
+type MockPostRepositoryInterface_ReadRecentPosts_Call struct {
+	*mock.Call
+}
+
+// ReadRecentPosts is a helper method to define mock.On call
+//   - userId int
+//   - limit int
+func (_e *MockPostRepositoryInterface_Expecter) ReadRecentPosts(userId interface{}, limit interface{}) *MockPostRepositoryInterface_ReadRecentPosts_Call {
+	return &MockPostRepositoryInterface_ReadRecentPosts_Call{Call: _e.mock.On("ReadRecentPosts", userId, limit)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadRecentPosts_Call) Run(run func(userId int, limit int)) *MockPostRepositoryInterface_ReadRecentPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadRecentPosts_Call) Return(posts []domain.Post, err error) *MockPostRepositoryInterface_ReadRecentPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadRecentPosts_Call) RunAndReturn(run func(userId int, limit int) ([]domain.Post, error)) *MockPostRepositoryInterface_ReadRecentPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadScheduledPosts provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadScheduledPosts(userId int) ([]domain.Post, error) {
+	ret := _mock.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadScheduledPosts")
+	}
+
+	var r0 []domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.Post, error)); ok {
+		return returnFunc(userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.Post); ok {
+		r0 = returnFunc(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadScheduledPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadScheduledPosts'
+type MockPostRepositoryInterface_ReadScheduledPosts_Call struct {
+	*mock.Call
+}
+
+// ReadScheduledPosts is a helper method to define mock.On call
+//   - userId int
+func (_e *MockPostRepositoryInterface_Expecter) ReadScheduledPosts(userId interface{}) *MockPostRepositoryInterface_ReadScheduledPosts_Call {
+	return &MockPostRepositoryInterface_ReadScheduledPosts_Call{Call: _e.mock.On("ReadScheduledPosts", userId)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadScheduledPosts_Call) Run(run func(userId int)) *MockPostRepositoryInterface_ReadScheduledPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadScheduledPosts_Call) Return(posts []domain.Post, err error) *MockPostRepositoryInterface_ReadScheduledPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadScheduledPosts_Call) RunAndReturn(run func(userId int) ([]domain.Post, error)) *MockPostRepositoryInterface_ReadScheduledPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadSlugOwner provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadSlugOwner(slug string) (int, error) {
+	ret := _mock.Called(slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadSlugOwner")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return returnFunc(slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) int); ok {
+		r0 = returnFunc(slug)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadSlugOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadSlugOwner'
+type MockPostRepositoryInterface_ReadSlugOwner_Call struct {
+	*mock.Call
+}
+
+// ReadSlugOwner is a helper method to define mock.On call
+//   - slug string
+func (_e *MockPostRepositoryInterface_Expecter) ReadSlugOwner(slug interface{}) *MockPostRepositoryInterface_ReadSlugOwner_Call {
+	return &MockPostRepositoryInterface_ReadSlugOwner_Call{Call: _e.mock.On("ReadSlugOwner", slug)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadSlugOwner_Call) Run(run func(slug string)) *MockPostRepositoryInterface_ReadSlugOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadSlugOwner_Call) Return(n int, err error) *MockPostRepositoryInterface_ReadSlugOwner_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadSlugOwner_Call) RunAndReturn(run func(slug string) (int, error)) *MockPostRepositoryInterface_ReadSlugOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTimeline provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadTimeline(userId int, beforeCreatedAt time.Time, beforeId int, limit int) ([]domain.Post, error) {
+	ret := _mock.Called(userId, beforeCreatedAt, beforeId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTimeline")
+	}
+
+	var r0 []domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, time.Time, int, int) ([]domain.Post, error)); ok {
+		return returnFunc(userId, beforeCreatedAt, beforeId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, time.Time, int, int) []domain.Post); ok {
+		r0 = returnFunc(userId, beforeCreatedAt, beforeId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, time.Time, int, int) error); ok {
+		r1 = returnFunc(userId, beforeCreatedAt, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadTimeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTimeline'
+type MockPostRepositoryInterface_ReadTimeline_Call struct {
+	*mock.Call
+}
+
+// ReadTimeline is a helper method to define mock.On call
+//   - userId int
+//   - beforeCreatedAt time.Time
+//   - beforeId int
+//   - limit int
+func (_e *MockPostRepositoryInterface_Expecter) ReadTimeline(userId interface{}, beforeCreatedAt interface{}, beforeId interface{}, limit interface{}) *MockPostRepositoryInterface_ReadTimeline_Call {
+	return &MockPostRepositoryInterface_ReadTimeline_Call{Call: _e.mock.On("ReadTimeline", userId, beforeCreatedAt, beforeId, limit)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadTimeline_Call) Run(run func(userId int, beforeCreatedAt time.Time, beforeId int, limit int)) *MockPostRepositoryInterface_ReadTimeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadTimeline_Call) Return(posts []domain.Post, err error) *MockPostRepositoryInterface_ReadTimeline_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadTimeline_Call) RunAndReturn(run func(userId int, beforeCreatedAt time.Time, beforeId int, limit int) ([]domain.Post, error)) *MockPostRepositoryInterface_ReadTimeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTrash provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadTrash(userId int) ([]domain.Post, error) {
+	ret := _mock.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTrash")
+	}
+
+	var r0 []domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.Post, error)); ok {
+		return returnFunc(userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.Post); ok {
+		r0 = returnFunc(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTrash'
+type MockPostRepositoryInterface_ReadTrash_Call struct {
+	*mock.Call
+}
+
+// ReadTrash is a helper method to define mock.On call
+//   - userId int
+func (_e *MockPostRepositoryInterface_Expecter) ReadTrash(userId interface{}) *MockPostRepositoryInterface_ReadTrash_Call {
+	return &MockPostRepositoryInterface_ReadTrash_Call{Call: _e.mock.On("ReadTrash", userId)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadTrash_Call) Run(run func(userId int)) *MockPostRepositoryInterface_ReadTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadTrash_Call) Return(posts []domain.Post, err error) *MockPostRepositoryInterface_ReadTrash_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadTrash_Call) RunAndReturn(run func(userId int) ([]domain.Post, error)) *MockPostRepositoryInterface_ReadTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTrashedPost provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadTrashedPost(id int) (*domain.Post, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTrashedPost")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (*domain.Post, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) *domain.Post); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadTrashedPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTrashedPost'
+type MockPostRepositoryInterface_ReadTrashedPost_Call struct {
+	*mock.Call
+}
+
+// ReadTrashedPost is a helper method to define mock.On call
+//   - id int
+func (_e *MockPostRepositoryInterface_Expecter) ReadTrashedPost(id interface{}) *MockPostRepositoryInterface_ReadTrashedPost_Call {
+	return &MockPostRepositoryInterface_ReadTrashedPost_Call{Call: _e.mock.On("ReadTrashedPost", id)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadTrashedPost_Call) Run(run func(id int)) *MockPostRepositoryInterface_ReadTrashedPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadTrashedPost_Call) Return(post *domain.Post, err error) *MockPostRepositoryInterface_ReadTrashedPost_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadTrashedPost_Call) RunAndReturn(run func(id int) (*domain.Post, error)) *MockPostRepositoryInterface_ReadTrashedPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUserPosts provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) ReadUserPosts(userId int) ([]domain.Post, error) {
+	ret := _mock.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUserPosts")
+	}
+
+	var r0 []domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.Post, error)); ok {
+		return returnFunc(userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.Post); ok {
+		r0 = returnFunc(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_ReadUserPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUserPosts'
+type MockPostRepositoryInterface_ReadUserPosts_Call struct {
+	*mock.Call
+}
+
+// ReadUserPosts is a helper method to define mock.On call
+//   - userId int
+func (_e *MockPostRepositoryInterface_Expecter) ReadUserPosts(userId interface{}) *MockPostRepositoryInterface_ReadUserPosts_Call {
+	return &MockPostRepositoryInterface_ReadUserPosts_Call{Call: _e.mock.On("ReadUserPosts", userId)}
+}
+
+func (_c *MockPostRepositoryInterface_ReadUserPosts_Call) Run(run func(userId int)) *MockPostRepositoryInterface_ReadUserPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadUserPosts_Call) Return(posts []domain.Post, err error) *MockPostRepositoryInterface_ReadUserPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_ReadUserPosts_Call) RunAndReturn(run func(userId int) ([]domain.Post, error)) *MockPostRepositoryInterface_ReadUserPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestorePost provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) RestorePost(id int) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestorePost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryInterface_RestorePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestorePost'
+type MockPostRepositoryInterface_RestorePost_Call struct {
+	*mock.Call
+}
+
+// RestorePost is a helper method to define mock.On call
+//   - id int
+func (_e *MockPostRepositoryInterface_Expecter) RestorePost(id interface{}) *MockPostRepositoryInterface_RestorePost_Call {
+	return &MockPostRepositoryInterface_RestorePost_Call{Call: _e.mock.On("RestorePost", id)}
+}
+
+func (_c *MockPostRepositoryInterface_RestorePost_Call) Run(run func(id int)) *MockPostRepositoryInterface_RestorePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_RestorePost_Call) Return(err error) *MockPostRepositoryInterface_RestorePost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_RestorePost_Call) RunAndReturn(run func(id int) error) *MockPostRepositoryInterface_RestorePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SchedulePost provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) SchedulePost(id int, publishAt time.Time) error {
+	ret := _mock.Called(id, publishAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SchedulePost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, time.Time) error); ok {
+		r0 = returnFunc(id, publishAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryInterface_SchedulePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SchedulePost'
+type MockPostRepositoryInterface_SchedulePost_Call struct {
+	*mock.Call
+}
+
+// SchedulePost is a helper method to define mock.On call
+//   - id int
+//   - publishAt time.Time
+func (_e *MockPostRepositoryInterface_Expecter) SchedulePost(id interface{}, publishAt interface{}) *MockPostRepositoryInterface_SchedulePost_Call {
+	return &MockPostRepositoryInterface_SchedulePost_Call{Call: _e.mock.On("SchedulePost", id, publishAt)}
+}
+
+func (_c *MockPostRepositoryInterface_SchedulePost_Call) Run(run func(id int, publishAt time.Time)) *MockPostRepositoryInterface_SchedulePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_SchedulePost_Call) Return(err error) *MockPostRepositoryInterface_SchedulePost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_SchedulePost_Call) RunAndReturn(run func(id int, publishAt time.Time) error) *MockPostRepositoryInterface_SchedulePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePost provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) UpdatePost(id int, version int, update *domain.PostUpdate) (bool, error) {
+	ret := _mock.Called(id, version, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePost")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, *domain.PostUpdate) (bool, error)); ok {
+		return returnFunc(id, version, update)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, *domain.PostUpdate) bool); ok {
+		r0 = returnFunc(id, version, update)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, *domain.PostUpdate) error); ok {
+		r1 = returnFunc(id, version, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryInterface_UpdatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePost'
+type MockPostRepositoryInterface_UpdatePost_Call struct {
+	*mock.Call
+}
+
+// UpdatePost is a helper method to define mock.On call
+//   - id int
+//   - version int
+//   - update *domain.PostUpdate
+func (_e *MockPostRepositoryInterface_Expecter) UpdatePost(id interface{}, version interface{}, update interface{}) *MockPostRepositoryInterface_UpdatePost_Call {
+	return &MockPostRepositoryInterface_UpdatePost_Call{Call: _e.mock.On("UpdatePost", id, version, update)}
+}
+
+func (_c *MockPostRepositoryInterface_UpdatePost_Call) Run(run func(id int, version int, update *domain.PostUpdate)) *MockPostRepositoryInterface_UpdatePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 *domain.PostUpdate
+		if args[2] != nil {
+			arg2 = args[2].(*domain.PostUpdate)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_UpdatePost_Call) Return(b bool, err error) *MockPostRepositoryInterface_UpdatePost_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_UpdatePost_Call) RunAndReturn(run func(id int, version int, update *domain.PostUpdate) (bool, error)) *MockPostRepositoryInterface_UpdatePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePostSlug provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) UpdatePostSlug(id int, slug string) error {
+	ret := _mock.Called(id, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePostSlug")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = returnFunc(id, slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryInterface_UpdatePostSlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePostSlug'
+type MockPostRepositoryInterface_UpdatePostSlug_Call struct {
+	*mock.Call
+}
+
+// UpdatePostSlug is a helper method to define mock.On call
+//   - id int
+//   - slug string
+func (_e *MockPostRepositoryInterface_Expecter) UpdatePostSlug(id interface{}, slug interface{}) *MockPostRepositoryInterface_UpdatePostSlug_Call {
+	return &MockPostRepositoryInterface_UpdatePostSlug_Call{Call: _e.mock.On("UpdatePostSlug", id, slug)}
+}
+
+func (_c *MockPostRepositoryInterface_UpdatePostSlug_Call) Run(run func(id int, slug string)) *MockPostRepositoryInterface_UpdatePostSlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_UpdatePostSlug_Call) Return(err error) *MockPostRepositoryInterface_UpdatePostSlug_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_UpdatePostSlug_Call) RunAndReturn(run func(id int, slug string) error) *MockPostRepositoryInterface_UpdatePostSlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePostStatus provides a mock function for the type MockPostRepositoryInterface
+func (_mock *MockPostRepositoryInterface) UpdatePostStatus(id int, status domain.PostStatus) error {
+	ret := _mock.Called(id, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePostStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, domain.PostStatus) error); ok {
+		r0 = returnFunc(id, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryInterface_UpdatePostStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePostStatus'
+type MockPostRepositoryInterface_UpdatePostStatus_Call struct {
+	*mock.Call
+}
+
+// UpdatePostStatus is a helper method to define mock.On call
+//   - id int
+//   - status domain.PostStatus
+func (_e *MockPostRepositoryInterface_Expecter) UpdatePostStatus(id interface{}, status interface{}) *MockPostRepositoryInterface_UpdatePostStatus_Call {
+	return &MockPostRepositoryInterface_UpdatePostStatus_Call{Call: _e.mock.On("UpdatePostStatus", id, status)}
+}
+
+func (_c *MockPostRepositoryInterface_UpdatePostStatus_Call) Run(run func(id int, status domain.PostStatus)) *MockPostRepositoryInterface_UpdatePostStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 domain.PostStatus
+		if args[1] != nil {
+			arg1 = args[1].(domain.PostStatus)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_UpdatePostStatus_Call) Return(err error) *MockPostRepositoryInterface_UpdatePostStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryInterface_UpdatePostStatus_Call) RunAndReturn(run func(id int, status domain.PostStatus) error) *MockPostRepositoryInterface_UpdatePostStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostRevisionRepositoryInterface creates a new instance of MockPostRevisionRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostRevisionRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostRevisionRepositoryInterface {
+	mock := &MockPostRevisionRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostRevisionRepositoryInterface is an autogenerated mock type for the PostRevisionRepositoryInterface type
+type MockPostRevisionRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockPostRevisionRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostRevisionRepositoryInterface) EXPECT() *MockPostRevisionRepositoryInterface_Expecter {
+	return &MockPostRevisionRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// ReadRevision provides a mock function for the type MockPostRevisionRepositoryInterface
+func (_mock *MockPostRevisionRepositoryInterface) ReadRevision(postId int, revision int) (*domain.PostRevision, error) {
+	ret := _mock.Called(postId, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadRevision")
+	}
+
+	var r0 *domain.PostRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) (*domain.PostRevision, error)); ok {
+		return returnFunc(postId, revision)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int) *domain.PostRevision); ok {
+		r0 = returnFunc(postId, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PostRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = returnFunc(postId, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRevisionRepositoryInterface_ReadRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadRevision'
+type MockPostRevisionRepositoryInterface_ReadRevision_Call struct {
+	*mock.Call
+}
+
+// ReadRevision is a helper method to define mock.On call
+//   - postId int
+//   - revision int
+func (_e *MockPostRevisionRepositoryInterface_Expecter) ReadRevision(postId interface{}, revision interface{}) *MockPostRevisionRepositoryInterface_ReadRevision_Call {
+	return &MockPostRevisionRepositoryInterface_ReadRevision_Call{Call: _e.mock.On("ReadRevision", postId, revision)}
+}
+
+func (_c *MockPostRevisionRepositoryInterface_ReadRevision_Call) Run(run func(postId int, revision int)) *MockPostRevisionRepositoryInterface_ReadRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRevisionRepositoryInterface_ReadRevision_Call) Return(postRevision *domain.PostRevision, err error) *MockPostRevisionRepositoryInterface_ReadRevision_Call {
+	_c.Call.Return(postRevision, err)
+	return _c
+}
+
+func (_c *MockPostRevisionRepositoryInterface_ReadRevision_Call) RunAndReturn(run func(postId int, revision int) (*domain.PostRevision, error)) *MockPostRevisionRepositoryInterface_ReadRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadRevisions provides a mock function for the type MockPostRevisionRepositoryInterface
+func (_mock *MockPostRevisionRepositoryInterface) ReadRevisions(postId int) ([]domain.PostRevision, error) {
+	ret := _mock.Called(postId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadRevisions")
+	}
+
+	var r0 []domain.PostRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.PostRevision, error)); ok {
+		return returnFunc(postId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.PostRevision); ok {
+		r0 = returnFunc(postId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PostRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(postId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRevisionRepositoryInterface_ReadRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadRevisions'
+type MockPostRevisionRepositoryInterface_ReadRevisions_Call struct {
+	*mock.Call
+}
+
+// ReadRevisions is a helper method to define mock.On call
+//   - postId int
+func (_e *MockPostRevisionRepositoryInterface_Expecter) ReadRevisions(postId interface{}) *MockPostRevisionRepositoryInterface_ReadRevisions_Call {
+	return &MockPostRevisionRepositoryInterface_ReadRevisions_Call{Call: _e.mock.On("ReadRevisions", postId)}
+}
+
+func (_c *MockPostRevisionRepositoryInterface_ReadRevisions_Call) Run(run func(postId int)) *MockPostRevisionRepositoryInterface_ReadRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRevisionRepositoryInterface_ReadRevisions_Call) Return(postRevisions []domain.PostRevision, err error) *MockPostRevisionRepositoryInterface_ReadRevisions_Call {
+	_c.Call.Return(postRevisions, err)
+	return _c
+}
+
+func (_c *MockPostRevisionRepositoryInterface_ReadRevisions_Call) RunAndReturn(run func(postId int) ([]domain.PostRevision, error)) *MockPostRevisionRepositoryInterface_ReadRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostTranslationRepositoryInterface creates a new instance of MockPostTranslationRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostTranslationRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostTranslationRepositoryInterface {
+	mock := &MockPostTranslationRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostTranslationRepositoryInterface is an autogenerated mock type for the PostTranslationRepositoryInterface type
+type MockPostTranslationRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockPostTranslationRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostTranslationRepositoryInterface) EXPECT() *MockPostTranslationRepositoryInterface_Expecter {
+	return &MockPostTranslationRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// CacheTranslationHTML provides a mock function for the type MockPostTranslationRepositoryInterface
+func (_mock *MockPostTranslationRepositoryInterface) CacheTranslationHTML(postId int, language string, contentHTML string) error {
+	ret := _mock.Called(postId, language, contentHTML)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CacheTranslationHTML")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, string) error); ok {
+		r0 = returnFunc(postId, language, contentHTML)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostTranslationRepositoryInterface_CacheTranslationHTML_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CacheTranslationHTML'
+type MockPostTranslationRepositoryInterface_CacheTranslationHTML_Call struct {
+	*mock.Call
+}
+
+// CacheTranslationHTML is a helper method to define mock.On call
+//   - postId int
+//   - language string
+//   - contentHTML string
+func (_e *MockPostTranslationRepositoryInterface_Expecter) CacheTranslationHTML(postId interface{}, language interface{}, contentHTML interface{}) *MockPostTranslationRepositoryInterface_CacheTranslationHTML_Call {
+	return &MockPostTranslationRepositoryInterface_CacheTranslationHTML_Call{Call: _e.mock.On("CacheTranslationHTML", postId, language, contentHTML)}
+}
+
+func (_c *MockPostTranslationRepositoryInterface_CacheTranslationHTML_Call) Run(run func(postId int, language string, contentHTML string)) *MockPostTranslationRepositoryInterface_CacheTranslationHTML_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostTranslationRepositoryInterface_CacheTranslationHTML_Call) Return(err error) *MockPostTranslationRepositoryInterface_CacheTranslationHTML_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostTranslationRepositoryInterface_CacheTranslationHTML_Call) RunAndReturn(run func(postId int, language string, contentHTML string) error) *MockPostTranslationRepositoryInterface_CacheTranslationHTML_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTranslation provides a mock function for the type MockPostTranslationRepositoryInterface
+func (_mock *MockPostTranslationRepositoryInterface) DeleteTranslation(postId int, language string) (bool, error) {
+	ret := _mock.Called(postId, language)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTranslation")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) (bool, error)); ok {
+		return returnFunc(postId, language)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) bool); ok {
+		r0 = returnFunc(postId, language)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(postId, language)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostTranslationRepositoryInterface_DeleteTranslation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTranslation'
+type MockPostTranslationRepositoryInterface_DeleteTranslation_Call struct {
+	*mock.Call
+}
+
+// DeleteTranslation is a helper method to define mock.On call
+//   - postId int
+//   - language string
+func (_e *MockPostTranslationRepositoryInterface_Expecter) DeleteTranslation(postId interface{}, language interface{}) *MockPostTranslationRepositoryInterface_DeleteTranslation_Call {
+	return &MockPostTranslationRepositoryInterface_DeleteTranslation_Call{Call: _e.mock.On("DeleteTranslation", postId, language)}
+}
+
+func (_c *MockPostTranslationRepositoryInterface_DeleteTranslation_Call) Run(run func(postId int, language string)) *MockPostTranslationRepositoryInterface_DeleteTranslation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostTranslationRepositoryInterface_DeleteTranslation_Call) Return(b bool, err error) *MockPostTranslationRepositoryInterface_DeleteTranslation_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPostTranslationRepositoryInterface_DeleteTranslation_Call) RunAndReturn(run func(postId int, language string) (bool, error)) *MockPostTranslationRepositoryInterface_DeleteTranslation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTranslations provides a mock function for the type MockPostTranslationRepositoryInterface
+func (_mock *MockPostTranslationRepositoryInterface) ReadTranslations(language string, postIds []int) ([]domain.PostTranslation, error) {
+	ret := _mock.Called(language, postIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTranslations")
+	}
+
+	var r0 []domain.PostTranslation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, []int) ([]domain.PostTranslation, error)); ok {
+		return returnFunc(language, postIds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, []int) []domain.PostTranslation); ok {
+		r0 = returnFunc(language, postIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PostTranslation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, []int) error); ok {
+		r1 = returnFunc(language, postIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostTranslationRepositoryInterface_ReadTranslations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTranslations'
+type MockPostTranslationRepositoryInterface_ReadTranslations_Call struct {
+	*mock.Call
+}
+
+// ReadTranslations is a helper method to define mock.On call
+//   - language string
+//   - postIds []int
+func (_e *MockPostTranslationRepositoryInterface_Expecter) ReadTranslations(language interface{}, postIds interface{}) *MockPostTranslationRepositoryInterface_ReadTranslations_Call {
+	return &MockPostTranslationRepositoryInterface_ReadTranslations_Call{Call: _e.mock.On("ReadTranslations", language, postIds)}
+}
+
+func (_c *MockPostTranslationRepositoryInterface_ReadTranslations_Call) Run(run func(language string, postIds []int)) *MockPostTranslationRepositoryInterface_ReadTranslations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 []int
+		if args[1] != nil {
+			arg1 = args[1].([]int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostTranslationRepositoryInterface_ReadTranslations_Call) Return(postTranslations []domain.PostTranslation, err error) *MockPostTranslationRepositoryInterface_ReadTranslations_Call {
+	_c.Call.Return(postTranslations, err)
+	return _c
+}
+
+func (_c *MockPostTranslationRepositoryInterface_ReadTranslations_Call) RunAndReturn(run func(language string, postIds []int) ([]domain.PostTranslation, error)) *MockPostTranslationRepositoryInterface_ReadTranslations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTranslation provides a mock function for the type MockPostTranslationRepositoryInterface
+func (_mock *MockPostTranslationRepositoryInterface) SaveTranslation(t *domain.PostTranslation, flags []domain.PostFlag) (bool, error) {
+	ret := _mock.Called(t, flags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTranslation")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.PostTranslation, []domain.PostFlag) (bool, error)); ok {
+		return returnFunc(t, flags)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*domain.PostTranslation, []domain.PostFlag) bool); ok {
+		r0 = returnFunc(t, flags)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*domain.PostTranslation, []domain.PostFlag) error); ok {
+		r1 = returnFunc(t, flags)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostTranslationRepositoryInterface_SaveTranslation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTranslation'
+type MockPostTranslationRepositoryInterface_SaveTranslation_Call struct {
+	*mock.Call
+}
+
+// SaveTranslation is a helper method to define mock.On call
+//   - t *domain.PostTranslation
+//   - flags []domain.PostFlag
+func (_e *MockPostTranslationRepositoryInterface_Expecter) SaveTranslation(t interface{}, flags interface{}) *MockPostTranslationRepositoryInterface_SaveTranslation_Call {
+	return &MockPostTranslationRepositoryInterface_SaveTranslation_Call{Call: _e.mock.On("SaveTranslation", t, flags)}
+}
+
+func (_c *MockPostTranslationRepositoryInterface_SaveTranslation_Call) Run(run func(t *domain.PostTranslation, flags []domain.PostFlag)) *MockPostTranslationRepositoryInterface_SaveTranslation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.PostTranslation
+		if args[0] != nil {
+			arg0 = args[0].(*domain.PostTranslation)
+		}
+		var arg1 []domain.PostFlag
+		if args[1] != nil {
+			arg1 = args[1].([]domain.PostFlag)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostTranslationRepositoryInterface_SaveTranslation_Call) Return(b bool, err error) *MockPostTranslationRepositoryInterface_SaveTranslation_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPostTranslationRepositoryInterface_SaveTranslation_Call) RunAndReturn(run func(t *domain.PostTranslation, flags []domain.PostFlag) (bool, error)) *MockPostTranslationRepositoryInterface_SaveTranslation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSeriesRepositoryInterface creates a new instance of MockSeriesRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSeriesRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSeriesRepositoryInterface {
+	mock := &MockSeriesRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSeriesRepositoryInterface is an autogenerated mock type for the SeriesRepositoryInterface type
+type MockSeriesRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockSeriesRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSeriesRepositoryInterface) EXPECT() *MockSeriesRepositoryInterface_Expecter {
+	return &MockSeriesRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddSeriesPost provides a mock function for the type MockSeriesRepositoryInterface
+func (_mock *MockSeriesRepositoryInterface) AddSeriesPost(seriesId int, postId int, position int) (bool, error) {
+	ret := _mock.Called(seriesId, postId, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSeriesPost")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) (bool, error)); ok {
+		return returnFunc(seriesId, postId, position)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) bool); ok {
+		r0 = returnFunc(seriesId, postId, position)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, int) error); ok {
+		r1 = returnFunc(seriesId, postId, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesRepositoryInterface_AddSeriesPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSeriesPost'
+type MockSeriesRepositoryInterface_AddSeriesPost_Call struct {
+	*mock.Call
+}
+
+// AddSeriesPost is a helper method to define mock.On call
+//   - seriesId int
+//   - postId int
+//   - position int
+func (_e *MockSeriesRepositoryInterface_Expecter) AddSeriesPost(seriesId interface{}, postId interface{}, position interface{}) *MockSeriesRepositoryInterface_AddSeriesPost_Call {
+	return &MockSeriesRepositoryInterface_AddSeriesPost_Call{Call: _e.mock.On("AddSeriesPost", seriesId, postId, position)}
+}
+
+func (_c *MockSeriesRepositoryInterface_AddSeriesPost_Call) Run(run func(seriesId int, postId int, position int)) *MockSeriesRepositoryInterface_AddSeriesPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_AddSeriesPost_Call) Return(b bool, err error) *MockSeriesRepositoryInterface_AddSeriesPost_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_AddSeriesPost_Call) RunAndReturn(run func(seriesId int, postId int, position int) (bool, error)) *MockSeriesRepositoryInterface_AddSeriesPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSeries provides a mock function for the type MockSeriesRepositoryInterface
+func (_mock *MockSeriesRepositoryInterface) CreateSeries(series *domain.Series) error {
+	ret := _mock.Called(series)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSeries")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.Series) error); ok {
+		r0 = returnFunc(series)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSeriesRepositoryInterface_CreateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeries'
+type MockSeriesRepositoryInterface_CreateSeries_Call struct {
+	*mock.Call
+}
+
+// CreateSeries is a helper method to define mock.On call
+//   - series *domain.Series
+func (_e *MockSeriesRepositoryInterface_Expecter) CreateSeries(series interface{}) *MockSeriesRepositoryInterface_CreateSeries_Call {
+	return &MockSeriesRepositoryInterface_CreateSeries_Call{Call: _e.mock.On("CreateSeries", series)}
+}
+
+func (_c *MockSeriesRepositoryInterface_CreateSeries_Call) Run(run func(series *domain.Series)) *MockSeriesRepositoryInterface_CreateSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.Series
+		if args[0] != nil {
+			arg0 = args[0].(*domain.Series)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_CreateSeries_Call) Return(err error) *MockSeriesRepositoryInterface_CreateSeries_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_CreateSeries_Call) RunAndReturn(run func(series *domain.Series) error) *MockSeriesRepositoryInterface_CreateSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSeries provides a mock function for the type MockSeriesRepositoryInterface
+func (_mock *MockSeriesRepositoryInterface) DeleteSeries(id int) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSeries")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSeriesRepositoryInterface_DeleteSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSeries'
+type MockSeriesRepositoryInterface_DeleteSeries_Call struct {
+	*mock.Call
+}
+
+// DeleteSeries is a helper method to define mock.On call
+//   - id int
+func (_e *MockSeriesRepositoryInterface_Expecter) DeleteSeries(id interface{}) *MockSeriesRepositoryInterface_DeleteSeries_Call {
+	return &MockSeriesRepositoryInterface_DeleteSeries_Call{Call: _e.mock.On("DeleteSeries", id)}
+}
+
+func (_c *MockSeriesRepositoryInterface_DeleteSeries_Call) Run(run func(id int)) *MockSeriesRepositoryInterface_DeleteSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_DeleteSeries_Call) Return(err error) *MockSeriesRepositoryInterface_DeleteSeries_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_DeleteSeries_Call) RunAndReturn(run func(id int) error) *MockSeriesRepositoryInterface_DeleteSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveSeriesPost provides a mock function for the type MockSeriesRepositoryInterface
+func (_mock *MockSeriesRepositoryInterface) MoveSeriesPost(seriesId int, postId int, position int) (bool, error) {
+	ret := _mock.Called(seriesId, postId, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveSeriesPost")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) (bool, error)); ok {
+		return returnFunc(seriesId, postId, position)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) bool); ok {
+		r0 = returnFunc(seriesId, postId, position)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, int) error); ok {
+		r1 = returnFunc(seriesId, postId, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesRepositoryInterface_MoveSeriesPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveSeriesPost'
+type MockSeriesRepositoryInterface_MoveSeriesPost_Call struct {
+	*mock.Call
+}
+
+// MoveSeriesPost is a helper method to define mock.On call
+//   - seriesId int
+//   - postId int
+//   - position int
+func (_e *MockSeriesRepositoryInterface_Expecter) MoveSeriesPost(seriesId interface{}, postId interface{}, position interface{}) *MockSeriesRepositoryInterface_MoveSeriesPost_Call {
+	return &MockSeriesRepositoryInterface_MoveSeriesPost_Call{Call: _e.mock.On("MoveSeriesPost", seriesId, postId, position)}
+}
+
+func (_c *MockSeriesRepositoryInterface_MoveSeriesPost_Call) Run(run func(seriesId int, postId int, position int)) *MockSeriesRepositoryInterface_MoveSeriesPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_MoveSeriesPost_Call) Return(b bool, err error) *MockSeriesRepositoryInterface_MoveSeriesPost_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_MoveSeriesPost_Call) RunAndReturn(run func(seriesId int, postId int, position int) (bool, error)) *MockSeriesRepositoryInterface_MoveSeriesPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadPostSeries provides a mock function for the type MockSeriesRepositoryInterface
+func (_mock *MockSeriesRepositoryInterface) ReadPostSeries(postId int) (*domain.Series, error) {
+	ret := _mock.Called(postId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPostSeries")
+	}
+
+	var r0 *domain.Series
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (*domain.Series, error)); ok {
+		return returnFunc(postId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) *domain.Series); ok {
+		r0 = returnFunc(postId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Series)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(postId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesRepositoryInterface_ReadPostSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPostSeries'
+type MockSeriesRepositoryInterface_ReadPostSeries_Call struct {
+	*mock.Call
+}
+
+// ReadPostSeries is a helper method to define mock.On call
+//   - postId int
+func (_e *MockSeriesRepositoryInterface_Expecter) ReadPostSeries(postId interface{}) *MockSeriesRepositoryInterface_ReadPostSeries_Call {
+	return &MockSeriesRepositoryInterface_ReadPostSeries_Call{Call: _e.mock.On("ReadPostSeries", postId)}
+}
+
+func (_c *MockSeriesRepositoryInterface_ReadPostSeries_Call) Run(run func(postId int)) *MockSeriesRepositoryInterface_ReadPostSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_ReadPostSeries_Call) Return(series *domain.Series, err error) *MockSeriesRepositoryInterface_ReadPostSeries_Call {
+	_c.Call.Return(series, err)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_ReadPostSeries_Call) RunAndReturn(run func(postId int) (*domain.Series, error)) *MockSeriesRepositoryInterface_ReadPostSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadSeries provides a mock function for the type MockSeriesRepositoryInterface
+func (_mock *MockSeriesRepositoryInterface) ReadSeries(id int) (*domain.Series, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadSeries")
+	}
+
+	var r0 *domain.Series
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (*domain.Series, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) *domain.Series); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Series)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesRepositoryInterface_ReadSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadSeries'
+type MockSeriesRepositoryInterface_ReadSeries_Call struct {
+	*mock.Call
+}
+
+// ReadSeries is a helper method to define mock.On call
+//   - id int
+func (_e *MockSeriesRepositoryInterface_Expecter) ReadSeries(id interface{}) *MockSeriesRepositoryInterface_ReadSeries_Call {
+	return &MockSeriesRepositoryInterface_ReadSeries_Call{Call: _e.mock.On("ReadSeries", id)}
+}
+
+func (_c *MockSeriesRepositoryInterface_ReadSeries_Call) Run(run func(id int)) *MockSeriesRepositoryInterface_ReadSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_ReadSeries_Call) Return(series *domain.Series, err error) *MockSeriesRepositoryInterface_ReadSeries_Call {
+	_c.Call.Return(series, err)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_ReadSeries_Call) RunAndReturn(run func(id int) (*domain.Series, error)) *MockSeriesRepositoryInterface_ReadSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadSeriesPosts provides a mock function for the type MockSeriesRepositoryInterface
+func (_mock *MockSeriesRepositoryInterface) ReadSeriesPosts(seriesId int) ([]domain.Post, error) {
+	ret := _mock.Called(seriesId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadSeriesPosts")
+	}
+
+	var r0 []domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.Post, error)); ok {
+		return returnFunc(seriesId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.Post); ok {
+		r0 = returnFunc(seriesId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(seriesId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesRepositoryInterface_ReadSeriesPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadSeriesPosts'
+type MockSeriesRepositoryInterface_ReadSeriesPosts_Call struct {
+	*mock.Call
+}
+
+// ReadSeriesPosts is a helper method to define mock.On call
+//   - seriesId int
+func (_e *MockSeriesRepositoryInterface_Expecter) ReadSeriesPosts(seriesId interface{}) *MockSeriesRepositoryInterface_ReadSeriesPosts_Call {
+	return &MockSeriesRepositoryInterface_ReadSeriesPosts_Call{Call: _e.mock.On("ReadSeriesPosts", seriesId)}
+}
+
+func (_c *MockSeriesRepositoryInterface_ReadSeriesPosts_Call) Run(run func(seriesId int)) *MockSeriesRepositoryInterface_ReadSeriesPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_ReadSeriesPosts_Call) Return(posts []domain.Post, err error) *MockSeriesRepositoryInterface_ReadSeriesPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_ReadSeriesPosts_Call) RunAndReturn(run func(seriesId int) ([]domain.Post, error)) *MockSeriesRepositoryInterface_ReadSeriesPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUserSeries provides a mock function for the type MockSeriesRepositoryInterface
+func (_mock *MockSeriesRepositoryInterface) ReadUserSeries(userId int) ([]domain.Series, error) {
+	ret := _mock.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUserSeries")
+	}
+
+	var r0 []domain.Series
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.Series, error)); ok {
+		return returnFunc(userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.Series); ok {
+		r0 = returnFunc(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Series)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesRepositoryInterface_ReadUserSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUserSeries'
+type MockSeriesRepositoryInterface_ReadUserSeries_Call struct {
+	*mock.Call
+}
+
+// ReadUserSeries is a helper method to define mock.On call
+//   - userId int
+func (_e *MockSeriesRepositoryInterface_Expecter) ReadUserSeries(userId interface{}) *MockSeriesRepositoryInterface_ReadUserSeries_Call {
+	return &MockSeriesRepositoryInterface_ReadUserSeries_Call{Call: _e.mock.On("ReadUserSeries", userId)}
+}
+
+func (_c *MockSeriesRepositoryInterface_ReadUserSeries_Call) Run(run func(userId int)) *MockSeriesRepositoryInterface_ReadUserSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_ReadUserSeries_Call) Return(seriess []domain.Series, err error) *MockSeriesRepositoryInterface_ReadUserSeries_Call {
+	_c.Call.Return(seriess, err)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_ReadUserSeries_Call) RunAndReturn(run func(userId int) ([]domain.Series, error)) *MockSeriesRepositoryInterface_ReadUserSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveSeriesPost provides a mock function for the type MockSeriesRepositoryInterface
+func (_mock *MockSeriesRepositoryInterface) RemoveSeriesPost(seriesId int, postId int) error {
+	ret := _mock.Called(seriesId, postId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSeriesPost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = returnFunc(seriesId, postId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSeriesRepositoryInterface_RemoveSeriesPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSeriesPost'
+type MockSeriesRepositoryInterface_RemoveSeriesPost_Call struct {
+	*mock.Call
+}
+
+// RemoveSeriesPost is a helper method to define mock.On call
+//   - seriesId int
+//   - postId int
+func (_e *MockSeriesRepositoryInterface_Expecter) RemoveSeriesPost(seriesId interface{}, postId interface{}) *MockSeriesRepositoryInterface_RemoveSeriesPost_Call {
+	return &MockSeriesRepositoryInterface_RemoveSeriesPost_Call{Call: _e.mock.On("RemoveSeriesPost", seriesId, postId)}
+}
+
+func (_c *MockSeriesRepositoryInterface_RemoveSeriesPost_Call) Run(run func(seriesId int, postId int)) *MockSeriesRepositoryInterface_RemoveSeriesPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_RemoveSeriesPost_Call) Return(err error) *MockSeriesRepositoryInterface_RemoveSeriesPost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_RemoveSeriesPost_Call) RunAndReturn(run func(seriesId int, postId int) error) *MockSeriesRepositoryInterface_RemoveSeriesPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderSeries provides a mock function for the type MockSeriesRepositoryInterface
+func (_mock *MockSeriesRepositoryInterface) ReorderSeries(seriesId int, postIds []int) (bool, error) {
+	ret := _mock.Called(seriesId, postIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderSeries")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, []int) (bool, error)); ok {
+		return returnFunc(seriesId, postIds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, []int) bool); ok {
+		r0 = returnFunc(seriesId, postIds)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, []int) error); ok {
+		r1 = returnFunc(seriesId, postIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesRepositoryInterface_ReorderSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderSeries'
+type MockSeriesRepositoryInterface_ReorderSeries_Call struct {
+	*mock.Call
+}
+
+// ReorderSeries is a helper method to define mock.On call
+//   - seriesId int
+//   - postIds []int
+func (_e *MockSeriesRepositoryInterface_Expecter) ReorderSeries(seriesId interface{}, postIds interface{}) *MockSeriesRepositoryInterface_ReorderSeries_Call {
+	return &MockSeriesRepositoryInterface_ReorderSeries_Call{Call: _e.mock.On("ReorderSeries", seriesId, postIds)}
+}
+
+func (_c *MockSeriesRepositoryInterface_ReorderSeries_Call) Run(run func(seriesId int, postIds []int)) *MockSeriesRepositoryInterface_ReorderSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 []int
+		if args[1] != nil {
+			arg1 = args[1].([]int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_ReorderSeries_Call) Return(b bool, err error) *MockSeriesRepositoryInterface_ReorderSeries_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_ReorderSeries_Call) RunAndReturn(run func(seriesId int, postIds []int) (bool, error)) *MockSeriesRepositoryInterface_ReorderSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSeries provides a mock function for the type MockSeriesRepositoryInterface
+func (_mock *MockSeriesRepositoryInterface) UpdateSeries(series *domain.Series) error {
+	ret := _mock.Called(series)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSeries")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.Series) error); ok {
+		r0 = returnFunc(series)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSeriesRepositoryInterface_UpdateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSeries'
+type MockSeriesRepositoryInterface_UpdateSeries_Call struct {
+	*mock.Call
+}
+
+// UpdateSeries is a helper method to define mock.On call
+//   - series *domain.Series
+func (_e *MockSeriesRepositoryInterface_Expecter) UpdateSeries(series interface{}) *MockSeriesRepositoryInterface_UpdateSeries_Call {
+	return &MockSeriesRepositoryInterface_UpdateSeries_Call{Call: _e.mock.On("UpdateSeries", series)}
+}
+
+func (_c *MockSeriesRepositoryInterface_UpdateSeries_Call) Run(run func(series *domain.Series)) *MockSeriesRepositoryInterface_UpdateSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.Series
+		if args[0] != nil {
+			arg0 = args[0].(*domain.Series)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_UpdateSeries_Call) Return(err error) *MockSeriesRepositoryInterface_UpdateSeries_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_UpdateSeries_Call) RunAndReturn(run func(series *domain.Series) error) *MockSeriesRepositoryInterface_UpdateSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserRepositoryInterface creates a new instance of MockUserRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserRepositoryInterface {
+	mock := &MockUserRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserRepositoryInterface is an autogenerated mock type for the UserRepositoryInterface type
+type MockUserRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockUserRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserRepositoryInterface) EXPECT() *MockUserRepositoryInterface_Expecter {
+	return &MockUserRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// CancelUserDeletion provides a mock function for the type MockUserRepositoryInterface
+func (_mock *MockUserRepositoryInterface) CancelUserDeletion(email string) error {
+	ret := _mock.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelUserDeletion")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepositoryInterface_CancelUserDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelUserDeletion'
+type MockUserRepositoryInterface_CancelUserDeletion_Call struct {
+	*mock.Call
+}
+
+// CancelUserDeletion is a helper method to define mock.On call
+//   - email string
+func (_e *MockUserRepositoryInterface_Expecter) CancelUserDeletion(email interface{}) *MockUserRepositoryInterface_CancelUserDeletion_Call {
+	return &MockUserRepositoryInterface_CancelUserDeletion_Call{Call: _e.mock.On("CancelUserDeletion", email)}
+}
+
+func (_c *MockUserRepositoryInterface_CancelUserDeletion_Call) Run(run func(email string)) *MockUserRepositoryInterface_CancelUserDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_CancelUserDeletion_Call) Return(err error) *MockUserRepositoryInterface_CancelUserDeletion_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_CancelUserDeletion_Call) RunAndReturn(run func(email string) error) *MockUserRepositoryInterface_CancelUserDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function for the type MockUserRepositoryInterface
+func (_mock *MockUserRepositoryInterface) CreateUser(usr *domain.User) error {
+	ret := _mock.Called(usr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.User) error); ok {
+		r0 = returnFunc(usr)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepositoryInterface_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockUserRepositoryInterface_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - usr *domain.User
+func (_e *MockUserRepositoryInterface_Expecter) CreateUser(usr interface{}) *MockUserRepositoryInterface_CreateUser_Call {
+	return &MockUserRepositoryInterface_CreateUser_Call{Call: _e.mock.On("CreateUser", usr)}
+}
+
+func (_c *MockUserRepositoryInterface_CreateUser_Call) Run(run func(usr *domain.User)) *MockUserRepositoryInterface_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.User
+		if args[0] != nil {
+			arg0 = args[0].(*domain.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_CreateUser_Call) Return(err error) *MockUserRepositoryInterface_CreateUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_CreateUser_Call) RunAndReturn(run func(usr *domain.User) error) *MockUserRepositoryInterface_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function for the type MockUserRepositoryInterface
+func (_mock *MockUserRepositoryInterface) DeleteUser(email string) error {
+	ret := _mock.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepositoryInterface_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockUserRepositoryInterface_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - email string
+func (_e *MockUserRepositoryInterface_Expecter) DeleteUser(email interface{}) *MockUserRepositoryInterface_DeleteUser_Call {
+	return &MockUserRepositoryInterface_DeleteUser_Call{Call: _e.mock.On("DeleteUser", email)}
+}
+
+func (_c *MockUserRepositoryInterface_DeleteUser_Call) Run(run func(email string)) *MockUserRepositoryInterface_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_DeleteUser_Call) Return(err error) *MockUserRepositoryInterface_DeleteUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_DeleteUser_Call) RunAndReturn(run func(email string) error) *MockUserRepositoryInterface_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedUsers provides a mock function for the type MockUserRepositoryInterface
+func (_mock *MockUserRepositoryInterface) PurgeDeletedUsers(before time.Time) (int64, []string, error) {
+	ret := _mock.Called(before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedUsers")
+	}
+
+	var r0 int64
+	var r1 []string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(time.Time) (int64, []string, error)); ok {
+		return returnFunc(before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = returnFunc(before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(time.Time) []string); ok {
+		r1 = returnFunc(before)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(time.Time) error); ok {
+		r2 = returnFunc(before)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserRepositoryInterface_PurgeDeletedUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedUsers'
+type MockUserRepositoryInterface_PurgeDeletedUsers_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedUsers is a helper method to define mock.On call
+//   - before time.Time
+func (_e *MockUserRepositoryInterface_Expecter) PurgeDeletedUsers(before interface{}) *MockUserRepositoryInterface_PurgeDeletedUsers_Call {
+	return &MockUserRepositoryInterface_PurgeDeletedUsers_Call{Call: _e.mock.On("PurgeDeletedUsers", before)}
+}
+
+func (_c *MockUserRepositoryInterface_PurgeDeletedUsers_Call) Run(run func(before time.Time)) *MockUserRepositoryInterface_PurgeDeletedUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Time
+		if args[0] != nil {
+			arg0 = args[0].(time.Time)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_PurgeDeletedUsers_Call) Return(n int64, strings []string, err error) *MockUserRepositoryInterface_PurgeDeletedUsers_Call {
+	_c.Call.Return(n, strings, err)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_PurgeDeletedUsers_Call) RunAndReturn(run func(before time.Time) (int64, []string, error)) *MockUserRepositoryInterface_PurgeDeletedUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadErasurePlan provides a mock function for the type MockUserRepositoryInterface
+func (_mock *MockUserRepositoryInterface) ReadErasurePlan(userId int) ([]domain.ErasureStep, error) {
+	ret := _mock.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadErasurePlan")
+	}
+
+	var r0 []domain.ErasureStep
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]domain.ErasureStep, error)); ok {
+		return returnFunc(userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []domain.ErasureStep); ok {
+		r0 = returnFunc(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ErasureStep)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepositoryInterface_ReadErasurePlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadErasurePlan'
+type MockUserRepositoryInterface_ReadErasurePlan_Call struct {
+	*mock.Call
+}
+
+// ReadErasurePlan is a helper method to define mock.On call
+//   - userId int
+func (_e *MockUserRepositoryInterface_Expecter) ReadErasurePlan(userId interface{}) *MockUserRepositoryInterface_ReadErasurePlan_Call {
+	return &MockUserRepositoryInterface_ReadErasurePlan_Call{Call: _e.mock.On("ReadErasurePlan", userId)}
+}
+
+func (_c *MockUserRepositoryInterface_ReadErasurePlan_Call) Run(run func(userId int)) *MockUserRepositoryInterface_ReadErasurePlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_ReadErasurePlan_Call) Return(erasureSteps []domain.ErasureStep, err error) *MockUserRepositoryInterface_ReadErasurePlan_Call {
+	_c.Call.Return(erasureSteps, err)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_ReadErasurePlan_Call) RunAndReturn(run func(userId int) ([]domain.ErasureStep, error)) *MockUserRepositoryInterface_ReadErasurePlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUser provides a mock function for the type MockUserRepositoryInterface
+func (_mock *MockUserRepositoryInterface) ReadUser(email string) (*domain.User, error) {
+	ret := _mock.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUser")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.User, error)); ok {
+		return returnFunc(email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.User); ok {
+		r0 = returnFunc(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepositoryInterface_ReadUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUser'
+type MockUserRepositoryInterface_ReadUser_Call struct {
+	*mock.Call
+}
+
+// ReadUser is a helper method to define mock.On call
+//   - email string
+func (_e *MockUserRepositoryInterface_Expecter) ReadUser(email interface{}) *MockUserRepositoryInterface_ReadUser_Call {
+	return &MockUserRepositoryInterface_ReadUser_Call{Call: _e.mock.On("ReadUser", email)}
+}
+
+func (_c *MockUserRepositoryInterface_ReadUser_Call) Run(run func(email string)) *MockUserRepositoryInterface_ReadUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_ReadUser_Call) Return(user *domain.User, err error) *MockUserRepositoryInterface_ReadUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_ReadUser_Call) RunAndReturn(run func(email string) (*domain.User, error)) *MockUserRepositoryInterface_ReadUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUserPendingDeletion provides a mock function for the type MockUserRepositoryInterface
+func (_mock *MockUserRepositoryInterface) ReadUserPendingDeletion(email string) (*domain.User, error) {
+	ret := _mock.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUserPendingDeletion")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.User, error)); ok {
+		return returnFunc(email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.User); ok {
+		r0 = returnFunc(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepositoryInterface_ReadUserPendingDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUserPendingDeletion'
+type MockUserRepositoryInterface_ReadUserPendingDeletion_Call struct {
+	*mock.Call
+}
+
+// ReadUserPendingDeletion is a helper method to define mock.On call
+//   - email string
+func (_e *MockUserRepositoryInterface_Expecter) ReadUserPendingDeletion(email interface{}) *MockUserRepositoryInterface_ReadUserPendingDeletion_Call {
+	return &MockUserRepositoryInterface_ReadUserPendingDeletion_Call{Call: _e.mock.On("ReadUserPendingDeletion", email)}
+}
+
+func (_c *MockUserRepositoryInterface_ReadUserPendingDeletion_Call) Run(run func(email string)) *MockUserRepositoryInterface_ReadUserPendingDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_ReadUserPendingDeletion_Call) Return(user *domain.User, err error) *MockUserRepositoryInterface_ReadUserPendingDeletion_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_ReadUserPendingDeletion_Call) RunAndReturn(run func(email string) (*domain.User, error)) *MockUserRepositoryInterface_ReadUserPendingDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUsersByUsernames provides a mock function for the type MockUserRepositoryInterface
+func (_mock *MockUserRepositoryInterface) ReadUsersByUsernames(usernames []string) ([]domain.User, error) {
+	ret := _mock.Called(usernames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUsersByUsernames")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string) ([]domain.User, error)); ok {
+		return returnFunc(usernames)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string) []domain.User); ok {
+		r0 = returnFunc(usernames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = returnFunc(usernames)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepositoryInterface_ReadUsersByUsernames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUsersByUsernames'
+type MockUserRepositoryInterface_ReadUsersByUsernames_Call struct {
+	*mock.Call
+}
+
+// ReadUsersByUsernames is a helper method to define mock.On call
+//   - usernames []string
+func (_e *MockUserRepositoryInterface_Expecter) ReadUsersByUsernames(usernames interface{}) *MockUserRepositoryInterface_ReadUsersByUsernames_Call {
+	return &MockUserRepositoryInterface_ReadUsersByUsernames_Call{Call: _e.mock.On("ReadUsersByUsernames", usernames)}
+}
+
+func (_c *MockUserRepositoryInterface_ReadUsersByUsernames_Call) Run(run func(usernames []string)) *MockUserRepositoryInterface_ReadUsersByUsernames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_ReadUsersByUsernames_Call) Return(users []domain.User, err error) *MockUserRepositoryInterface_ReadUsersByUsernames_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_ReadUsersByUsernames_Call) RunAndReturn(run func(usernames []string) ([]domain.User, error)) *MockUserRepositoryInterface_ReadUsersByUsernames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUsername provides a mock function for the type MockUserRepositoryInterface
+func (_mock *MockUserRepositoryInterface) UpdateUsername(email string, new_username string) error {
+	ret := _mock.Called(email, new_username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUsername")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(email, new_username)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepositoryInterface_UpdateUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUsername'
 type MockUserRepositoryInterface_UpdateUsername_Call struct {
 	*mock.Call
 }
@@ -566,3 +7772,223 @@ func (_c *MockUserRepositoryInterface_UpdateUsername_Call) RunAndReturn(run func
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockViewRepositoryInterface creates a new instance of MockViewRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockViewRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockViewRepositoryInterface {
+	mock := &MockViewRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockViewRepositoryInterface is an autogenerated mock type for the ViewRepositoryInterface type
+type MockViewRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockViewRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockViewRepositoryInterface) EXPECT() *MockViewRepositoryInterface_Expecter {
+	return &MockViewRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddViews provides a mock function for the type MockViewRepositoryInterface
+func (_mock *MockViewRepositoryInterface) AddViews(counts []domain.ViewCount) error {
+	ret := _mock.Called(counts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddViews")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func([]domain.ViewCount) error); ok {
+		r0 = returnFunc(counts)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockViewRepositoryInterface_AddViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddViews'
+type MockViewRepositoryInterface_AddViews_Call struct {
+	*mock.Call
+}
+
+// AddViews is a helper method to define mock.On call
+//   - counts []domain.ViewCount
+func (_e *MockViewRepositoryInterface_Expecter) AddViews(counts interface{}) *MockViewRepositoryInterface_AddViews_Call {
+	return &MockViewRepositoryInterface_AddViews_Call{Call: _e.mock.On("AddViews", counts)}
+}
+
+func (_c *MockViewRepositoryInterface_AddViews_Call) Run(run func(counts []domain.ViewCount)) *MockViewRepositoryInterface_AddViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []domain.ViewCount
+		if args[0] != nil {
+			arg0 = args[0].([]domain.ViewCount)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockViewRepositoryInterface_AddViews_Call) Return(err error) *MockViewRepositoryInterface_AddViews_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockViewRepositoryInterface_AddViews_Call) RunAndReturn(run func(counts []domain.ViewCount) error) *MockViewRepositoryInterface_AddViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadDailyViews provides a mock function for the type MockViewRepositoryInterface
+func (_mock *MockViewRepositoryInterface) ReadDailyViews(postId int, since string) ([]domain.DayViews, error) {
+	ret := _mock.Called(postId, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDailyViews")
+	}
+
+	var r0 []domain.DayViews
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) ([]domain.DayViews, error)); ok {
+		return returnFunc(postId, since)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) []domain.DayViews); ok {
+		r0 = returnFunc(postId, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.DayViews)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(postId, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockViewRepositoryInterface_ReadDailyViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDailyViews'
+type MockViewRepositoryInterface_ReadDailyViews_Call struct {
+	*mock.Call
+}
+
+// ReadDailyViews is a helper method to define mock.On call
+//   - postId int
+//   - since string
+func (_e *MockViewRepositoryInterface_Expecter) ReadDailyViews(postId interface{}, since interface{}) *MockViewRepositoryInterface_ReadDailyViews_Call {
+	return &MockViewRepositoryInterface_ReadDailyViews_Call{Call: _e.mock.On("ReadDailyViews", postId, since)}
+}
+
+func (_c *MockViewRepositoryInterface_ReadDailyViews_Call) Run(run func(postId int, since string)) *MockViewRepositoryInterface_ReadDailyViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockViewRepositoryInterface_ReadDailyViews_Call) Return(dayViewss []domain.DayViews, err error) *MockViewRepositoryInterface_ReadDailyViews_Call {
+	_c.Call.Return(dayViewss, err)
+	return _c
+}
+
+func (_c *MockViewRepositoryInterface_ReadDailyViews_Call) RunAndReturn(run func(postId int, since string) ([]domain.DayViews, error)) *MockViewRepositoryInterface_ReadDailyViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTopReferrers provides a mock function for the type MockViewRepositoryInterface
+func (_mock *MockViewRepositoryInterface) ReadTopReferrers(postId int, since string, limit int) ([]domain.ReferrerViews, error) {
+	ret := _mock.Called(postId, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTopReferrers")
+	}
+
+	var r0 []domain.ReferrerViews
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, int) ([]domain.ReferrerViews, error)); ok {
+		return returnFunc(postId, since, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string, int) []domain.ReferrerViews); ok {
+		r0 = returnFunc(postId, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ReferrerViews)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string, int) error); ok {
+		r1 = returnFunc(postId, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockViewRepositoryInterface_ReadTopReferrers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTopReferrers'
+type MockViewRepositoryInterface_ReadTopReferrers_Call struct {
+	*mock.Call
+}
+
+// ReadTopReferrers is a helper method to define mock.On call
+//   - postId int
+//   - since string
+//   - limit int
+func (_e *MockViewRepositoryInterface_Expecter) ReadTopReferrers(postId interface{}, since interface{}, limit interface{}) *MockViewRepositoryInterface_ReadTopReferrers_Call {
+	return &MockViewRepositoryInterface_ReadTopReferrers_Call{Call: _e.mock.On("ReadTopReferrers", postId, since, limit)}
+}
+
+func (_c *MockViewRepositoryInterface_ReadTopReferrers_Call) Run(run func(postId int, since string, limit int)) *MockViewRepositoryInterface_ReadTopReferrers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockViewRepositoryInterface_ReadTopReferrers_Call) Return(referrerViewss []domain.ReferrerViews, err error) *MockViewRepositoryInterface_ReadTopReferrers_Call {
+	_c.Call.Return(referrerViewss, err)
+	return _c
+}
+
+func (_c *MockViewRepositoryInterface_ReadTopReferrers_Call) RunAndReturn(run func(postId int, since string, limit int) ([]domain.ReferrerViews, error)) *MockViewRepositoryInterface_ReadTopReferrers_Call {
+	_c.Call.Return(run)
+	return _c
+}
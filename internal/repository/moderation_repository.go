@@ -0,0 +1,350 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"web/example/internal/domain"
+)
+
+// ModerationRepositoryInterface covers post reports and moderation decisions
+type ModerationRepositoryInterface interface {
+	CreateReport(report *domain.PostReport) (bool, error)
+	CountOpenReports(postId int) (int, error)
+	ReadReports(postId int) ([]domain.PostReport, error)
+	ReadQueue(filter QueueFilter, beforeActivityAt time.Time, beforePostId int, limit int) ([]domain.ModerationQueueItem, error)
+	ApplyModeration(postId int, moderatorId *int, action domain.ModerationAction, note *string) error
+	ReadModerationLog(postId int) ([]domain.ModerationLogEntry, error)
+	ReadFlags(postId int) ([]domain.PostFlag, error)
+}
+
+// QueueFilter narrows the moderation queue, zero values don't filter
+type QueueFilter struct {
+	Reason  domain.ReportReason // Only posts with an open report for the reason
+	Hidden  *bool               // Only hidden (pending review) or only visible posts
+	Flagged bool                // Only posts held by the content filters
+}
+
+// ModerationRepository handles all database operations for moderation
+type ModerationRepository struct {
+	db *sql.DB
+}
+
+// NewModerationRepository creates a new instance of ModerationRepository
+func NewModerationRepository(db *sql.DB) *ModerationRepository {
+	return &ModerationRepository{
+		db: db,
+	}
+}
+
+// CreateReport stores the report, report.Id is set to the new id. It reports
+// false without storing anything when the reporter already reported the post.
+func (r *ModerationRepository) CreateReport(report *domain.PostReport) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		"INSERT INTO post_reports (post_id, reporter_id, reason, details) values (?, ?, ?, ?) ON CONFLICT DO NOTHING",
+		report.PostId, report.ReporterId, report.Reason, report.Details)
+	if err != nil {
+		return false, err
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return false, err
+	}
+	report.Id = int(id)
+
+	return true, nil
+}
+
+// CountOpenReports counts the reports of the post no moderator acted on yet,
+// every reporter counts once
+func (r *ModerationRepository) CountOpenReports(postId int) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	var count int
+
+	err := r.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM post_reports WHERE post_id == ? AND resolved_at IS NULL", postId).Scan(&count)
+
+	return count, err
+}
+
+// ReadReports lists every report of the post, newest first
+func (r *ModerationRepository) ReadReports(postId int) ([]domain.PostReport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT id, post_id, reporter_id, reason, details, resolved_at, created_at FROM post_reports WHERE post_id == ? ORDER BY id DESC",
+		postId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []domain.PostReport
+
+	for rows.Next() {
+		var rep domain.PostReport
+
+		if err := rows.Scan(&rep.Id, &rep.PostId, &rep.ReporterId, &rep.Reason, &rep.Details, &rep.ResolvedAt, &rep.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, rep)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// ReadQueue lists the live posts with open reports or filter flags, most
+// recent activity first. beforeActivityAt and beforePostId come from the last
+// item of the previous page, zero for the first page.
+func (r *ModerationRepository) ReadQueue(filter QueueFilter, beforeActivityAt time.Time, beforePostId int, limit int) ([]domain.ModerationQueueItem, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	query := `WITH pending AS (
+			SELECT post_id, reason, NULL AS flag, created_at FROM post_reports WHERE resolved_at IS NULL
+			UNION ALL
+			SELECT post_id, NULL, filter || ': ' || reason, created_at FROM post_flags WHERE resolved_at IS NULL
+		)
+		SELECT p.id, COALESCE(p.slug, ''), p.title, u.username, p.hidden_at,
+		COUNT(q.reason), COALESCE(group_concat(q.reason), ''), COALESCE(group_concat(q.flag, char(10)), ''), MAX(q.created_at)
+		FROM pending q JOIN posts p ON p.id == q.post_id JOIN users u ON u.id == p.user_id
+		WHERE p.deleted_at IS NULL`
+	var args []any
+
+	if filter.Hidden != nil {
+		if *filter.Hidden {
+			query += " AND p.hidden_at IS NOT NULL"
+		} else {
+			query += " AND p.hidden_at IS NULL"
+		}
+	}
+
+	query += " GROUP BY p.id HAVING 1"
+
+	if filter.Reason != "" {
+		query += " AND SUM(q.reason == ?) > 0"
+		args = append(args, filter.Reason)
+	}
+
+	if filter.Flagged {
+		query += " AND COUNT(q.flag) > 0"
+	}
+
+	if beforePostId != 0 {
+		query += " AND (MAX(q.created_at), p.id) < (?, ?)"
+		args = append(args, beforeActivityAt.UTC().Format(sqliteTimeLayout), beforePostId)
+	}
+
+	query += " ORDER BY MAX(q.created_at) DESC, p.id DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []domain.ModerationQueueItem
+
+	for rows.Next() {
+		var item domain.ModerationQueueItem
+		var reasons, flags, lastActivity string
+
+		if err := rows.Scan(&item.PostId, &item.Slug, &item.Title, &item.AuthorUsername, &item.HiddenAt,
+			&item.OpenReports, &reasons, &flags, &lastActivity); err != nil {
+			return nil, err
+		}
+
+		item.Reasons = map[domain.ReportReason]int{}
+		if reasons != "" {
+			for _, reason := range strings.Split(reasons, ",") {
+				item.Reasons[domain.ReportReason(reason)]++
+			}
+		}
+
+		if flags != "" {
+			item.Flags = strings.Split(flags, "\n")
+		}
+
+		// MAX loses the column type, the text is in the CURRENT_TIMESTAMP layout
+		activity, err := time.Parse(sqliteTimeLayout, lastActivity)
+		if err != nil {
+			return nil, err
+		}
+		item.LastActivityAt = activity.Format(time.RFC3339)
+
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// ApplyModeration applies the action to the post and records it in one
+// transaction. Moderator actions resolve the open reports, an automatic hide
+// leaves them open for review. Dismissing only unhides the post when it was
+// last hidden automatically.
+func (r *ModerationRepository) ApplyModeration(postId int, moderatorId *int, action domain.ModerationAction, note *string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var update string
+
+	switch action {
+	case domain.ModerationHide, domain.ModerationAutoHide:
+		update = "UPDATE posts SET hidden_at = COALESCE(hidden_at, CURRENT_TIMESTAMP), version = version + 1 WHERE id == ?"
+	case domain.ModerationRestore:
+		update = "UPDATE posts SET hidden_at = NULL, version = version + 1 WHERE id == ?"
+	case domain.ModerationDismiss:
+		update = `UPDATE posts SET hidden_at = NULL, version = version + 1 WHERE id == ? AND
+			(SELECT action FROM moderation_actions WHERE post_id == posts.id AND action IN ('hide', 'auto_hide') ORDER BY id DESC LIMIT 1) == 'auto_hide'`
+	default:
+		return fmt.Errorf("unknown moderation action %q", action)
+	}
+
+	if _, err := tx.ExecContext(ctx, update, postId); err != nil {
+		return err
+	}
+
+	if action != domain.ModerationAutoHide {
+		for _, table := range []string{"post_reports", "post_flags"} {
+			if _, err := tx.ExecContext(ctx,
+				"UPDATE "+table+" SET resolved_at = CURRENT_TIMESTAMP WHERE post_id == ? AND resolved_at IS NULL",
+				postId); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"INSERT INTO moderation_actions (post_id, moderator_id, action, note) values (?, ?, ?, ?)",
+		postId, moderatorId, action, note); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ReadModerationLog lists the actions taken on the post, oldest first
+func (r *ModerationRepository) ReadModerationLog(postId int) ([]domain.ModerationLogEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT a.id, a.post_id, u.username, a.action, a.note, a.created_at
+		FROM moderation_actions a LEFT JOIN users u ON u.id == a.moderator_id
+		WHERE a.post_id == ? ORDER BY a.id`,
+		postId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []domain.ModerationLogEntry
+
+	for rows.Next() {
+		var e domain.ModerationLogEntry
+
+		if err := rows.Scan(&e.Id, &e.PostId, &e.ModeratorUsername, &e.Action, &e.Note, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// flagPost stores the flags and hides the post pending review within the
+// transaction writing the content, so flagged content is never visible. The
+// hide is logged as automatic, no flags is a no-op.
+func flagPost(ctx context.Context, tx *sql.Tx, postId int, flags []domain.PostFlag) error {
+	if len(flags) == 0 {
+		return nil
+	}
+
+	reasons := make([]string, len(flags))
+
+	for i, f := range flags {
+		if _, err := tx.ExecContext(ctx,
+			"INSERT INTO post_flags (post_id, filter, reason) values (?, ?, ?)",
+			postId, f.Filter, f.Reason); err != nil {
+			return err
+		}
+		reasons[i] = f.Filter + ": " + f.Reason
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"UPDATE posts SET hidden_at = COALESCE(hidden_at, CURRENT_TIMESTAMP), version = version + 1 WHERE id == ?", postId); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx,
+		"INSERT INTO moderation_actions (post_id, action, note) values (?, ?, ?)",
+		postId, domain.ModerationAutoHide, strings.Join(reasons, "; "))
+
+	return err
+}
+
+// ReadFlags lists every filter flag of the post, newest first
+func (r *ModerationRepository) ReadFlags(postId int) ([]domain.PostFlag, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT id, post_id, filter, reason, resolved_at, created_at FROM post_flags WHERE post_id == ? ORDER BY id DESC",
+		postId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var flags []domain.PostFlag
+
+	for rows.Next() {
+		var f domain.PostFlag
+
+		if err := rows.Scan(&f.Id, &f.PostId, &f.Filter, &f.Reason, &f.ResolvedAt, &f.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		flags = append(flags, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return flags, nil
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"web/example/internal/domain"
+)
+
+// Notifications are written by the services for the notified user
+type NotificationRepositoryInterface interface {
+	CreateNotification(notification *domain.Notification) error
+	ReadNotification(id int) (*domain.Notification, error)
+	ReadNotifications(userId int, unreadOnly bool, beforeId int, limit int) ([]domain.Notification, error)
+	ReadNotificationsAfter(userId int, afterId int, limit int) ([]domain.Notification, error)
+	CountUnread(userId int) (int, error)
+	MarkRead(userId int, id int) error
+	MarkAllRead(userId int) error
+}
+
+// notificationColumns is the column list matching scanNotification, the
+// actor's username is looked up so clients can show who it was
+const notificationColumns = "n.id, n.user_id, n.type, n.actor_id, u.username, n.post_id, n.read_at, n.created_at" +
+	" FROM notifications n JOIN users u ON u.id == n.actor_id"
+
+func scanNotification(row rowScanner) (*domain.Notification, error) {
+	var n domain.Notification
+
+	if err := row.Scan(&n.Id, &n.UserId, &n.Type, &n.ActorId, &n.ActorUsername, &n.PostId, &n.ReadAt, &n.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	return &n, nil
+}
+
+// NotificationRepository handles all database operations for notifications
+type NotificationRepository struct {
+	db *sql.DB
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository
+func NewNotificationRepository(db *sql.DB) *NotificationRepository {
+	return &NotificationRepository{
+		db: db,
+	}
+}
+
+// CreateNotification inserts the notification, notification.Id is set to the new id
+func (r *NotificationRepository) CreateNotification(notification *domain.Notification) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		"INSERT INTO notifications (user_id, actor_id, type, post_id) values (?, ?, ?, ?)",
+		notification.UserId, notification.ActorId, notification.Type, notification.PostId)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	notification.Id = int(id)
+
+	return nil
+}
+
+func (r *NotificationRepository) ReadNotification(id int) (*domain.Notification, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	return scanNotification(r.db.QueryRowContext(ctx,
+		"SELECT "+notificationColumns+" WHERE n.id == ?", id))
+}
+
+func (r *NotificationRepository) queryNotifications(query string, args ...any) ([]domain.Notification, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []domain.Notification
+
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, *n)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+// ReadNotifications pages through the user's notifications newest first,
+// beforeId is the last notification of the previous page, 0 for the first page
+func (r *NotificationRepository) ReadNotifications(userId int, unreadOnly bool, beforeId int, limit int) ([]domain.Notification, error) {
+	query := "SELECT " + notificationColumns + " WHERE n.user_id == ?"
+	args := []any{userId}
+
+	if unreadOnly {
+		query += " AND n.read_at IS NULL"
+	}
+
+	if beforeId != 0 {
+		query += " AND n.id < ?"
+		args = append(args, beforeId)
+	}
+
+	query += " ORDER BY n.id DESC LIMIT ?"
+	args = append(args, limit)
+
+	return r.queryNotifications(query, args...)
+}
+
+// ReadNotificationsAfter lists the notifications newer than afterId oldest
+// first, streams use it to catch up after a reconnect
+func (r *NotificationRepository) ReadNotificationsAfter(userId int, afterId int, limit int) ([]domain.Notification, error) {
+	return r.queryNotifications(
+		"SELECT "+notificationColumns+" WHERE n.user_id == ? AND n.id > ? ORDER BY n.id LIMIT ?",
+		userId, afterId, limit)
+}
+
+func (r *NotificationRepository) CountUnread(userId int) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	var count int
+
+	err := r.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM notifications WHERE user_id == ? AND read_at IS NULL", userId).Scan(&count)
+
+	return count, err
+}
+
+// MarkRead marks one of the user's notifications as read, returns
+// sql.ErrNoRows when the user has no such notification
+func (r *NotificationRepository) MarkRead(userId int, id int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE notifications SET read_at = COALESCE(read_at, CURRENT_TIMESTAMP) WHERE id == ? AND user_id == ?",
+		id, userId)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *NotificationRepository) MarkAllRead(userId int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE notifications SET read_at = CURRENT_TIMESTAMP WHERE user_id == ? AND read_at IS NULL", userId)
+
+	return err
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+)
+
+// readOrder reads the itemColumn ids of the rows of table under parentId, in
+// position order
+func readOrder(ctx context.Context, tx *sql.Tx, table string, parentColumn string, itemColumn string, parentId int) ([]int, error) {
+	rows, err := tx.QueryContext(ctx,
+		"SELECT "+itemColumn+" FROM "+table+" WHERE "+parentColumn+" == ? ORDER BY position", parentId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var order []int
+
+	for rows.Next() {
+		var id int
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		order = append(order, id)
+	}
+
+	return order, rows.Err()
+}
+
+// writeOrder numbers the rows of table under parentId from 1 in the order of
+// itemIds, rows already in place are not written
+func writeOrder(ctx context.Context, tx *sql.Tx, table string, parentColumn string, itemColumn string, parentId int, itemIds []int) error {
+	for i, id := range itemIds {
+		if _, err := tx.ExecContext(ctx,
+			"UPDATE "+table+" SET position = ? WHERE "+parentColumn+" == ? AND "+itemColumn+" == ? AND position != ?",
+			i+1, parentId, id, i+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertAt inserts id at the 1 based position of order, positions out of
+// range append it
+func insertAt(order []int, id int, position int) []int {
+	if position <= 0 || position > len(order) {
+		position = len(order) + 1
+	}
+
+	return append(order[:position-1:position-1], append([]int{id}, order[position-1:]...)...)
+}
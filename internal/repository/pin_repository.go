@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+)
+
+// PinRepositoryInterface covers posts pinned to their owner's profile and
+// posts featured on the global listing, the posts themselves are read
+// through PostRepositoryInterface
+type PinRepositoryInterface interface {
+	PinPost(userId int, postId int, position int, limit int) (bool, error)
+	UnpinPost(userId int, postId int) (bool, error)
+	FeaturePost(postId int, adminId int, position int, limit int) (bool, error)
+	UnfeaturePost(postId int) (bool, error)
+}
+
+// PinRepository handles all database operations for pins and features
+type PinRepository struct {
+	db *sql.DB
+}
+
+// NewPinRepository creates a new instance of PinRepository
+func NewPinRepository(db *sql.DB) *PinRepository {
+	return &PinRepository{
+		db: db,
+	}
+}
+
+// singleList is the parent column for readOrder and writeOrder of tables
+// holding one list, 1 == 1 matches every row
+const singleList = "1"
+
+// PinPost pins the post to the user's profile at the 1 based position, a
+// pinned post moves there. It reports false without pinning when the user
+// already has limit pinned posts.
+func (r *PinRepository) PinPost(userId int, postId int, position int, limit int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	// counted in the insert itself so concurrent pins can't both slip under the limit
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO post_pins (post_id, user_id, position) SELECT ?, ?, 0
+		WHERE (SELECT COUNT(*) FROM post_pins WHERE user_id == ?) < ? ON CONFLICT DO NOTHING`,
+		postId, userId, userId, limit); err != nil {
+		return false, err
+	}
+
+	order, err := readOrder(ctx, tx, "post_pins", "user_id", "post_id", userId)
+	if err != nil {
+		return false, err
+	}
+
+	i := slices.Index(order, postId)
+	if i < 0 {
+		return false, nil
+	}
+
+	order = slices.Delete(order, i, i+1)
+
+	if err := writeOrder(ctx, tx, "post_pins", "user_id", "post_id", userId, insertAt(order, postId, position)); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// UnpinPost takes the post off the user's profile, it reports false when it
+// was not pinned
+func (r *PinRepository) UnpinPost(userId int, postId int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM post_pins WHERE user_id == ? AND post_id == ?", userId, postId)
+	if err != nil {
+		return false, err
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+
+	order, err := readOrder(ctx, tx, "post_pins", "user_id", "post_id", userId)
+	if err != nil {
+		return false, err
+	}
+
+	if err := writeOrder(ctx, tx, "post_pins", "user_id", "post_id", userId, order); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// FeaturePost features the post on the global listing at the 1 based
+// position, a featured post moves there. It reports false without featuring
+// when limit posts are featured already.
+func (r *PinRepository) FeaturePost(postId int, adminId int, position int, limit int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO featured_posts (post_id, featured_by, position) SELECT ?, ?, 0
+		WHERE (SELECT COUNT(*) FROM featured_posts) < ? ON CONFLICT DO NOTHING`,
+		postId, adminId, limit); err != nil {
+		return false, err
+	}
+
+	order, err := readOrder(ctx, tx, "featured_posts", singleList, "post_id", 1)
+	if err != nil {
+		return false, err
+	}
+
+	i := slices.Index(order, postId)
+	if i < 0 {
+		return false, nil
+	}
+
+	order = slices.Delete(order, i, i+1)
+
+	if err := writeOrder(ctx, tx, "featured_posts", singleList, "post_id", 1, insertAt(order, postId, position)); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// UnfeaturePost takes the post off the global listing's featured posts, it
+// reports false when it was not featured
+func (r *PinRepository) UnfeaturePost(postId int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM featured_posts WHERE post_id == ?", postId)
+	if err != nil {
+		return false, err
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+
+	order, err := readOrder(ctx, tx, "featured_posts", singleList, "post_id", 1)
+	if err != nil {
+		return false, err
+	}
+
+	if err := writeOrder(ctx, tx, "featured_posts", singleList, "post_id", 1, order); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
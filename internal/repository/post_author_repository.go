@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"web/example/internal/domain"
+)
+
+// PostAuthorRepositoryInterface covers the authors of posts and their invites
+type PostAuthorRepositoryInterface interface {
+	ReadPostAuthors(postId int) ([]domain.PostAuthor, error)
+	InviteAuthor(postId int, userId int, invitedBy int) (bool, error)
+	AcceptInvite(postId int, userId int) (bool, error)
+	RemoveAuthor(postId int, userId int) (bool, error)
+	TransferOwnership(postId int, fromUserId int, toUserId int) (bool, error)
+}
+
+// PostAuthorRepository handles all database operations for post authors
+type PostAuthorRepository struct {
+	db *sql.DB
+}
+
+// NewPostAuthorRepository creates a new instance of PostAuthorRepository
+func NewPostAuthorRepository(db *sql.DB) *PostAuthorRepository {
+	return &PostAuthorRepository{
+		db: db,
+	}
+}
+
+// ReadPostAuthors lists the authors of the post with pending invites, the
+// owner first and then in the order they were invited
+func (r *PostAuthorRepository) ReadPostAuthors(postId int) ([]domain.PostAuthor, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT u.id, u.username, a.role, a.accepted_at FROM post_authors a JOIN users u ON u.id == a.user_id
+		WHERE a.post_id == ? AND u.deleted_at IS NULL ORDER BY a.role == 'owner' DESC, a.created_at, a.user_id`,
+		postId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var authors []domain.PostAuthor
+
+	for rows.Next() {
+		var a domain.PostAuthor
+
+		if err := rows.Scan(&a.UserId, &a.Username, &a.Role, &a.AcceptedAt); err != nil {
+			return nil, err
+		}
+
+		authors = append(authors, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return authors, nil
+}
+
+// InviteAuthor invites the user as editor of the post. It reports false when
+// the user is already an author or invited.
+func (r *PostAuthorRepository) InviteAuthor(postId int, userId int, invitedBy int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		"INSERT INTO post_authors (post_id, user_id, role, invited_by) values (?, ?, 'editor', ?) ON CONFLICT DO NOTHING",
+		postId, userId, invitedBy)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+
+	return n > 0, err
+}
+
+// AcceptInvite makes the invited user an author, it reports false when they
+// have no pending invite
+func (r *PostAuthorRepository) AcceptInvite(postId int, userId int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE post_authors SET accepted_at = CURRENT_TIMESTAMP WHERE post_id == ? AND user_id == ? AND accepted_at IS NULL",
+		postId, userId)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+
+	return n > 0, err
+}
+
+// RemoveAuthor removes an editor or a pending invite, the owner is never
+// removed. It reports false when the user is neither.
+func (r *PostAuthorRepository) RemoveAuthor(postId int, userId int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		"DELETE FROM post_authors WHERE post_id == ? AND user_id == ? AND role != 'owner'",
+		postId, userId)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+
+	return n > 0, err
+}
+
+// TransferOwnership makes an editor the owner of the post and the owner an
+// editor, posts.user_id follows. It reports false when fromUserId is no
+// longer the owner or toUserId is not an editor who accepted.
+func (r *PostAuthorRepository) TransferOwnership(postId int, fromUserId int, toUserId int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	// the old owner steps down first, a post has one owner at a time
+	res, err := tx.ExecContext(ctx,
+		"UPDATE post_authors SET role = 'editor' WHERE post_id == ? AND user_id == ? AND role == 'owner'",
+		postId, fromUserId)
+	if err != nil {
+		return false, err
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+
+	res, err = tx.ExecContext(ctx,
+		"UPDATE post_authors SET role = 'owner' WHERE post_id == ? AND user_id == ? AND role == 'editor' AND accepted_at IS NOT NULL",
+		postId, toUserId)
+	if err != nil {
+		return false, err
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"UPDATE posts SET user_id = ?, version = version + 1 WHERE id == ?", toUserId, postId); err != nil {
+		return false, err
+	}
+
+	// a pin is on the old owner's profile
+	if _, err := tx.ExecContext(ctx, "DELETE FROM post_pins WHERE post_id == ?", postId); err != nil {
+		return false, err
+	}
+
+	// and so are the series the post is part of
+	if err := leaveSeries(ctx, tx, postId); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
@@ -3,7 +3,11 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 	"web/example/internal/domain"
 )
@@ -11,9 +15,134 @@ import (
 type PostRepositoryInterface interface {
 	CreatePost(post *domain.Post) error
 	ReadPost(id int) (*domain.Post, error)
-	UpdatePost(id int, title string, content string) error
-	DeletePost(id int) error
-	ReadAllPosts() ([]domain.Post, error)
+	UpdatePost(id int, version int, update *domain.PostUpdate) (bool, error)
+	UpdatePostStatus(id int, status domain.PostStatus) error
+	SchedulePost(id int, publishAt time.Time) error
+	PublishDuePosts(now time.Time) ([]int, error)
+	ReadScheduledPosts(userId int) ([]domain.Post, error)
+	DeletePost(id int, version int) (bool, error)
+	ReadAllPosts(viewerId int, beforeCreatedAt time.Time, beforeId int, limit int) ([]domain.Post, error)
+	ReadFeaturedPosts() ([]domain.Post, error)
+	ReadAuthorPosts(userId int, viewerId int, beforeCreatedAt time.Time, beforeId int, limit int) ([]domain.Post, error)
+	ReadPinnedPosts(userId int) ([]domain.Post, error)
+	ReadTrash(userId int) ([]domain.Post, error)
+	ReadTrashedPost(id int) (*domain.Post, error)
+	RestorePost(id int) error
+	PurgeDeletedPosts(before time.Time) (int64, []string, error)
+	ReadPostBySlug(slug string) (*domain.Post, error)
+	ReadSlugOwner(slug string) (int, error)
+	UpdatePostSlug(id int, slug string) error
+	ReadPostsWithoutSlug() ([]domain.Post, error)
+	CacheContentHTML(id int, contentHTML string) error
+	ReadFeedPosts(userId int, tag string, limit int) ([]domain.FeedPost, error)
+	ReadTimeline(userId int, beforeCreatedAt time.Time, beforeId int, limit int) ([]domain.Post, error)
+	ReadMentioningPosts(username string, beforeId int, limit int) ([]domain.Post, error)
+	ReadRecentPosts(userId int, limit int) ([]domain.Post, error)
+	ReadUserPosts(userId int) ([]domain.Post, error)
+	ReadPostsByIds(ids []int) ([]domain.Post, error)
+}
+
+// postColumns is the column list matching scanPost, mentions, accepted
+// authors, languages and tags come along as JSON arrays
+const postColumns = "id, user_id, COALESCE(slug, ''), title, content, content_format, content_html, language, status, visibility, version, created_at, published_at, publish_at, deleted_at, hidden_at, " +
+	"(SELECT json_group_array(json_object('userId', u.id, 'username', u.username, 'start', m.start_offset, 'end', m.end_offset))" +
+	" FROM post_mentions m JOIN users u ON u.id == m.user_id WHERE m.post_id == posts.id AND u.deleted_at IS NULL), " +
+	"(SELECT json_group_array(json_object('userId', u.id, 'username', u.username, 'role', a.role, 'acceptedAt', strftime('%Y-%m-%dT%H:%M:%SZ', a.accepted_at)))" +
+	" FROM post_authors a JOIN users u ON u.id == a.user_id WHERE a.post_id == posts.id AND a.accepted_at IS NOT NULL AND u.deleted_at IS NULL), " +
+	"(SELECT json_group_array(t.language) FROM post_translations t WHERE t.post_id == posts.id), " +
+	"(SELECT json_group_array(tag) FROM (SELECT tag FROM post_tags WHERE post_id == posts.id ORDER BY position))"
+
+// livePosts filters out posts in the trash and posts of accounts pending deletion,
+// every read goes through it unless it is explicitly about the trash
+const livePosts = "deleted_at IS NULL AND user_id NOT IN (SELECT id FROM users WHERE deleted_at IS NOT NULL)"
+
+// publicPosts are the live posts everyone sees in listings: published,
+// public and not hidden by moderation
+const publicPosts = "status == 'published' AND visibility == 'public' AND hidden_at IS NULL AND " + livePosts
+
+// sqliteTimeLayout matches CURRENT_TIMESTAMP so stored times compare as text
+const sqliteTimeLayout = "2006-01-02 15:04:05"
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanMention mirrors domain.Mention with the user id, which is not in its JSON
+type scanMention struct {
+	UserId   int    `json:"userId"`
+	Username string `json:"username"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+}
+
+// scanAuthor mirrors domain.PostAuthor with the user id, which is not in its JSON
+type scanAuthor struct {
+	UserId     int               `json:"userId"`
+	Username   string            `json:"username"`
+	Role       domain.AuthorRole `json:"role"`
+	AcceptedAt *string           `json:"acceptedAt"`
+}
+
+// scanPost scans the postColumns, extra receives columns selected after them
+func scanPost(row rowScanner, extra ...any) (*domain.Post, error) {
+	var p domain.Post
+	var mentions, authors, languages, tags string
+
+	dest := append([]any{&p.Id, &p.UserId, &p.Slug, &p.Title, &p.Content, &p.ContentFormat, &p.ContentHTML, &p.Language, &p.Status, &p.Visibility, &p.Version, &p.CreatedAt, &p.PublishedAt, &p.PublishAt, &p.DeletedAt, &p.HiddenAt, &mentions, &authors, &languages, &tags}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	var scanned []scanMention
+	if err := json.Unmarshal([]byte(mentions), &scanned); err != nil {
+		return nil, err
+	}
+
+	p.Mentions = make([]domain.Mention, len(scanned))
+	for i, m := range scanned {
+		p.Mentions[i] = domain.Mention(m)
+	}
+	slices.SortFunc(p.Mentions, func(a, b domain.Mention) int { return a.Start - b.Start })
+
+	var scannedAuthors []scanAuthor
+	if err := json.Unmarshal([]byte(authors), &scannedAuthors); err != nil {
+		return nil, err
+	}
+
+	p.Authors = make([]domain.PostAuthor, len(scannedAuthors))
+	for i, a := range scannedAuthors {
+		p.Authors[i] = domain.PostAuthor(a)
+	}
+	// the owner goes first, then the editors in the order they joined
+	slices.SortStableFunc(p.Authors, func(a, b domain.PostAuthor) int {
+		switch {
+		case a.Role != b.Role && a.Role == domain.AuthorOwner:
+			return -1
+		case a.Role != b.Role && b.Role == domain.AuthorOwner:
+			return 1
+		}
+		return strings.Compare(*a.AcceptedAt, *b.AcceptedAt)
+	})
+
+	if err := json.Unmarshal([]byte(languages), &p.Languages); err != nil {
+		return nil, err
+	}
+	// the original first, then the translations in tag order
+	slices.SortFunc(p.Languages, func(a, b string) int {
+		switch {
+		case a == p.Language:
+			return -1
+		case b == p.Language:
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+
+	if err := json.Unmarshal([]byte(tags), &p.Tags); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
 }
 
 // PostRepository handles all database operations for posts
@@ -28,13 +157,81 @@ func NewPostRepository(db *sql.DB) *PostRepository {
 	}
 }
 
+// CreatePost inserts the post together with its first revision and slug,
+// post.Id is set to the new id
 func (r *PostRepository) CreatePost(post *domain.Post) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 
-	_, err := r.db.ExecContext(ctx, "INSERT INTO posts (user_id, title, content) values (?,?,?)", post.UserId, post.Title, post.Content)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
 
-	return err
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO posts (user_id, slug, title, content, content_format, language, status, visibility, published_at)
+		values (?, ?, ?, ?, ?, ?, ?, ?, CASE WHEN ? = 'published' THEN CURRENT_TIMESTAMP END)`,
+		post.UserId, post.Slug, post.Title, post.Content, post.ContentFormat, post.Language, post.Status, post.Visibility, post.Status)
+	if err != nil {
+		return err
+	}
+
+	if err := insertPostDetails(ctx, tx, post, res); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// insertPostDetails sets post.Id from the insert of the post row and stores
+// what comes with a new post: its first revision, slug, mentions, tags and flags
+func insertPostDetails(ctx context.Context, tx *sql.Tx, post *domain.Post, res sql.Result) error {
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	post.Id = int(id)
+
+	if err := insertRevision(ctx, tx, post.Id); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "INSERT INTO post_slugs (slug, post_id) values (?, ?)", post.Slug, post.Id); err != nil {
+		return err
+	}
+
+	if err := insertMentions(ctx, tx, post.Id, post.Mentions); err != nil {
+		return err
+	}
+
+	if err := insertTags(ctx, tx, post.Id, post.Tags); err != nil {
+		return err
+	}
+
+	return flagPost(ctx, tx, post.Id, post.Flags)
+}
+
+func insertTags(ctx context.Context, tx *sql.Tx, postId int, tags []string) error {
+	for i, tag := range tags {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO post_tags (post_id, tag, position) values (?, ?, ?)", postId, tag, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertMentions(ctx context.Context, tx *sql.Tx, postId int, mentions []domain.Mention) error {
+	for _, m := range mentions {
+		if _, err := tx.ExecContext(ctx,
+			"INSERT INTO post_mentions (post_id, user_id, start_offset, end_offset) values (?, ?, ?, ?)",
+			postId, m.UserId, m.Start, m.End); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (r *PostRepository) ReadPost(id int) (*domain.Post, error) {
@@ -42,22 +239,108 @@ func (r *PostRepository) ReadPost(id int) (*domain.Post, error) {
 	defer cancel()
 
 	row := r.db.QueryRowContext(ctx,
-		"SELECT * FROM posts WHERE id == ?", id)
+		"SELECT "+postColumns+" FROM posts WHERE id == ? AND "+livePosts, id)
 
-	var p domain.Post
+	return scanPost(row)
+}
 
-	if err := row.Scan(&p.Id, &p.UserId, &p.Title, &p.Content, &p.CreatedAt); err != nil {
-		return nil, err
+// UpdatePost writes the update and records the result as a new revision, the
+// cached HTML is dropped to be rendered again on the next read. It only
+// writes when the post is still at version and reports false without writing
+// anything otherwise. Everything else in the update (format, visibility, slug,
+// mentions and flags) is written in the same transaction.
+func (r *PostRepository) UpdatePost(id int, version int, update *domain.PostUpdate) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
 	}
+	defer tx.Rollback()
 
-	return &p, nil
+	// posts inserted without going through CreatePost (seed data) have no
+	// history yet, keep their original content as revision 1
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO post_revisions (post_id, revision, title, content, created_at)
+		SELECT id, 1, title, content, created_at FROM posts
+		WHERE id == ? AND NOT EXISTS (SELECT 1 FROM post_revisions WHERE post_id == posts.id)`,
+		id); err != nil {
+		return false, err
+	}
+
+	res, err := tx.ExecContext(ctx,
+		`UPDATE posts SET title = ?, content = ?, content_format = COALESCE(NULLIF(?, ''), content_format),
+		visibility = COALESCE(NULLIF(?, ''), visibility), content_html = NULL, version = version + 1
+		WHERE id == ? AND version == ?`,
+		update.Title, update.Content, update.ContentFormat, update.Visibility, id, version)
+
+	if err != nil {
+		return false, err
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+
+	if err := insertRevision(ctx, tx, id); err != nil {
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM post_mentions WHERE post_id == ?", id); err != nil {
+		return false, err
+	}
+
+	if err := insertMentions(ctx, tx, id, update.Mentions); err != nil {
+		return false, err
+	}
+
+	if update.Tags != nil {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM post_tags WHERE post_id == ?", id); err != nil {
+			return false, err
+		}
+
+		if err := insertTags(ctx, tx, id, update.Tags); err != nil {
+			return false, err
+		}
+	}
+
+	if update.Slug != "" {
+		if err := setSlug(ctx, tx, id, update.Slug); err != nil {
+			return false, err
+		}
+	}
+
+	if err := flagPost(ctx, tx, id, update.Flags); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// insertRevision snapshots the post's current title and content as its next revision
+func insertRevision(ctx context.Context, tx *sql.Tx, postId int) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO post_revisions (post_id, revision, title, content)
+		SELECT id, COALESCE((SELECT MAX(revision) FROM post_revisions WHERE post_id == posts.id), 0) + 1, title, content
+		FROM posts WHERE id == ?`,
+		postId)
+
+	return err
 }
 
-func (r *PostRepository) UpdatePost(id int, title string, content string) error {
+// UpdatePostStatus moves the post to status, stamping published_at when it
+// gets published and clearing it when it goes back to draft, any pending
+// schedule is dropped
+func (r *PostRepository) UpdatePostStatus(id int, status domain.PostStatus) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 
-	res, err := r.db.ExecContext(ctx, "UPDATE posts SET title = ?, content = ? WHERE id == ?", title, content, id)
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE posts SET status = ?, publish_at = NULL, version = version + 1,
+		published_at = CASE ? WHEN 'published' THEN CURRENT_TIMESTAMP WHEN 'draft' THEN NULL ELSE published_at END
+		WHERE id == ?`,
+		status, status, id)
 
 	if err != nil {
 		return err
@@ -72,36 +355,400 @@ func (r *PostRepository) UpdatePost(id int, title string, content string) error
 	return nil
 }
 
-func (r *PostRepository) DeletePost(id int) error {
+// SchedulePost sets the time a draft gets published by the scheduler
+func (r *PostRepository) SchedulePost(id int, publishAt time.Time) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 
-	_, err := r.db.ExecContext(ctx, "DELETE FROM posts WHERE id == ?", id)
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE posts SET publish_at = ?, version = version + 1 WHERE id == ? AND status == 'draft'",
+		publishAt.UTC().Format(sqliteTimeLayout), id)
 
-	return err
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n <= 0 {
+		return fmt.Errorf("nothing was updated")
+	}
+
+	return nil
+}
+
+// PublishDuePosts publishes every draft whose publish_at is not after now in
+// a single statement and returns their ids, running it again for the same
+// now is a no-op
+func (r *PostRepository) PublishDuePosts(now time.Time) ([]int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		`UPDATE posts SET status = 'published', published_at = publish_at, publish_at = NULL, version = version + 1
+		WHERE status == 'draft' AND publish_at IS NOT NULL AND publish_at <= ? AND `+livePosts+`
+		RETURNING id`,
+		now.UTC().Format(sqliteTimeLayout))
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+
+	for rows.Next() {
+		var id int
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// ReadScheduledPosts lists the user's drafts waiting to be published, the
+// next one to go out first
+func (r *PostRepository) ReadScheduledPosts(userId int) ([]domain.Post, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+postColumns+" FROM posts WHERE user_id == ? AND status == 'draft' AND publish_at IS NOT NULL AND "+livePosts+" ORDER BY publish_at",
+		userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanPosts(rows)
+}
+
+// DeletePost moves the post to the trash, it is hard deleted by PurgeDeletedPosts
+// once the retention is over. Like UpdatePost it reports false when the post
+// is no longer at version.
+func (r *PostRepository) DeletePost(id int, version int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE posts SET deleted_at = CURRENT_TIMESTAMP, version = version + 1 WHERE id == ? AND version == ? AND deleted_at IS NULL",
+		id, version)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+
+	return n > 0, err
+}
+
+// ReadTrash lists the user's deleted posts, most recently deleted first
+func (r *PostRepository) ReadTrash(userId int) ([]domain.Post, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+postColumns+" FROM posts WHERE user_id == ? AND deleted_at IS NOT NULL ORDER BY deleted_at DESC",
+		userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanPosts(rows)
+}
+
+// ReadTrashedPost reads a post only if it is in the trash
+func (r *PostRepository) ReadTrashedPost(id int) (*domain.Post, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	row := r.db.QueryRowContext(ctx,
+		"SELECT "+postColumns+" FROM posts WHERE id == ? AND deleted_at IS NOT NULL", id)
+
+	return scanPost(row)
+}
+
+// RestorePost takes the post out of the trash
+func (r *PostRepository) RestorePost(id int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx, "UPDATE posts SET deleted_at = NULL, version = version + 1 WHERE id == ? AND deleted_at IS NOT NULL", id)
+
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n <= 0 {
+		return fmt.Errorf("nothing was restored")
+	}
+
+	return nil
+}
+
+// PurgeDeletedPosts hard deletes posts that went to the trash before the
+// given time. It returns the blob keys of their attachments, whose rows go
+// with the posts, so the caller can delete the files.
+func (r *PostRepository) PurgeDeletedPosts(before time.Time) (int64, []string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer tx.Rollback()
+
+	cutoff := before.UTC().Format(sqliteTimeLayout)
+
+	keys, err := readBlobKeys(ctx, tx, "deleted_at IS NOT NULL AND deleted_at <= ?1", cutoff)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM posts WHERE deleted_at IS NOT NULL AND deleted_at <= ?", cutoff)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return n, keys, tx.Commit()
+}
+
+// listablePosts narrows listings down to the posts that can be listed for
+// the viewer (?2), published and not unlisted or by them. The service still
+// decides, see isListed.
+const listablePosts = "(status == 'published' AND visibility != 'unlisted' OR id IN (SELECT post_id FROM post_authors WHERE user_id == ?2 AND accepted_at IS NOT NULL)) AND " + livePosts
+
+// readPostsPage reads a page of the posts matching where, newest first and
+// below the (created_at, id) cursor when one is given. ?1 and ?2 in where
+// are the user and the viewer ids, where may leave the user out.
+func (r *PostRepository) readPostsPage(where string, userId int, viewerId int, beforeCreatedAt time.Time, beforeId int, limit int) ([]domain.Post, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	query := "SELECT " + postColumns + " FROM posts WHERE " + where + " AND " + listablePosts
+	args := []any{userId, viewerId}
+
+	if !beforeCreatedAt.IsZero() {
+		query += " AND (created_at, id) < (?3, ?4)"
+		args = append(args, beforeCreatedAt.UTC().Format(sqliteTimeLayout), beforeId)
+	}
+
+	query += " ORDER BY created_at DESC, id DESC LIMIT ?" + strconv.Itoa(len(args)+1)
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanPosts(rows)
+}
+
+// ReadAllPosts reads a page of the global listing for the viewer, 0 for
+// anonymous viewers. Featured posts are left out, they are listed apart.
+func (r *PostRepository) ReadAllPosts(viewerId int, beforeCreatedAt time.Time, beforeId int, limit int) ([]domain.Post, error) {
+	return r.readPostsPage("id NOT IN (SELECT post_id FROM featured_posts)", 0, viewerId, beforeCreatedAt, beforeId, limit)
+}
+
+// ReadFeaturedPosts reads the featured posts in position order
+func (r *PostRepository) ReadFeaturedPosts() ([]domain.Post, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+postColumns+" FROM posts WHERE id IN (SELECT post_id FROM featured_posts) AND "+livePosts+
+			" ORDER BY (SELECT position FROM featured_posts WHERE post_id == posts.id)")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanPosts(rows)
+}
+
+// ReadAuthorPosts reads a page of the posts the user owns for the viewer,
+// pinned posts are left out, they are listed apart
+func (r *PostRepository) ReadAuthorPosts(userId int, viewerId int, beforeCreatedAt time.Time, beforeId int, limit int) ([]domain.Post, error) {
+	return r.readPostsPage("user_id == ?1 AND id NOT IN (SELECT post_id FROM post_pins WHERE user_id == ?1)", userId, viewerId, beforeCreatedAt, beforeId, limit)
+}
+
+// ReadPinnedPosts reads the posts pinned to the user's profile in position
+// order
+func (r *PostRepository) ReadPinnedPosts(userId int) ([]domain.Post, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+postColumns+" FROM posts WHERE id IN (SELECT post_id FROM post_pins WHERE user_id == ?1) AND user_id == ?1 AND "+livePosts+
+			" ORDER BY (SELECT position FROM post_pins WHERE post_id == posts.id)", userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanPosts(rows)
+}
+
+// ReadPostBySlug reads the post whose current slug is slug
+func (r *PostRepository) ReadPostBySlug(slug string) (*domain.Post, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	row := r.db.QueryRowContext(ctx,
+		"SELECT "+postColumns+" FROM posts WHERE slug == ? AND "+livePosts, slug)
+
+	return scanPost(row)
+}
+
+// ReadSlugOwner returns the id of the post that has or had slug, 0 if the slug was never used
+func (r *PostRepository) ReadSlugOwner(slug string) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	var postId int
+	err := r.db.QueryRowContext(ctx, "SELECT post_id FROM post_slugs WHERE slug == ?", slug).Scan(&postId)
+
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+
+	return postId, err
+}
+
+// UpdatePostSlug makes slug the current slug of the post, previous slugs are
+// kept in post_slugs so they keep resolving to the post
+func (r *PostRepository) UpdatePostSlug(id int, slug string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := setSlug(ctx, tx, id, slug); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE posts SET version = version + 1 WHERE id == ?", id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// setSlug makes slug the current slug of the post, it fails when another
+// post has it
+func setSlug(ctx context.Context, tx *sql.Tx, id int, slug string) error {
+	// the slug may be one the post had before, in that case it is just reused
+	if _, err := tx.ExecContext(ctx,
+		"INSERT INTO post_slugs (slug, post_id) values (?, ?) ON CONFLICT (slug) DO NOTHING", slug, id); err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx,
+		"UPDATE posts SET slug = ? WHERE id == ? AND EXISTS (SELECT 1 FROM post_slugs WHERE slug == ? AND post_id == ?)",
+		slug, id, slug, id)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n <= 0 {
+		return fmt.Errorf("slug %s is already taken", slug)
+	}
+
+	return nil
 }
 
-func (r *PostRepository) ReadAllPosts() ([]domain.Post, error) {
+// ReadPostsWithoutSlug lists posts created before slugs existed, including deleted ones
+func (r *PostRepository) ReadPostsWithoutSlug() ([]domain.Post, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 
 	rows, err := r.db.QueryContext(ctx,
-		"SELECT * FROM posts")
+		"SELECT "+postColumns+" FROM posts WHERE slug IS NULL ORDER BY id")
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	return scanPosts(rows)
+}
+
+// CacheContentHTML stores the rendered content of the post
+func (r *PostRepository) CacheContentHTML(id int, contentHTML string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx, "UPDATE posts SET content_html = ? WHERE id == ?", contentHTML, id)
+
+	return err
+}
+
+func scanPosts(rows *sql.Rows) ([]domain.Post, error) {
 	var posts []domain.Post
 
 	for rows.Next() {
-		var p domain.Post
+		p, err := scanPost(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		posts = append(posts, *p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+// ReadFeedPosts lists the newest published posts with their author names,
+// of one user or of everyone when userId is 0, filed under tag unless it is
+// empty
+func (r *PostRepository) ReadFeedPosts(userId int, tag string, limit int) ([]domain.FeedPost, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+postColumns+", (SELECT username FROM users WHERE users.id == posts.user_id) FROM posts"+
+			" WHERE (? == 0 OR user_id == ?) AND (? == '' OR id IN (SELECT post_id FROM post_tags WHERE tag == ?)) AND "+publicPosts+
+			" ORDER BY created_at DESC, id DESC LIMIT ?",
+		userId, userId, tag, tag, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var posts []domain.FeedPost
+
+	for rows.Next() {
+		var authorName string
 
-		if err := rows.Scan(&p.Id, &p.UserId, &p.Title, &p.Content, &p.CreatedAt); err != nil {
+		p, err := scanPost(rows, &authorName)
+		if err != nil {
 			return nil, err
 		}
 
-		posts = append(posts, p)
+		posts = append(posts, domain.FeedPost{Post: *p, AuthorName: authorName})
 	}
 
 	if err := rows.Err(); err != nil {
@@ -110,3 +757,125 @@ func (r *PostRepository) ReadAllPosts() ([]domain.Post, error) {
 
 	return posts, nil
 }
+
+// ReadTimeline lists the published posts of the authors userId follows,
+// newest first. A page continues after the last post of the previous one,
+// (beforeCreatedAt, beforeId), a zero beforeCreatedAt starts at the newest.
+// Authors blocked in either direction are left out.
+func (r *PostRepository) ReadTimeline(userId int, beforeCreatedAt time.Time, beforeId int, limit int) ([]domain.Post, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// +status keeps SQLite from picking idx_posts_status, walking
+	// idx_posts_user_id_created_at of each followed author is much cheaper
+	query := "SELECT " + postColumns + " FROM posts" +
+		" WHERE user_id IN (SELECT followee_id FROM follows WHERE follower_id == ?)" +
+		" AND user_id NOT IN (SELECT blocked_id FROM user_blocks WHERE blocker_id == ? UNION SELECT blocker_id FROM user_blocks WHERE blocked_id == ?)" +
+		" AND +status == 'published' AND visibility IN ('public', 'followers') AND hidden_at IS NULL AND " + livePosts
+	args := []any{userId, userId, userId}
+
+	if !beforeCreatedAt.IsZero() {
+		query += " AND (created_at, id) < (?, ?)"
+		args = append(args, beforeCreatedAt.UTC().Format(sqliteTimeLayout), beforeId)
+	}
+
+	query += " ORDER BY created_at DESC, id DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanPosts(rows)
+}
+
+// ReadMentioningPosts lists the published posts mentioning a user with the
+// username, newest first. beforeId is the last post of the previous page, 0
+// for the first page.
+func (r *PostRepository) ReadMentioningPosts(username string, beforeId int, limit int) ([]domain.Post, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	query := "SELECT " + postColumns + " FROM posts" +
+		" WHERE id IN (SELECT m.post_id FROM post_mentions m JOIN users u ON u.id == m.user_id WHERE u.username == ? AND u.deleted_at IS NULL)" +
+		" AND " + publicPosts
+	args := []any{username}
+
+	if beforeId != 0 {
+		query += " AND id < ?"
+		args = append(args, beforeId)
+	}
+
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanPosts(rows)
+}
+
+// ReadRecentPosts lists the user's latest live posts in any status, newest
+// first
+func (r *PostRepository) ReadRecentPosts(userId int, limit int) ([]domain.Post, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+postColumns+" FROM posts WHERE user_id == ? AND "+livePosts+" ORDER BY id DESC LIMIT ?",
+		userId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanPosts(rows)
+}
+
+// ReadUserPosts lists the live posts the user owns in any status, oldest
+// first
+func (r *PostRepository) ReadUserPosts(userId int) ([]domain.Post, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+postColumns+" FROM posts WHERE user_id == ? AND "+livePosts+" ORDER BY id",
+		userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanPosts(rows)
+}
+
+// ReadPostsByIds reads the live posts among ids in any status, in no
+// particular order. Deleted posts are left out instead of failing the read.
+func (r *PostRepository) ReadPostsByIds(ids []int) ([]domain.Post, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+postColumns+" FROM posts WHERE id IN (?"+strings.Repeat(", ?", len(ids)-1)+") AND "+livePosts,
+		args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanPosts(rows)
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"web/example/internal/domain"
+)
+
+// Revisions are written by PostRepository in the same transaction as the
+// post itself, this repository only reads them back.
+type PostRevisionRepositoryInterface interface {
+	ReadRevisions(postId int) ([]domain.PostRevision, error)
+	ReadRevision(postId int, revision int) (*domain.PostRevision, error)
+}
+
+// PostRevisionRepository handles all database operations for post revisions
+type PostRevisionRepository struct {
+	db *sql.DB
+}
+
+// NewPostRevisionRepository creates a new instance of PostRevisionRepository
+func NewPostRevisionRepository(db *sql.DB) *PostRevisionRepository {
+	return &PostRevisionRepository{
+		db: db,
+	}
+}
+
+// ReadRevisions lists all revisions of a post, oldest first
+func (r *PostRevisionRepository) ReadRevisions(postId int) ([]domain.PostRevision, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT id, post_id, revision, title, content, created_at FROM post_revisions WHERE post_id == ? ORDER BY revision",
+		postId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []domain.PostRevision
+
+	for rows.Next() {
+		var rev domain.PostRevision
+
+		if err := rows.Scan(&rev.Id, &rev.PostId, &rev.Revision, &rev.Title, &rev.Content, &rev.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, rev)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func (r *PostRevisionRepository) ReadRevision(postId int, revision int) (*domain.PostRevision, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	row := r.db.QueryRowContext(ctx,
+		"SELECT id, post_id, revision, title, content, created_at FROM post_revisions WHERE post_id == ? AND revision == ?",
+		postId, revision)
+
+	var rev domain.PostRevision
+
+	if err := row.Scan(&rev.Id, &rev.PostId, &rev.Revision, &rev.Title, &rev.Content, &rev.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	return &rev, nil
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+	"web/example/internal/domain"
+)
+
+// PostTranslationRepositoryInterface covers the localized variants of posts,
+// the original variant is only written through the post
+type PostTranslationRepositoryInterface interface {
+	ReadTranslations(language string, postIds []int) ([]domain.PostTranslation, error)
+	SaveTranslation(t *domain.PostTranslation, flags []domain.PostFlag) (bool, error)
+	DeleteTranslation(postId int, language string) (bool, error)
+	CacheTranslationHTML(postId int, language string, contentHTML string) error
+}
+
+// PostTranslationRepository handles all database operations for post translations
+type PostTranslationRepository struct {
+	db *sql.DB
+}
+
+// NewPostTranslationRepository creates a new instance of PostTranslationRepository
+func NewPostTranslationRepository(db *sql.DB) *PostTranslationRepository {
+	return &PostTranslationRepository{
+		db: db,
+	}
+}
+
+// ReadTranslations reads the variants in language of the posts, posts
+// without one are left out
+func (r *PostTranslationRepository) ReadTranslations(language string, postIds []int) ([]domain.PostTranslation, error) {
+	if len(postIds) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	args := []any{language}
+	for _, id := range postIds {
+		args = append(args, id)
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT post_id, language, title, content, content_html, original, created_at, updated_at FROM post_translations
+		WHERE language == ? AND post_id IN (?`+strings.Repeat(", ?", len(postIds)-1)+`)`,
+		args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var translations []domain.PostTranslation
+
+	for rows.Next() {
+		var t domain.PostTranslation
+
+		if err := rows.Scan(&t.PostId, &t.Language, &t.Title, &t.Content, &t.ContentHTML, &t.Original, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return nil, err
+		}
+
+		translations = append(translations, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return translations, nil
+}
+
+// SaveTranslation adds or replaces the variant of the post in t.Language and
+// drops its cached HTML. It reports false without writing anything when that
+// is the language of the original. Flags hide the post in the same
+// transaction.
+func (r *PostTranslationRepository) SaveTranslation(t *domain.PostTranslation, flags []domain.PostFlag) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO post_translations (post_id, language, title, content) values (?, ?, ?, ?)
+		ON CONFLICT (post_id, language) DO UPDATE SET title = excluded.title, content = excluded.content,
+		content_html = NULL, updated_at = CURRENT_TIMESTAMP WHERE original == 0`,
+		t.PostId, t.Language, t.Title, t.Content)
+	if err != nil {
+		return false, err
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n <= 0 {
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE posts SET version = version + 1 WHERE id == ?", t.PostId); err != nil {
+		return false, err
+	}
+
+	if err := flagPost(ctx, tx, t.PostId, flags); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// DeleteTranslation removes the variant of the post in language, it reports
+// false when there is none or it is the original
+func (r *PostTranslationRepository) DeleteTranslation(postId int, language string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"DELETE FROM post_translations WHERE post_id == ? AND language == ? AND original == 0", postId, language)
+	if err != nil {
+		return false, err
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n <= 0 {
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE posts SET version = version + 1 WHERE id == ?", postId); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// CacheTranslationHTML stores the rendered content of the variant
+func (r *PostTranslationRepository) CacheTranslationHTML(postId int, language string, contentHTML string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE post_translations SET content_html = ? WHERE post_id == ? AND language == ?", contentHTML, postId, language)
+
+	return err
+}
@@ -0,0 +1,324 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+	"web/example/internal/domain"
+)
+
+// SeriesRepositoryInterface covers series and the order of their posts
+type SeriesRepositoryInterface interface {
+	CreateSeries(series *domain.Series) error
+	ReadSeries(id int) (*domain.Series, error)
+	ReadUserSeries(userId int) ([]domain.Series, error)
+	ReadPostSeries(postId int) (*domain.Series, error)
+	UpdateSeries(series *domain.Series) error
+	DeleteSeries(id int) error
+	ReadSeriesPosts(seriesId int) ([]domain.Post, error)
+	AddSeriesPost(seriesId int, postId int, position int) (bool, error)
+	MoveSeriesPost(seriesId int, postId int, position int) (bool, error)
+	ReorderSeries(seriesId int, postIds []int) (bool, error)
+	RemoveSeriesPost(seriesId int, postId int) error
+}
+
+// SeriesRepository handles all database operations for series
+type SeriesRepository struct {
+	db *sql.DB
+}
+
+// NewSeriesRepository creates a new instance of SeriesRepository
+func NewSeriesRepository(db *sql.DB) *SeriesRepository {
+	return &SeriesRepository{
+		db: db,
+	}
+}
+
+const seriesColumns = "s.id, s.user_id, s.title, s.description, s.created_at"
+
+func scanSeries(row rowScanner) (*domain.Series, error) {
+	var s domain.Series
+
+	if err := row.Scan(&s.Id, &s.UserId, &s.Title, &s.Description, &s.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// CreateSeries stores the series and sets its id
+func (r *SeriesRepository) CreateSeries(series *domain.Series) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx,
+		"INSERT INTO series (user_id, title, description) values (?, ?, ?)",
+		series.UserId, series.Title, series.Description)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	series.Id = int(id)
+
+	return err
+}
+
+func (r *SeriesRepository) ReadSeries(id int) (*domain.Series, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	return scanSeries(r.db.QueryRowContext(ctx, "SELECT "+seriesColumns+" FROM series s WHERE s.id == ?", id))
+}
+
+// ReadUserSeries lists the user's series, newest first
+func (r *SeriesRepository) ReadUserSeries(userId int) ([]domain.Series, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+seriesColumns+" FROM series s WHERE s.user_id == ? ORDER BY s.id DESC", userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var series []domain.Series
+
+	for rows.Next() {
+		s, err := scanSeries(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		series = append(series, *s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+// ReadPostSeries reads the series the post is part of, sql.ErrNoRows when
+// it is in none
+func (r *SeriesRepository) ReadPostSeries(postId int) (*domain.Series, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	return scanSeries(r.db.QueryRowContext(ctx,
+		"SELECT "+seriesColumns+" FROM series s JOIN series_posts sp ON sp.series_id == s.id WHERE sp.post_id == ?", postId))
+}
+
+// UpdateSeries overwrites title and description
+func (r *SeriesRepository) UpdateSeries(series *domain.Series) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE series SET title = ?, description = ? WHERE id == ?",
+		series.Title, series.Description, series.Id)
+
+	return err
+}
+
+// DeleteSeries deletes the series, its posts are kept
+func (r *SeriesRepository) DeleteSeries(id int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx, "DELETE FROM series WHERE id == ?", id)
+
+	return err
+}
+
+// ReadSeriesPosts lists the live posts of the series in order, in any status
+func (r *SeriesRepository) ReadSeriesPosts(seriesId int) ([]domain.Post, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+postColumns+" FROM posts JOIN series_posts sp ON sp.post_id == posts.id"+
+			" WHERE sp.series_id == ? AND "+livePosts+" ORDER BY sp.position",
+		seriesId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanPosts(rows)
+}
+
+// AddSeriesPost inserts the post at position, 1 based and clamped to the
+// series, 0 appends it. It reports false when the post is already part of a
+// series.
+func (r *SeriesRepository) AddSeriesPost(seriesId int, postId int, position int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"INSERT INTO series_posts (series_id, post_id, position) values (?, ?, 0) ON CONFLICT DO NOTHING",
+		seriesId, postId)
+	if err != nil {
+		return false, err
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+
+	order, err := readOrder(ctx, tx, "series_posts", "series_id", "post_id", seriesId)
+	if err != nil {
+		return false, err
+	}
+
+	order = slices.DeleteFunc(order, func(id int) bool { return id == postId })
+
+	if err := writeOrder(ctx, tx, "series_posts", "series_id", "post_id", seriesId, insertAt(order, postId, position)); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// MoveSeriesPost moves the post to position, 1 based and clamped to the
+// series. It reports false when the post is not part of the series.
+func (r *SeriesRepository) MoveSeriesPost(seriesId int, postId int, position int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	order, err := readOrder(ctx, tx, "series_posts", "series_id", "post_id", seriesId)
+	if err != nil {
+		return false, err
+	}
+
+	i := slices.Index(order, postId)
+	if i < 0 {
+		return false, nil
+	}
+
+	order = slices.Delete(order, i, i+1)
+
+	if err := writeOrder(ctx, tx, "series_posts", "series_id", "post_id", seriesId, insertAt(order, postId, max(position, 1))); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// ReorderSeries puts the posts first in the order of postIds, posts left out
+// follow in their current order. It reports false when postIds names a post
+// that is not part of the series or names one twice.
+func (r *SeriesRepository) ReorderSeries(seriesId int, postIds []int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	order, err := readOrder(ctx, tx, "series_posts", "series_id", "post_id", seriesId)
+	if err != nil {
+		return false, err
+	}
+
+	rest := slices.Clone(order)
+	for _, id := range postIds {
+		i := slices.Index(rest, id)
+		if i < 0 {
+			return false, nil
+		}
+		rest = slices.Delete(rest, i, i+1)
+	}
+
+	if err := writeOrder(ctx, tx, "series_posts", "series_id", "post_id", seriesId, append(slices.Clone(postIds), rest...)); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// RemoveSeriesPost takes the post out of the series and closes the gap it
+// leaves
+func (r *SeriesRepository) RemoveSeriesPost(seriesId int, postId int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx,
+		"DELETE FROM series_posts WHERE series_id == ? AND post_id == ?", seriesId, postId); err != nil {
+		return err
+	}
+
+	order, err := readOrder(ctx, tx, "series_posts", "series_id", "post_id", seriesId)
+	if err != nil {
+		return err
+	}
+
+	if err := writeOrder(ctx, tx, "series_posts", "series_id", "post_id", seriesId, order); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// leaveSeries takes the post out of every series it is part of and closes the
+// gaps it leaves
+func leaveSeries(ctx context.Context, tx *sql.Tx, postId int) error {
+	rows, err := tx.QueryContext(ctx, "SELECT series_id FROM series_posts WHERE post_id == ?", postId)
+	if err != nil {
+		return err
+	}
+
+	var seriesIds []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		seriesIds = append(seriesIds, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM series_posts WHERE post_id == ?", postId); err != nil {
+		return err
+	}
+
+	for _, seriesId := range seriesIds {
+		order, err := readOrder(ctx, tx, "series_posts", "series_id", "post_id", seriesId)
+		if err != nil {
+			return err
+		}
+
+		if err := writeOrder(ctx, tx, "series_posts", "series_id", "post_id", seriesId, order); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 	"web/example/internal/domain"
 )
@@ -17,6 +18,24 @@ type UserRepositoryInterface interface {
 	DeleteUser(email string) error
 	ReadUser(email string) (*domain.User, error)
 	UpdateUsername(email string, new_username string) error
+	ReadUserPendingDeletion(email string) (*domain.User, error)
+	CancelUserDeletion(email string) error
+	PurgeDeletedUsers(before time.Time) (int64, []string, error)
+	ReadErasurePlan(userId int) ([]domain.ErasureStep, error)
+	ReadUsersByUsernames(usernames []string) ([]domain.User, error)
+}
+
+// userColumns is the column list matching scanUser
+const userColumns = "id, email, username, password_hash, deleted_at, role"
+
+func scanUser(row rowScanner) (*domain.User, error) {
+	var u domain.User
+
+	if err := row.Scan(&u.Id, &u.Email, &u.Username, &u.Password_hash, &u.DeletedAt, &u.Role); err != nil {
+		return nil, err
+	}
+
+	return &u, nil
 }
 
 // UserRepository handles all database operations for users
@@ -42,14 +61,22 @@ func (r *UserRepository) CreateUser(usr *domain.User) error {
 	return err
 }
 
+// DeleteUser marks the account for deletion, it stays recoverable until
+// PurgeDeletedUsers erases it
 func (r *UserRepository) DeleteUser(email string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 
 	defer cancel()
 
-	_, err := r.db.ExecContext(ctx, "DELETE FROM users WHERE email == ?", email)
+	res, err := r.db.ExecContext(ctx, "UPDATE users SET deleted_at = CURRENT_TIMESTAMP WHERE email == ? AND deleted_at IS NULL", email)
 
-	return err
+	if err != nil {
+		return err
+	} else if n, err := res.RowsAffected(); err != nil || n <= 0 {
+		return fmt.Errorf("nothing has changed (no user)")
+	}
+
+	return nil
 }
 
 func (r *UserRepository) ReadUser(email string) (*domain.User, error) {
@@ -57,14 +84,9 @@ func (r *UserRepository) ReadUser(email string) (*domain.User, error) {
 
 	defer cancel()
 
-	row := r.db.QueryRowContext(ctx, "SELECT * FROM users WHERE email == ?", email)
-
-	var u domain.User
+	row := r.db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE email == ? AND deleted_at IS NULL", email)
 
-	if err := row.Scan(&u.Id, &u.Email, &u.Username, &u.Password_hash); err != nil {
-		return nil, err
-	}
-	return &u, nil
+	return scanUser(row)
 }
 
 func (r *UserRepository) UpdateUsername(email string, new_username string) error {
@@ -72,7 +94,7 @@ func (r *UserRepository) UpdateUsername(email string, new_username string) error
 
 	defer cancel()
 
-	res, err := r.db.ExecContext(ctx, "UPDATE users SET username = ? WHERE email == ?", new_username, email)
+	res, err := r.db.ExecContext(ctx, "UPDATE users SET username = ? WHERE email == ? AND deleted_at IS NULL", new_username, email)
 
 	if err != nil {
 		return err
@@ -82,3 +104,145 @@ func (r *UserRepository) UpdateUsername(email string, new_username string) error
 
 	return nil
 }
+
+// ReadUserPendingDeletion reads an account only while it is marked for deletion
+func (r *UserRepository) ReadUserPendingDeletion(email string) (*domain.User, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+
+	defer cancel()
+
+	row := r.db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE email == ? AND deleted_at IS NOT NULL AND erased_at IS NULL", email)
+
+	return scanUser(row)
+}
+
+func (r *UserRepository) CancelUserDeletion(email string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+
+	defer cancel()
+
+	res, err := r.db.ExecContext(ctx, "UPDATE users SET deleted_at = NULL WHERE email == ? AND deleted_at IS NOT NULL", email)
+
+	if err != nil {
+		return err
+	} else if n, err := res.RowsAffected(); err != nil || n <= 0 {
+		return fmt.Errorf("nothing has changed (no pending deletion)")
+	}
+
+	return nil
+}
+
+// PurgeDeletedUsers erases the accounts marked for deletion before the given
+// time by the erasure policy, one transaction per account. It returns the
+// blob keys of the erased attachments so the caller can delete the files,
+// also those of the accounts erased before a failure.
+func (r *UserRepository) PurgeDeletedUsers(before time.Time) (int64, []string, error) {
+	ids, err := r.readDueErasures(before)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var erased int64
+	var keys []string
+
+	for _, id := range ids {
+		blobKeys, err := r.erase(id)
+		if err != nil {
+			return erased, keys, err
+		}
+		erased++
+		keys = append(keys, blobKeys...)
+	}
+
+	return erased, keys, nil
+}
+
+func (r *UserRepository) readDueErasures(before time.Time) ([]int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT id FROM users WHERE deleted_at IS NOT NULL AND deleted_at <= ? AND erased_at IS NULL",
+		before.UTC().Format(sqliteTimeLayout))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// erase applies the erasure policy to the user, it returns the blob keys of
+// the erased attachments
+func (r *UserRepository) erase(userId int) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), erasureTimeout)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	keys, err := readBlobKeys(ctx, tx, "user_id == ?1", userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := eraseUser(ctx, tx, userId); err != nil {
+		return nil, err
+	}
+
+	return keys, tx.Commit()
+}
+
+// ReadUsersByUsernames lists the users having any of the usernames, usernames
+// are not unique so one name can match several users
+func (r *UserRepository) ReadUsersByUsernames(usernames []string) ([]domain.User, error) {
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	args := make([]any, len(usernames))
+	for i, name := range usernames {
+		args[i] = name
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+userColumns+" FROM users WHERE username IN (?"+strings.Repeat(", ?", len(usernames)-1)+") AND deleted_at IS NULL",
+		args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []domain.User
+
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, *u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"web/example/internal/domain"
+)
+
+// ViewRepositoryInterface covers the stored view counts of posts
+type ViewRepositoryInterface interface {
+	AddViews(counts []domain.ViewCount) error
+	ReadDailyViews(postId int, since string) ([]domain.DayViews, error)
+	ReadTopReferrers(postId int, since string, limit int) ([]domain.ReferrerViews, error)
+}
+
+// ViewRepository handles all database operations for view counts
+type ViewRepository struct {
+	db *sql.DB
+}
+
+// NewViewRepository creates a new instance of ViewRepository
+func NewViewRepository(db *sql.DB) *ViewRepository {
+	return &ViewRepository{
+		db: db,
+	}
+}
+
+// AddViews adds a batch of counts in one transaction. Counts of posts purged
+// meanwhile are dropped.
+func (r *ViewRepository) AddViews(counts []domain.ViewCount) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx,
+		`INSERT INTO post_views (post_id, day, referrer, views) SELECT ?, ?, ?, ? WHERE EXISTS (SELECT 1 FROM posts WHERE id == ?)
+		ON CONFLICT (post_id, day, referrer) DO UPDATE SET views = views + excluded.views`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, c := range counts {
+		if _, err := stmt.ExecContext(ctx, c.PostId, c.Day, c.Referrer, c.Views, c.PostId); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ReadDailyViews sums the views of the post per day from since (YYYY-MM-DD)
+// on, oldest first. Days without views are missing.
+func (r *ViewRepository) ReadDailyViews(postId int, since string) ([]domain.DayViews, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT day, SUM(views) FROM post_views WHERE post_id == ? AND day >= ? GROUP BY day ORDER BY day",
+		postId, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []domain.DayViews
+
+	for rows.Next() {
+		var d domain.DayViews
+
+		if err := rows.Scan(&d.Day, &d.Views); err != nil {
+			return nil, err
+		}
+
+		days = append(days, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return days, nil
+}
+
+// ReadTopReferrers lists the referrer hosts with the most views of the post
+// from since (YYYY-MM-DD) on, direct visits are left out
+func (r *ViewRepository) ReadTopReferrers(postId int, since string, limit int) ([]domain.ReferrerViews, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT referrer, SUM(views) AS total FROM post_views WHERE post_id == ? AND day >= ? AND referrer != ''
+		GROUP BY referrer ORDER BY total DESC, referrer LIMIT ?`,
+		postId, since, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var referrers []domain.ReferrerViews
+
+	for rows.Next() {
+		var rv domain.ReferrerViews
+
+		if err := rows.Scan(&rv.Referrer, &rv.Views); err != nil {
+			return nil, err
+		}
+
+		referrers = append(referrers, rv)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return referrers, nil
+}
@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"web/example/internal/domain"
+	"web/example/internal/media"
+	"web/example/internal/repository"
+	"web/example/internal/signedurl"
+	"web/example/internal/storage"
+
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultAttachmentMaxSize = 10 << 20 // 10 MiB
+	DefaultAttachmentURLTTL  = 15 * time.Minute
+)
+
+// blobTimeout bounds blob store writes and deletes
+const blobTimeout = 30 * time.Second
+
+var (
+	ErrAttachmentTooLarge   = errors.New("attachment is too large")
+	ErrUnsupportedMediaType = errors.New("attachment type is not allowed")
+)
+
+// AttachmentService handles uploads and downloads of post attachments, post
+// access is checked through the PostService rules
+type AttachmentService struct {
+	Posts          *PostService
+	AttachmentRepo repository.AttachmentRepositoryInterface
+	Store          storage.BlobStore
+	Signer         *signedurl.Signer
+	MaxSize        int64
+	AllowedTypes   []string
+	URLTTL         time.Duration
+}
+
+// NewAttachmentService creates a new instance of AttachmentService with the
+// default limits
+func NewAttachmentService(db *sql.DB, store storage.BlobStore, signer *signedurl.Signer) *AttachmentService {
+	return &AttachmentService{
+		Posts:          NewPostService(db),
+		AttachmentRepo: repository.NewAttachmentRepository(db),
+		Store:          store,
+		Signer:         signer,
+		MaxSize:        DefaultAttachmentMaxSize,
+		AllowedTypes:   media.DefaultAllowedTypes,
+		URLTTL:         DefaultAttachmentURLTTL,
+	}
+}
+
+// attachmentPath is the download route of an attachment, the part of the URL
+// covered by the signature
+func attachmentPath(id int, thumbnail bool) string {
+	p := "/attachments/" + strconv.Itoa(id)
+	if thumbnail {
+		p += "/thumbnail"
+	}
+	return p
+}
+
+// withURLs fills in the signed download URLs
+func (s *AttachmentService) withURLs(attachment *domain.Attachment) {
+	expires := s.Posts.now().Add(s.URLTTL)
+
+	attachment.URL = s.Signer.Sign(attachmentPath(attachment.Id, false), expires)
+	if attachment.ThumbnailKey != nil {
+		attachment.ThumbnailURL = s.Signer.Sign(attachmentPath(attachment.Id, true), expires)
+	}
+}
+
+// newBlobKey picks a random key below the post, names are never derived from
+// client input
+func newBlobKey(postId int) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return "posts/" + strconv.Itoa(postId) + "/" + hex.EncodeToString(b), nil
+}
+
+// cleanFilename keeps the base name of the uploaded file for display and
+// Content-Disposition, without directories or control characters
+func cleanFilename(filename string) string {
+	filename = path.Base(strings.ReplaceAll(filename, `\`, "/"))
+	filename = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, strings.ToValidUTF8(filename, ""))
+	filename = strings.TrimSpace(filename)
+
+	if len(filename) > 255 {
+		filename = strings.ToValidUTF8(filename[:255], "")
+	}
+
+	if filename == "" || filename == "." || filename == "/" {
+		return "file"
+	}
+
+	return filename
+}
+
+// UploadAttachmentService stores a file on a post of the author. The type is sniffed
+// from the content and checked against AllowedTypes, images are stripped of
+// their metadata and get a thumbnail.
+func (s *AttachmentService) UploadAttachmentService(postId int, userEmail string, filename string, content io.Reader) (*domain.Attachment, error) {
+	post, err := s.Posts.verifyAuthor(postId, userEmail, domain.AuthorEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(content, s.MaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > s.MaxSize {
+		return nil, fmt.Errorf("%w, the limit is %d bytes", ErrAttachmentTooLarge, s.MaxSize)
+	}
+
+	contentType := media.Sniff(data)
+	if !slices.Contains(s.AllowedTypes, contentType) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedMediaType, contentType)
+	}
+
+	key, err := newBlobKey(post.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := &domain.Attachment{
+		PostId:      post.Id,
+		Filename:    cleanFilename(filename),
+		ContentType: contentType,
+		StorageKey:  key,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), blobTimeout)
+	defer cancel()
+
+	if media.IsImage(contentType) {
+		img, err := media.ProcessImage(contentType, data)
+		if err != nil {
+			return nil, fmt.Errorf("could not process image: %w", err)
+		}
+
+		data = img.Data
+		attachment.Width, attachment.Height = &img.Width, &img.Height
+
+		thumbnailKey := key + "-thumbnail"
+		if err := s.Store.Put(ctx, thumbnailKey, img.Thumbnail, img.ThumbnailType); err != nil {
+			return nil, err
+		}
+		attachment.ThumbnailKey = &thumbnailKey
+	}
+
+	attachment.Size = int64(len(data))
+
+	if err := s.Store.Put(ctx, key, data, contentType); err != nil {
+		s.deleteBlobs(attachment)
+		return nil, err
+	}
+
+	if err := s.AttachmentRepo.CreateAttachment(attachment); err != nil {
+		s.deleteBlobs(attachment)
+		return nil, err
+	}
+
+	created, err := s.AttachmentRepo.ReadAttachment(attachment.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.withURLs(created)
+
+	return created, nil
+}
+
+// deleteBlobs removes the stored files of an attachment
+func (s *AttachmentService) deleteBlobs(attachment *domain.Attachment) {
+	keys := []string{attachment.StorageKey}
+	if attachment.ThumbnailKey != nil {
+		keys = append(keys, *attachment.ThumbnailKey)
+	}
+
+	deleteBlobs(s.Store, keys)
+}
+
+// deleteBlobs removes stored files whose rows are gone, failures only leave
+// unreferenced blobs behind so they are logged and not returned
+func deleteBlobs(store storage.BlobStore, keys []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), blobTimeout)
+	defer cancel()
+
+	for _, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			zap.S().Warnf("Could not delete blob %s: %s", key, err.Error())
+		}
+	}
+}
+
+// ReadAttachments lists the attachments of a post the viewer can see, with
+// freshly signed download URLs
+func (s *AttachmentService) ReadAttachments(postId int, viewerEmail string) ([]domain.Attachment, error) {
+	if _, err := s.Posts.readVisible(postId, viewerEmail); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.AttachmentRepo.ReadAttachments(postId)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range attachments {
+		s.withURLs(&attachments[i])
+	}
+
+	return attachments, nil
+}
+
+// OpenAttachment checks a signed download URL and opens the attachment or its
+// thumbnail, returning the content type to serve it with. The reader outlives
+// the call so it is bound to the caller's (request) context.
+func (s *AttachmentService) OpenAttachment(ctx context.Context, id int, thumbnail bool, expires string, sig string) (*domain.Attachment, string, io.ReadSeekCloser, error) {
+	if err := s.Signer.Verify(attachmentPath(id, thumbnail), expires, sig, s.Posts.now()); err != nil {
+		return nil, "", nil, err
+	}
+
+	attachment, err := s.AttachmentRepo.ReadAttachment(id)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	// links stop working once the post is deleted
+	if _, err := s.Posts.PostRepo.ReadPost(attachment.PostId); err != nil {
+		return nil, "", nil, err
+	}
+
+	key, contentType := attachment.StorageKey, attachment.ContentType
+	if thumbnail {
+		if attachment.ThumbnailKey == nil {
+			return nil, "", nil, sql.ErrNoRows
+		}
+		key, contentType = *attachment.ThumbnailKey, media.ThumbnailType(attachment.ContentType)
+	}
+
+	content, err := s.Store.Open(ctx, key)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	return attachment, contentType, content, nil
+}
+
+// DeleteAttachmentService removes an attachment of a post of the author with its files
+func (s *AttachmentService) DeleteAttachmentService(postId int, attachmentId int, userEmail string) error {
+	if _, err := s.Posts.verifyAuthor(postId, userEmail, domain.AuthorEditor); err != nil {
+		return err
+	}
+
+	attachment, err := s.AttachmentRepo.ReadAttachment(attachmentId)
+	if err != nil {
+		return err
+	}
+
+	if attachment.PostId != postId {
+		return sql.ErrNoRows
+	}
+
+	if err := s.AttachmentRepo.DeleteAttachment(attachment.Id); err != nil {
+		return err
+	}
+
+	s.deleteBlobs(attachment)
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ErrAlreadyAuthor    = errors.New("user is already an author of the post or invited")
+	ErrOwnerCannotLeave = errors.New("the owner can't leave the post, transfer the ownership first")
+	ErrNotEditor        = errors.New("ownership can only be transferred to an editor who accepted")
+)
+
+// AuthorService handles the authors of posts: invites, leaving and
+// transferring ownership
+type AuthorService struct {
+	Posts         *PostService
+	AuthorRepo    repository.PostAuthorRepositoryInterface
+	Notifications *NotificationService
+}
+
+// NewAuthorService creates a new instance of AuthorService with repositories,
+// invited users are notified through notifications
+func NewAuthorService(db *sql.DB, notifications *NotificationService) *AuthorService {
+	return &AuthorService{
+		Posts:         NewPostService(db),
+		AuthorRepo:    repository.NewPostAuthorRepository(db),
+		Notifications: notifications,
+	}
+}
+
+// ReadAuthors lists the authors of the post with pending invites, for its
+// authors only
+func (s *AuthorService) ReadAuthors(postId int, userEmail string) ([]domain.PostAuthor, error) {
+	post, err := s.Posts.verifyAuthor(postId, userEmail, domain.AuthorEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	authors, err := s.AuthorRepo.ReadPostAuthors(post.Id)
+	if err != nil {
+		return nil, err
+	}
+	if authors == nil {
+		authors = []domain.PostAuthor{}
+	}
+
+	return authors, nil
+}
+
+// Invite invites a user as editor of an owned post, they are an author once
+// they accept
+func (s *AuthorService) Invite(postId int, req *handlermodel.InvitePostAuthorRequest) error {
+	post, err := s.Posts.verifyAuthor(postId, req.UserEmail, domain.AuthorOwner)
+	if err != nil {
+		return err
+	}
+
+	invitee, err := s.Posts.UserRepo.ReadUser(req.Email)
+	if err != nil {
+		return err
+	}
+
+	if s.Posts.FollowRepo != nil {
+		if blocked, err := s.Posts.FollowRepo.IsBlocked(post.UserId, invitee.Id); err != nil {
+			return err
+		} else if blocked {
+			return ErrBlocked
+		}
+	}
+
+	invited, err := s.AuthorRepo.InviteAuthor(post.Id, invitee.Id, post.UserId)
+	if err != nil {
+		return err
+	}
+	if !invited {
+		return ErrAlreadyAuthor
+	}
+
+	// the invite is stored, a lost notification is not worth failing it
+	if err := s.Notifications.Notify(invitee.Id, post.UserId, domain.NotificationAuthorInvite, &post.Id); err != nil {
+		zap.S().Warnf("Could not notify user %d of invite to post %d: %s", invitee.Id, post.Id, err.Error())
+	}
+
+	return nil
+}
+
+// Accept makes the user an editor of the post they were invited to
+func (s *AuthorService) Accept(postId int, userEmail string) error {
+	user, err := s.Posts.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return err
+	}
+
+	accepted, err := s.AuthorRepo.AcceptInvite(postId, user.Id)
+	if err != nil {
+		return err
+	}
+	if !accepted {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Remove takes an editor off the post or withdraws an invite. Owners remove
+// anyone but themselves, editors and invited users only themselves.
+func (s *AuthorService) Remove(postId int, req *handlermodel.RemovePostAuthorRequest) error {
+	user, err := s.Posts.UserRepo.ReadUser(req.UserEmail)
+	if err != nil {
+		return err
+	}
+
+	target, err := s.Posts.UserRepo.ReadUser(req.Email)
+	if err != nil {
+		return err
+	}
+
+	var post *domain.Post
+	if user.Id == target.Id {
+		post, err = s.Posts.PostRepo.ReadPost(postId)
+	} else {
+		post, err = s.Posts.verifyAuthor(postId, req.UserEmail, domain.AuthorOwner)
+	}
+	if err != nil {
+		return err
+	}
+
+	if post.UserId == target.Id {
+		return ErrOwnerCannotLeave
+	}
+
+	removed, err := s.AuthorRepo.RemoveAuthor(post.Id, target.Id)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Transfer hands an owned post over to one of its editors, the owner stays
+// on as editor
+func (s *AuthorService) Transfer(postId int, req *handlermodel.TransferPostRequest) error {
+	post, err := s.Posts.verifyAuthor(postId, req.UserEmail, domain.AuthorOwner)
+	if err != nil {
+		return err
+	}
+
+	target, err := s.Posts.UserRepo.ReadUser(req.Email)
+	if err != nil {
+		return err
+	}
+
+	if target.Id == post.UserId {
+		return nil
+	}
+
+	transferred, err := s.AuthorRepo.TransferOwnership(post.Id, post.UserId, target.Id)
+	if err != nil {
+		return err
+	}
+	if !transferred {
+		return ErrNotEditor
+	}
+
+	return nil
+}
@@ -0,0 +1,402 @@
+package services
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository"
+)
+
+// MaxReadingListItems caps the posts in one reading list
+const MaxReadingListItems = 500
+
+var (
+	ErrReadingListExists = errors.New("a reading list with this name already exists")
+	ErrReadingListFull   = errors.New("reading list is full")
+	ErrAlreadyInList     = errors.New("post is already in the reading list")
+)
+
+// BookmarkService handles bookmarks and reading lists
+type BookmarkService struct {
+	Posts        *PostService
+	BookmarkRepo repository.BookmarkRepositoryInterface
+}
+
+// NewBookmarkService creates a new instance of BookmarkService with repositories
+func NewBookmarkService(db *sql.DB) *BookmarkService {
+	return &BookmarkService{
+		Posts:        NewPostService(db),
+		BookmarkRepo: repository.NewBookmarkRepository(db),
+	}
+}
+
+// Bookmark saves a post the user can read, bookmarking it again replaces the note
+func (s *BookmarkService) Bookmark(postId int, userEmail string, note string) error {
+	user, err := s.Posts.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.Posts.readVisible(postId, userEmail); err != nil {
+		return err
+	}
+
+	return s.BookmarkRepo.SaveBookmark(user.Id, postId, note)
+}
+
+func (s *BookmarkService) Unbookmark(postId int, userEmail string) error {
+	user, err := s.Posts.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return err
+	}
+
+	return s.BookmarkRepo.DeleteBookmark(user.Id, postId)
+}
+
+// ReadBookmarks pages through the user's bookmarks, newest first. Bookmarks
+// of posts that were deleted or can no longer be read are kept without their
+// post, the cursor is the id of the last bookmark.
+func (s *BookmarkService) ReadBookmarks(userEmail string, cursor string, limit int) (*domain.Page[domain.Bookmark], error) {
+	before, err := decodeCursor(cursor, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.Posts.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pageSize(limit)
+
+	bookmarks, err := s.BookmarkRepo.ReadBookmarks(user.Id, int(before[0]), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(bookmarks))
+	for i, b := range bookmarks {
+		ids[i] = b.PostId
+	}
+
+	posts, err := s.readPosts(ids, s.Posts.viewerOf(user))
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.Page[domain.Bookmark]{Items: []domain.Bookmark{}}
+	for _, b := range bookmarks {
+		b.Post = posts[b.PostId]
+		page.Items = append(page.Items, b)
+	}
+
+	if len(bookmarks) == limit {
+		page.NextCursor = encodeCursor(int64(bookmarks[len(bookmarks)-1].Id))
+	}
+
+	return page, nil
+}
+
+// readPosts reads the posts the viewer can read by id, with their rendered
+// content. Posts that are gone or not visible are missing from the map.
+func (s *BookmarkService) readPosts(ids []int, v viewer) (map[int]*domain.Post, error) {
+	posts, err := s.Posts.PostRepo.ReadPostsByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byId := map[int]*domain.Post{}
+	for i := range posts {
+		if !canView(&posts[i], v) {
+			continue
+		}
+
+		if err := s.Posts.withHTML(&posts[i]); err != nil {
+			return nil, err
+		}
+
+		byId[posts[i].Id] = &posts[i]
+	}
+
+	return byId, nil
+}
+
+// newShareToken picks the random token of a public list's URL
+func newShareToken() (*string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return &token, nil
+}
+
+// withSharePath sets the public URL path of a list shared by its owner
+func withSharePath(list *domain.ReadingList) *domain.ReadingList {
+	if list.ShareToken != nil {
+		list.SharePath = "/lists/" + *list.ShareToken
+	}
+	return list
+}
+
+// nameTaken reports whether the user has another list called name
+func (s *BookmarkService) nameTaken(userId int, name string, listId int) (bool, error) {
+	lists, err := s.BookmarkRepo.ReadReadingLists(userId)
+	if err != nil {
+		return false, err
+	}
+
+	for _, l := range lists {
+		if l.Name == name && l.Id != listId {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// CreateReadingList creates an empty list, public lists get a share URL
+func (s *BookmarkService) CreateReadingList(req *handlermodel.CreateReadingListRequest) (*domain.ReadingList, error) {
+	user, err := s.Posts.UserRepo.ReadUser(req.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	if taken, err := s.nameTaken(user.Id, req.Name, 0); err != nil {
+		return nil, err
+	} else if taken {
+		return nil, ErrReadingListExists
+	}
+
+	list := &domain.ReadingList{UserId: user.Id, Name: req.Name, Description: req.Description}
+	if req.Public {
+		if list.ShareToken, err = newShareToken(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.BookmarkRepo.CreateReadingList(list); err != nil {
+		return nil, err
+	}
+
+	list, _, err = s.readOwnList(list.Id, req.UserEmail)
+	return list, err
+}
+
+// ReadReadingLists lists the user's lists without their items
+func (s *BookmarkService) ReadReadingLists(userEmail string) ([]domain.ReadingList, error) {
+	user, err := s.Posts.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := s.BookmarkRepo.ReadReadingLists(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []domain.ReadingList{}
+	for _, l := range lists {
+		result = append(result, *withSharePath(&l))
+	}
+
+	return result, nil
+}
+
+// readOwnList reads a list of the user along with the user, lists of other
+// users are not found
+func (s *BookmarkService) readOwnList(listId int, userEmail string) (*domain.ReadingList, *domain.User, error) {
+	user, err := s.Posts.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list, err := s.BookmarkRepo.ReadReadingList(listId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if list.UserId != user.Id {
+		return nil, nil, sql.ErrNoRows
+	}
+
+	return withSharePath(list), user, nil
+}
+
+// ReadReadingList reads an own list with its items in order. Items of posts
+// the owner can no longer read are kept without their post.
+func (s *BookmarkService) ReadReadingList(listId int, userEmail string) (*domain.ReadingList, error) {
+	list, owner, err := s.readOwnList(listId, userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.withItems(list, s.Posts.viewerOf(owner), true); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// ReadSharedReadingList reads a public list by its share token as an
+// anonymous reader, items that reader can't see are left out
+func (s *BookmarkService) ReadSharedReadingList(token string) (*domain.ReadingList, error) {
+	list, err := s.BookmarkRepo.ReadReadingListByToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.withItems(list, viewer{}, false); err != nil {
+		return nil, err
+	}
+	list.ItemCount = len(list.Items)
+
+	return list, nil
+}
+
+// withItems loads the items of list with the posts v can read, keepMissing
+// keeps the items of the other posts without their post
+func (s *BookmarkService) withItems(list *domain.ReadingList, v viewer, keepMissing bool) error {
+	items, err := s.BookmarkRepo.ReadListItems(list.Id)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]int, len(items))
+	for i, item := range items {
+		ids[i] = item.PostId
+	}
+
+	posts, err := s.readPosts(ids, v)
+	if err != nil {
+		return err
+	}
+
+	list.Items = []domain.ReadingListItem{}
+	for _, item := range items {
+		item.Post = posts[item.PostId]
+		if item.Post == nil && !keepMissing {
+			continue
+		}
+
+		item.Position = len(list.Items) + 1
+		list.Items = append(list.Items, item)
+	}
+
+	return nil
+}
+
+// UpdateReadingList renames an own list or changes its description or
+// sharing. Sharing again after unsharing makes a new URL, old links stay dead.
+func (s *BookmarkService) UpdateReadingList(listId int, req *handlermodel.UpdateReadingListRequest) (*domain.ReadingList, error) {
+	list, _, err := s.readOwnList(listId, req.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil && *req.Name != list.Name {
+		if taken, err := s.nameTaken(list.UserId, *req.Name, list.Id); err != nil {
+			return nil, err
+		} else if taken {
+			return nil, ErrReadingListExists
+		}
+		list.Name = *req.Name
+	}
+
+	if req.Description != nil {
+		list.Description = *req.Description
+	}
+
+	if req.Public != nil {
+		switch {
+		case !*req.Public:
+			list.ShareToken = nil
+		case list.ShareToken == nil:
+			if list.ShareToken, err = newShareToken(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := s.BookmarkRepo.UpdateReadingList(list); err != nil {
+		return nil, err
+	}
+
+	list, _, err = s.readOwnList(list.Id, req.UserEmail)
+	return list, err
+}
+
+func (s *BookmarkService) DeleteReadingList(listId int, userEmail string) error {
+	list, _, err := s.readOwnList(listId, userEmail)
+	if err != nil {
+		return err
+	}
+
+	return s.BookmarkRepo.DeleteReadingList(list.Id)
+}
+
+// AddListItem adds a post the user can read to an own list at position, 0
+// appends it
+func (s *BookmarkService) AddListItem(listId int, req *handlermodel.AddReadingListItemRequest) error {
+	list, _, err := s.readOwnList(listId, req.UserEmail)
+	if err != nil {
+		return err
+	}
+
+	if list.ItemCount >= MaxReadingListItems {
+		return ErrReadingListFull
+	}
+
+	if _, err := s.Posts.readVisible(req.PostId, req.UserEmail); err != nil {
+		return err
+	}
+
+	added, err := s.BookmarkRepo.AddListItem(list.Id, req.PostId, req.Note, req.Position)
+	if err != nil {
+		return err
+	}
+	if !added {
+		return ErrAlreadyInList
+	}
+
+	return nil
+}
+
+// UpdateListItem moves an item of an own list or changes its note
+func (s *BookmarkService) UpdateListItem(listId int, postId int, req *handlermodel.UpdateReadingListItemRequest) error {
+	list, _, err := s.readOwnList(listId, req.UserEmail)
+	if err != nil {
+		return err
+	}
+
+	if req.Note != nil {
+		if found, err := s.BookmarkRepo.UpdateListItemNote(list.Id, postId, *req.Note); err != nil {
+			return err
+		} else if !found {
+			return sql.ErrNoRows
+		}
+	}
+
+	if req.Position != nil {
+		if found, err := s.BookmarkRepo.MoveListItem(list.Id, postId, *req.Position); err != nil {
+			return err
+		} else if !found {
+			return sql.ErrNoRows
+		}
+	}
+
+	return nil
+}
+
+func (s *BookmarkService) RemoveListItem(listId int, postId int, userEmail string) error {
+	list, _, err := s.readOwnList(listId, userEmail)
+	if err != nil {
+		return err
+	}
+
+	return s.BookmarkRepo.RemoveListItem(list.Id, postId)
+}
@@ -0,0 +1,430 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+	"web/example/internal/domain"
+	"web/example/internal/export"
+	"web/example/internal/filter"
+	"web/example/internal/render"
+	"web/example/internal/repository"
+	"web/example/internal/slug"
+)
+
+// ExportService exports a user's posts and series and imports them into
+// another account or instance
+type ExportService struct {
+	Posts      *PostService
+	ImportRepo repository.ImportRepositoryInterface
+	SeriesRepo repository.SeriesRepositoryInterface
+}
+
+// NewExportService creates a new instance of ExportService with repositories
+func NewExportService(db *sql.DB) *ExportService {
+	return &ExportService{
+		Posts:      NewPostService(db),
+		ImportRepo: repository.NewImportRepository(db),
+		SeriesRepo: repository.NewSeriesRepository(db),
+	}
+}
+
+// Export reads the posts the user owns, in any status, and their series.
+// Posts they only co-author are left to their owners' exports.
+func (s *ExportService) Export(userEmail string) (*export.Archive, error) {
+	user, err := s.Posts.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := s.Posts.PostRepo.ReadUserPosts(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &export.Archive{}
+	exported := map[int]bool{}
+
+	for _, p := range posts {
+		var createdAt time.Time
+		if t := parseTime(&p.CreatedAt); t != nil {
+			createdAt = *t
+		}
+
+		a.Posts = append(a.Posts, export.Post{
+			Id:            p.Id,
+			Slug:          p.Slug,
+			Title:         p.Title,
+			ContentFormat: p.ContentFormat,
+			Status:        string(p.Status),
+			Visibility:    string(p.Visibility),
+			Tags:          p.Tags,
+			CreatedAt:     createdAt,
+			PublishedAt:   parseTime(p.PublishedAt),
+			PublishAt:     parseTime(p.PublishAt),
+			ContentHash:   export.Hash(p.Title, p.Content),
+			Content:       p.Content,
+		})
+		exported[p.Id] = true
+	}
+
+	series, err := s.SeriesRepo.ReadUserSeries(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// oldest first like the posts, so an import creates them in the same order
+	slices.Reverse(series)
+
+	for _, sr := range series {
+		parts, err := s.SeriesRepo.ReadSeriesPosts(sr.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		ids := []int{}
+		for _, p := range parts {
+			// a part transferred to another owner is not in this export
+			if exported[p.Id] {
+				ids = append(ids, p.Id)
+			}
+		}
+
+		a.Series = append(a.Series, export.Series{Title: sr.Title, Description: sr.Description, PostIds: ids})
+	}
+
+	return a, nil
+}
+
+// parseTime parses a time as scanned from the db, nil and unparsable times
+// are nil
+func parseTime(value *string) *time.Time {
+	if value == nil {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return nil
+	}
+
+	return &t
+}
+
+// importing is the state of an import as its items are checked
+type importing struct {
+	user     *domain.User
+	report   *domain.ImportReport
+	posts    []domain.PostImport
+	series   []domain.SeriesImport
+	bySource map[int]*domain.Post    // Export id to the post it maps to
+	failed   map[int]bool            // Export ids of the posts that failed
+	itemPost map[int]*domain.Post    // Report item index to the post it maps to
+	byHash   map[string]*domain.Post // Existing and imported posts by export.Hash
+	slugs    map[string]bool         // Slugs taken by the imported posts
+}
+
+// Import checks every item of the archive and stores the posts and series
+// unless one of them failed or dryRun is set, the report says what became of
+// each item. Posts with the title and content of a post the user already has,
+// or of an earlier post of the import, are skipped and their export id maps
+// to that post. Mentions are resolved but nobody is notified. Only errors
+// other than failed items are returned.
+func (s *ExportService) Import(userEmail string, a *export.Archive, dryRun bool) (*domain.ImportReport, error) {
+	user, err := s.Posts.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.Posts.PostRepo.ReadUserPosts(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	im := &importing{
+		user:     user,
+		report:   &domain.ImportReport{DryRun: dryRun, Items: []domain.ImportItem{}},
+		bySource: map[int]*domain.Post{},
+		failed:   map[int]bool{},
+		itemPost: map[int]*domain.Post{},
+		byHash:   map[string]*domain.Post{},
+		slugs:    map[string]bool{},
+	}
+
+	for i := range existing {
+		im.byHash[export.Hash(existing[i].Title, existing[i].Content)] = &existing[i]
+	}
+
+	for i := range a.Posts {
+		item := domain.ImportItem{Source: a.Posts[i].Source, Kind: "post", SourceId: a.Posts[i].Id}
+
+		result, post, err := s.importPost(im, &a.Posts[i])
+		if err != nil {
+			im.fail(item, err)
+			if a.Posts[i].Id != 0 {
+				im.failed[a.Posts[i].Id] = true
+			}
+			continue
+		}
+
+		item.Result = result
+		im.itemPost[len(im.report.Items)] = post
+		im.add(item)
+	}
+
+	for i := range a.Series {
+		item := domain.ImportItem{Source: a.Series[i].Source, Kind: "series"}
+
+		result, err := s.importSeries(im, &a.Series[i])
+		if err != nil {
+			im.fail(item, err)
+			continue
+		}
+
+		item.Result = result
+		im.add(item)
+	}
+
+	for _, inv := range a.Invalid {
+		im.fail(domain.ImportItem{Source: inv.Source}, inv.Err)
+	}
+
+	if dryRun || im.report.Failed > 0 {
+		return im.report, nil
+	}
+
+	if err := s.ImportRepo.ImportPosts(im.posts, im.series); err != nil {
+		return nil, err
+	}
+
+	im.report.Imported = true
+	im.report.PostIds = map[int]int{}
+
+	// the stored posts got their ids, the items are filled in with them
+	for i, post := range im.itemPost {
+		im.report.Items[i].PostId = post.Id
+	}
+	for id, post := range im.bySource {
+		im.report.PostIds[id] = post.Id
+	}
+
+	return im.report, nil
+}
+
+func (im *importing) add(item domain.ImportItem) {
+	switch {
+	case item.Result == domain.ImportDuplicate:
+		im.report.Duplicates++
+	case item.Kind == "post":
+		im.report.Created++
+	default:
+		im.report.Series++
+	}
+
+	im.report.Items = append(im.report.Items, item)
+}
+
+func (im *importing) fail(item domain.ImportItem, err error) {
+	item.Result = domain.ImportFailed
+	item.Error = err.Error()
+
+	im.report.Failed++
+	im.report.Items = append(im.report.Items, item)
+}
+
+// importPost checks the post and queues it for storing unless it is a
+// duplicate, it returns the post the item maps to
+func (s *ExportService) importPost(im *importing, p *export.Post) (domain.ImportResult, *domain.Post, error) {
+	if p.Id < 0 {
+		return "", nil, fmt.Errorf("invalid id %d", p.Id)
+	}
+	if _, ok := im.bySource[p.Id]; ok || im.failed[p.Id] {
+		return "", nil, fmt.Errorf("id %d is used by another post of the import", p.Id)
+	}
+	p.Title, p.Content = domain.CleanText(p.Title, true), domain.CleanText(p.Content, false)
+	if p.Title == "" || p.Content == "" {
+		return "", nil, fmt.Errorf("title and content are required")
+	}
+	if err := domain.CheckPostText(p.Title, p.Content); err != nil {
+		return "", nil, err
+	}
+
+	status := domain.PostStatus(p.Status)
+	switch status {
+	case "":
+		status = domain.PostStatusPublished
+	case domain.PostStatusDraft, domain.PostStatusPublished, domain.PostStatusArchived:
+	default:
+		return "", nil, fmt.Errorf("invalid status %q", p.Status)
+	}
+
+	format := p.ContentFormat
+	switch format {
+	case "":
+		format = render.FormatPlain
+	case render.FormatPlain, render.FormatMarkdown:
+	default:
+		return "", nil, fmt.Errorf("invalid content format %q", p.ContentFormat)
+	}
+
+	visibility := domain.PostVisibility(p.Visibility)
+	switch visibility {
+	case "":
+		visibility = domain.VisibilityPublic
+	case domain.VisibilityPublic, domain.VisibilityUnlisted, domain.VisibilityFollowers, domain.VisibilityPrivate:
+	default:
+		return "", nil, fmt.Errorf("invalid visibility %q", p.Visibility)
+	}
+
+	tags := domain.CleanTags(p.Tags)
+	if len(tags) > domain.MaxTags {
+		return "", nil, fmt.Errorf("at most %d tags", domain.MaxTags)
+	}
+	for _, tag := range tags {
+		if !domain.IsTag(tag) {
+			return "", nil, fmt.Errorf("invalid tag %q", tag)
+		}
+	}
+
+	if p.PublishAt != nil && status != domain.PostStatusDraft {
+		return "", nil, fmt.Errorf("only drafts can be scheduled")
+	}
+
+	hash := export.Hash(p.Title, p.Content)
+	if dup, ok := im.byHash[hash]; ok {
+		if p.Id != 0 {
+			im.bySource[p.Id] = dup
+		}
+		return domain.ImportDuplicate, dup, nil
+	}
+
+	flags, err := s.Posts.checkContent(&filter.Content{UserId: im.user.Id, Title: p.Title, Body: p.Content})
+	if err != nil {
+		return "", nil, err
+	}
+
+	postSlug, err := s.importSlug(im, p.Title)
+	if err != nil {
+		return "", nil, err
+	}
+
+	mentions, err := s.Posts.resolveMentions(p.Content)
+	if err != nil {
+		return "", nil, err
+	}
+
+	post := &domain.Post{
+		UserId:        im.user.Id,
+		Slug:          postSlug,
+		Title:         p.Title,
+		Content:       p.Content,
+		ContentFormat: format,
+		Language:      DefaultLanguage,
+		Status:        status,
+		Visibility:    visibility,
+		Mentions:      mentions,
+		Tags:          tags,
+		Flags:         flags,
+	}
+
+	createdAt := s.Posts.now()
+	if !p.CreatedAt.IsZero() {
+		createdAt = p.CreatedAt
+	}
+
+	publishedAt := p.PublishedAt
+	if status == domain.PostStatusPublished && publishedAt == nil {
+		publishedAt = &createdAt
+	}
+
+	im.posts = append(im.posts, domain.PostImport{Post: post, CreatedAt: createdAt, PublishedAt: publishedAt, PublishAt: p.PublishAt})
+	im.byHash[hash] = post
+	im.slugs[postSlug] = true
+	if p.Id != 0 {
+		im.bySource[p.Id] = post
+	}
+
+	return domain.ImportCreated, post, nil
+}
+
+// importSlug is uniqueSlug also avoiding the slugs of the posts imported
+// before
+func (s *ExportService) importSlug(im *importing, title string) (string, error) {
+	base := slug.Make(title)
+
+	for n := 1; ; n++ {
+		candidate := slug.WithSuffix(base, n)
+		if im.slugs[candidate] {
+			continue
+		}
+
+		owner, err := s.Posts.PostRepo.ReadSlugOwner(candidate)
+		if err != nil {
+			return "", err
+		}
+
+		if owner == 0 {
+			return candidate, nil
+		}
+	}
+}
+
+// importSeries checks the series and queues it for storing. A series of
+// posts that were all duplicates is skipped as a duplicate itself.
+func (s *ExportService) importSeries(im *importing, sr *export.Series) (domain.ImportResult, error) {
+	if sr.Title == "" {
+		return "", fmt.Errorf("title is required")
+	}
+
+	posts := make([]*domain.Post, 0, len(sr.PostIds))
+	duplicates := 0
+
+	for _, id := range sr.PostIds {
+		post, ok := im.bySource[id]
+		switch {
+		case im.failed[id]:
+			return "", fmt.Errorf("post %d failed to import", id)
+		case !ok:
+			return "", fmt.Errorf("post %d is not part of the import", id)
+		case slices.Contains(posts, post):
+			return "", fmt.Errorf("post %d is named twice", id)
+		}
+
+		for _, other := range im.series {
+			if slices.Contains(other.Posts, post) {
+				return "", fmt.Errorf("post %d: %w", id, ErrPostInSeries)
+			}
+		}
+
+		posts = append(posts, post)
+		if post.Id != 0 {
+			duplicates++
+		}
+	}
+
+	if len(posts) > 0 && duplicates == len(posts) {
+		return domain.ImportDuplicate, nil
+	}
+
+	// existing posts can join a new series only when they are in none yet
+	for i, post := range posts {
+		if post.Id == 0 {
+			continue
+		}
+
+		if _, err := s.SeriesRepo.ReadPostSeries(post.Id); err == nil {
+			return "", fmt.Errorf("post %d: %w", sr.PostIds[i], ErrPostInSeries)
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return "", err
+		}
+	}
+
+	im.series = append(im.series, domain.SeriesImport{
+		Series: &domain.Series{UserId: im.user.Id, Title: sr.Title, Description: sr.Description},
+		Posts:  posts,
+	})
+
+	return domain.ImportCreated, nil
+}
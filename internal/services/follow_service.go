@@ -0,0 +1,158 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"web/example/internal/domain"
+	"web/example/internal/repository"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ErrFollowSelf = errors.New("users can't follow or block themselves")
+	ErrBlocked    = errors.New("user is blocked")
+)
+
+// FollowService handles following and blocking other users
+type FollowService struct {
+	UserRepo      repository.UserRepositoryInterface
+	FollowRepo    repository.FollowRepositoryInterface
+	Notifications *NotificationService
+}
+
+// NewFollowService creates a new instance of FollowService with repositories,
+// followed users are notified through notifications
+func NewFollowService(db *sql.DB, notifications *NotificationService) *FollowService {
+	return &FollowService{
+		UserRepo:      repository.NewUserRepository(db),
+		FollowRepo:    repository.NewFollowRepository(db),
+		Notifications: notifications,
+	}
+}
+
+// readPair resolves the acting user and the target user, the mock identity
+// comes from the email like everywhere else
+func (s *FollowService) readPair(userEmail string, targetEmail string) (*domain.User, *domain.User, error) {
+	user, err := s.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	target, err := s.UserRepo.ReadUser(targetEmail)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if user.Id == target.Id {
+		return nil, nil, ErrFollowSelf
+	}
+
+	return user, target, nil
+}
+
+// Follow makes userEmail follow targetEmail and notifies them, not possible
+// when either blocked the other
+func (s *FollowService) Follow(userEmail string, targetEmail string) error {
+	user, target, err := s.readPair(userEmail, targetEmail)
+	if err != nil {
+		return err
+	}
+
+	blocked, err := s.FollowRepo.IsBlocked(user.Id, target.Id)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrBlocked
+	}
+
+	created, err := s.FollowRepo.Follow(user.Id, target.Id)
+	if err != nil || !created {
+		return err
+	}
+
+	// the follow happened, a lost notification is not worth failing it
+	if err := s.Notifications.Notify(target.Id, user.Id, domain.NotificationFollow, nil); err != nil {
+		zap.S().Warnf("Could not notify user %d of follow: %s", target.Id, err.Error())
+	}
+
+	return nil
+}
+
+func (s *FollowService) Unfollow(userEmail string, targetEmail string) error {
+	user, target, err := s.readPair(userEmail, targetEmail)
+	if err != nil {
+		return err
+	}
+
+	return s.FollowRepo.Unfollow(user.Id, target.Id)
+}
+
+// Block hides both users from each other's timeline and removes the follows between them
+func (s *FollowService) Block(userEmail string, targetEmail string) error {
+	user, target, err := s.readPair(userEmail, targetEmail)
+	if err != nil {
+		return err
+	}
+
+	return s.FollowRepo.Block(user.Id, target.Id)
+}
+
+func (s *FollowService) Unblock(userEmail string, targetEmail string) error {
+	user, target, err := s.readPair(userEmail, targetEmail)
+	if err != nil {
+		return err
+	}
+
+	return s.FollowRepo.Unblock(user.Id, target.Id)
+}
+
+// readFollows pages through a follow list, the cursor is the id of the last follow
+func (s *FollowService) readFollows(email string, cursor string, limit int,
+	read func(userId int, beforeId int, limit int) ([]domain.Follow, error)) (*domain.Page[domain.Follow], error) {
+	before, err := decodeCursor(cursor, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepo.ReadUser(email)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pageSize(limit)
+
+	follows, err := read(user.Id, int(before[0]), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.Page[domain.Follow]{Items: []domain.Follow{}}
+	page.Items = append(page.Items, follows...)
+
+	if len(follows) == limit {
+		page.NextCursor = encodeCursor(int64(follows[len(follows)-1].Id))
+	}
+
+	return page, nil
+}
+
+// ReadFollowers lists the users following email, newest follow first
+func (s *FollowService) ReadFollowers(email string, cursor string, limit int) (*domain.Page[domain.Follow], error) {
+	return s.readFollows(email, cursor, limit, s.FollowRepo.ReadFollowers)
+}
+
+// ReadFollowing lists the users email follows, newest follow first
+func (s *FollowService) ReadFollowing(email string, cursor string, limit int) (*domain.Page[domain.Follow], error) {
+	return s.readFollows(email, cursor, limit, s.FollowRepo.ReadFollowing)
+}
+
+func (s *FollowService) ReadFollowCounts(email string) (*domain.FollowCounts, error) {
+	user, err := s.UserRepo.ReadUser(email)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.FollowRepo.ReadFollowCounts(user.Id)
+}
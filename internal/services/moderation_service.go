@@ -0,0 +1,215 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+	"web/example/internal/domain"
+	"web/example/internal/filter"
+	"web/example/internal/repository"
+
+	"go.uber.org/zap"
+)
+
+// DefaultReportHideThreshold is how many users must report a post before it
+// is hidden pending review
+const DefaultReportHideThreshold = 3
+
+var (
+	ErrNotModerator     = errors.New("user is not a moderator")
+	ErrAlreadyReported  = errors.New("post was already reported by this user")
+	ErrReportOwnPost    = errors.New("users can't report their own posts")
+	ErrModerationAction = errors.New("action must be hide, restore or dismiss")
+)
+
+// ModerationService handles post reports and the moderation queue, post
+// access is checked through the PostService rules
+type ModerationService struct {
+	Posts          *PostService
+	ModerationRepo repository.ModerationRepositoryInterface
+	ClassifierRepo repository.ClassifierRepositoryInterface // Trained from decisions, nil doesn't train
+	HideThreshold  int                                      // Distinct open reports hiding a post, 0 never hides automatically
+}
+
+// NewModerationService creates a new instance of ModerationService with repositories
+func NewModerationService(db *sql.DB) *ModerationService {
+	return &ModerationService{
+		Posts:          NewPostService(db),
+		ModerationRepo: repository.NewModerationRepository(db),
+		ClassifierRepo: repository.NewClassifierRepository(db),
+		HideThreshold:  DefaultReportHideThreshold,
+	}
+}
+
+// ModerationDetail is everything a moderator needs to decide on a post
+type ModerationDetail struct {
+	Post    *domain.Post                `json:"post"`
+	Reports []domain.PostReport         `json:"reports"`
+	Flags   []domain.PostFlag           `json:"flags"`
+	Log     []domain.ModerationLogEntry `json:"log"`
+}
+
+// moderator resolves the email to a moderator
+func (s *ModerationService) moderator(email string) (*domain.User, error) {
+	user, err := s.Posts.UserRepo.ReadUser(email)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsModerator() {
+		return nil, ErrNotModerator
+	}
+
+	return user, nil
+}
+
+// ReportPost flags a post the reporter can see, once per reporter. Reaching
+// HideThreshold open reports hides the post until a moderator reviews it.
+func (s *ModerationService) ReportPost(postId int, reporterEmail string, reason domain.ReportReason, details *string) error {
+	reporter, err := s.Posts.UserRepo.ReadUser(reporterEmail)
+	if err != nil {
+		return err
+	}
+
+	post, err := s.Posts.readVisible(postId, reporterEmail)
+	if err != nil {
+		return err
+	}
+
+	if post.UserId == reporter.Id {
+		return ErrReportOwnPost
+	}
+
+	created, err := s.ModerationRepo.CreateReport(&domain.PostReport{
+		PostId:     post.Id,
+		ReporterId: reporter.Id,
+		Reason:     reason,
+		Details:    details,
+	})
+	if err != nil {
+		return err
+	}
+	if !created {
+		return ErrAlreadyReported
+	}
+
+	if s.HideThreshold <= 0 || post.HiddenAt != nil {
+		return nil
+	}
+
+	count, err := s.ModerationRepo.CountOpenReports(post.Id)
+	if err != nil {
+		return err
+	}
+
+	if count >= s.HideThreshold {
+		zap.S().Infof("Hiding post %d after %d reports", post.Id, count)
+		return s.ModerationRepo.ApplyModeration(post.Id, nil, domain.ModerationAutoHide, nil)
+	}
+
+	return nil
+}
+
+// ReadQueue pages through the posts with open reports or filter flags, most
+// recent activity first
+func (s *ModerationService) ReadQueue(moderatorEmail string, queueFilter repository.QueueFilter, cursor string, limit int) (*domain.Page[domain.ModerationQueueItem], error) {
+	before, err := decodeCursor(cursor, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.moderator(moderatorEmail); err != nil {
+		return nil, err
+	}
+
+	limit = pageSize(limit)
+
+	items, err := s.ModerationRepo.ReadQueue(queueFilter, time.Unix(before[0], 0), int(before[1]), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.Page[domain.ModerationQueueItem]{Items: []domain.ModerationQueueItem{}}
+	page.Items = append(page.Items, items...)
+
+	if len(items) == limit {
+		last := items[len(items)-1]
+
+		activity, err := time.Parse(time.RFC3339, last.LastActivityAt)
+		if err != nil {
+			return nil, err
+		}
+
+		page.NextCursor = encodeCursor(activity.Unix(), int64(last.PostId))
+	}
+
+	return page, nil
+}
+
+// ReadModerationDetail returns the post with its reports and moderation log
+func (s *ModerationService) ReadModerationDetail(postId int, moderatorEmail string) (*ModerationDetail, error) {
+	if _, err := s.moderator(moderatorEmail); err != nil {
+		return nil, err
+	}
+
+	post, err := s.Posts.ReadPost(postId, moderatorEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := s.ModerationRepo.ReadReports(post.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	flags, err := s.ModerationRepo.ReadFlags(post.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	log, err := s.ModerationRepo.ReadModerationLog(post.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ModerationDetail{
+		Post:    post,
+		Reports: append([]domain.PostReport{}, reports...),
+		Flags:   append([]domain.PostFlag{}, flags...),
+		Log:     append([]domain.ModerationLogEntry{}, log...),
+	}, nil
+}
+
+// Moderate hides, restores or dismisses the reports of a post, the action
+// is recorded with the moderator and note. The decision trains the spam
+// classifier: hidden posts are spam, restored and dismissed ones are not.
+func (s *ModerationService) Moderate(postId int, moderatorEmail string, action domain.ModerationAction, note *string) error {
+	switch action {
+	case domain.ModerationHide, domain.ModerationRestore, domain.ModerationDismiss:
+	default:
+		return ErrModerationAction
+	}
+
+	moderator, err := s.moderator(moderatorEmail)
+	if err != nil {
+		return err
+	}
+
+	post, err := s.Posts.PostRepo.ReadPost(postId)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ModerationRepo.ApplyModeration(post.Id, &moderator.Id, action, note); err != nil {
+		return err
+	}
+
+	if s.ClassifierRepo != nil {
+		tokens := filter.Tokenize(post.Title + "\n" + post.Content)
+		if err := s.ClassifierRepo.Train(post.Id, action == domain.ModerationHide, tokens); err != nil {
+			zap.S().Warnf("Could not train the classifier on post %d: %s", post.Id, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"database/sql"
+	"web/example/internal/domain"
+	"web/example/internal/notify"
+	"web/example/internal/repository"
+)
+
+// NotificationService stores notifications and pushes them to the live
+// streams of their users
+type NotificationService struct {
+	UserRepo         repository.UserRepositoryInterface
+	NotificationRepo repository.NotificationRepositoryInterface
+	FollowRepo       repository.FollowRepositoryInterface
+	Hub              *notify.Hub
+}
+
+// NewNotificationService creates a new instance of NotificationService, hub
+// is shared by every service writing notifications
+func NewNotificationService(db *sql.DB, hub *notify.Hub) *NotificationService {
+	return &NotificationService{
+		UserRepo:         repository.NewUserRepository(db),
+		NotificationRepo: repository.NewNotificationRepository(db),
+		FollowRepo:       repository.NewFollowRepository(db),
+		Hub:              hub,
+	}
+}
+
+// Notify tells userId that actorId did something, postId is the post it
+// happened on if any. Users are not notified of their own actions nor of
+// users blocked either way. A nil service notifies no one.
+func (s *NotificationService) Notify(userId int, actorId int, kind domain.NotificationType, postId *int) error {
+	if s == nil || userId == actorId {
+		return nil
+	}
+
+	blocked, err := s.FollowRepo.IsBlocked(userId, actorId)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return nil
+	}
+
+	n := &domain.Notification{UserId: userId, ActorId: actorId, Type: kind, PostId: postId}
+	if err := s.NotificationRepo.CreateNotification(n); err != nil {
+		return err
+	}
+
+	// re-read for the actor's username and the stored timestamps
+	if n, err = s.NotificationRepo.ReadNotification(n.Id); err != nil {
+		return err
+	}
+
+	if s.Hub != nil {
+		s.Hub.Publish(*n)
+	}
+
+	return nil
+}
+
+// NotificationPage is a page of notifications with the user's unread count
+type NotificationPage struct {
+	domain.Page[domain.Notification]
+	Unread int `json:"unread"`
+}
+
+// ReadNotifications pages through the user's notifications newest first,
+// only unread ones when unreadOnly is set
+func (s *NotificationService) ReadNotifications(userEmail string, unreadOnly bool, cursor string, limit int) (*NotificationPage, error) {
+	before, err := decodeCursor(cursor, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pageSize(limit)
+
+	notifications, err := s.NotificationRepo.ReadNotifications(user.Id, unreadOnly, int(before[0]), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := s.NotificationRepo.CountUnread(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &NotificationPage{Unread: unread}
+	page.Items = append([]domain.Notification{}, notifications...)
+
+	if len(notifications) == limit {
+		page.NextCursor = encodeCursor(int64(notifications[len(notifications)-1].Id))
+	}
+
+	return page, nil
+}
+
+func (s *NotificationService) MarkRead(userEmail string, id int) error {
+	user, err := s.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return err
+	}
+
+	return s.NotificationRepo.MarkRead(user.Id, id)
+}
+
+func (s *NotificationService) MarkAllRead(userEmail string) error {
+	user, err := s.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return err
+	}
+
+	return s.NotificationRepo.MarkAllRead(user.Id)
+}
+
+// Subscribe opens a live stream of the user's notifications. Notifications
+// newer than lastId (the last one the client saw, 0 for none) that were
+// missed while disconnected are returned to be sent first, at most
+// MaxPageSize of them. Callers must Unsubscribe from the hub when done.
+func (s *NotificationService) Subscribe(userEmail string, lastId int) (*notify.Subscription, []domain.Notification, error) {
+	user, err := s.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// subscribe before catching up so nothing created in between is lost,
+	// the stream skips what it already sent
+	sub := s.Hub.Subscribe(user.Id)
+
+	if lastId == 0 {
+		return sub, nil, nil
+	}
+
+	missed, err := s.NotificationRepo.ReadNotificationsAfter(user.Id, lastId, MaxPageSize)
+	if err != nil {
+		s.Hub.Unsubscribe(sub)
+		return nil, nil, err
+	}
+
+	return sub, missed, nil
+}
+
+func (s *NotificationService) Unsubscribe(sub *notify.Subscription) {
+	s.Hub.Unsubscribe(sub)
+}
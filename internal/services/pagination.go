@@ -0,0 +1,62 @@
+package services
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Cursor paginated lists return DefaultPageSize items, clients can ask for
+// at most MaxPageSize
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 50
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// pageSize clamps the requested page size
+func pageSize(limit int) int {
+	if limit <= 0 {
+		return DefaultPageSize
+	}
+	return min(limit, MaxPageSize)
+}
+
+// encodeCursor packs the sort key of the last item of a page into an opaque token
+func encodeCursor(parts ...int64) string {
+	fields := make([]string, len(parts))
+	for i, p := range parts {
+		fields[i] = strconv.FormatInt(p, 10)
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(fields, ".")))
+}
+
+// decodeCursor unpacks a token made by encodeCursor, an empty cursor is the
+// first page and decodes to zeros
+func decodeCursor(cursor string, n int) ([]int64, error) {
+	parts := make([]int64, n)
+	if cursor == "" {
+		return parts, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	fields := strings.Split(string(raw), ".")
+	if len(fields) != n {
+		return nil, ErrInvalidCursor
+	}
+
+	for i, f := range fields {
+		if parts[i], err = strconv.ParseInt(f, 10, 64); err != nil || parts[i] <= 0 {
+			return nil, ErrInvalidCursor
+		}
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,363 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository"
+	"web/example/internal/signedurl"
+	"web/example/internal/storage"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultDataExportTTL is how long a built export can be downloaded
+	DefaultDataExportTTL = 24 * time.Hour
+	// DefaultDataExportInterval is how often pending exports are built
+	DefaultDataExportInterval = 5 * time.Second
+
+	dataExportBatch = 5
+)
+
+var (
+	// ErrDataExportGone is returned for a download of an export that was
+	// downloaded before or has expired
+	ErrDataExportGone = errors.New("data export was already downloaded or has expired")
+	// ErrWrongPassword is returned when an erasure is not confirmed by the password
+	ErrWrongPassword = errors.New("wrong password")
+)
+
+// PersonalDataService builds personal data exports in the background and
+// erases accounts by the erasure policy (see repository/erasure.go)
+type PersonalDataService struct {
+	UserRepo             repository.UserRepositoryInterface
+	DataExportRepo       repository.DataExportRepositoryInterface
+	Store                storage.BlobStore
+	Signer               *signedurl.Signer
+	Clock                Clock
+	TTL                  time.Duration
+	Interval             time.Duration
+	AccountDeletionGrace time.Duration // Only to tell when an erasure happens, the purger does it
+}
+
+// NewPersonalDataService creates a new instance of PersonalDataService with
+// repositories and the default timings
+func NewPersonalDataService(db *sql.DB, store storage.BlobStore, signer *signedurl.Signer) *PersonalDataService {
+	return &PersonalDataService{
+		UserRepo:             repository.NewUserRepository(db),
+		DataExportRepo:       repository.NewDataExportRepository(db),
+		Store:                store,
+		Signer:               signer,
+		Clock:                systemClock{},
+		TTL:                  DefaultDataExportTTL,
+		Interval:             DefaultDataExportInterval,
+		AccountDeletionGrace: DefaultAccountDeletionGrace,
+	}
+}
+
+// dataExportPath is the download route of an export, the part of the URL
+// covered by the signature
+func dataExportPath(id int) string {
+	return "/data-exports/" + strconv.Itoa(id) + "/download"
+}
+
+// withURL fills in the signed download URL of a ready export, it expires
+// with the archive
+func (s *PersonalDataService) withURL(export *domain.DataExport) {
+	if export.Status != domain.DataExportReady {
+		return
+	}
+
+	if expires := parseTime(export.ExpiresAt); expires != nil {
+		export.DownloadURL = s.Signer.Sign(dataExportPath(export.Id), *expires)
+	}
+}
+
+// RequestExport queues an export of the user's data, while one is pending
+// that one is returned instead
+func (s *PersonalDataService) RequestExport(userEmail string) (*domain.DataExport, error) {
+	user, err := s.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	exports, err := s.DataExportRepo.ReadUserDataExports(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(exports) > 0 && exports[0].Status == domain.DataExportPending {
+		return &exports[0], nil
+	}
+
+	export := &domain.DataExport{UserId: user.Id}
+	if err := s.DataExportRepo.CreateDataExport(export); err != nil {
+		return nil, err
+	}
+
+	return s.DataExportRepo.ReadDataExport(export.Id)
+}
+
+// ReadExports lists the user's exports newest first, ready ones with their
+// download URL
+func (s *PersonalDataService) ReadExports(userEmail string) ([]domain.DataExport, error) {
+	user, err := s.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	exports, err := s.DataExportRepo.ReadUserDataExports(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range exports {
+		s.withURL(&exports[i])
+	}
+
+	return exports, nil
+}
+
+// ReadExport reads an export of the user, exports of other users are not
+// found
+func (s *PersonalDataService) ReadExport(id int, userEmail string) (*domain.DataExport, error) {
+	user, err := s.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := s.DataExportRepo.ReadDataExport(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if export.UserId != user.Id {
+		return nil, sql.ErrNoRows
+	}
+
+	s.withURL(export)
+	return export, nil
+}
+
+// Download opens the archive of a signed download URL and marks it
+// downloaded, a second download is ErrDataExportGone
+func (s *PersonalDataService) Download(ctx context.Context, id int, expires string, sig string) (io.ReadSeekCloser, error) {
+	if err := s.Signer.Verify(dataExportPath(id), expires, sig, s.Clock.Now()); err != nil {
+		return nil, err
+	}
+
+	export, err := s.DataExportRepo.ReadDataExport(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if export.StorageKey == nil {
+		return nil, ErrDataExportGone
+	}
+
+	// opened before claiming, so a store failure doesn't use up the download
+	content, err := s.Store.Open(ctx, *export.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := s.DataExportRepo.ClaimDataExportDownload(id, s.Clock.Now())
+	if err != nil || !claimed {
+		content.Close()
+		if err == nil {
+			err = ErrDataExportGone
+		}
+		return nil, err
+	}
+
+	return content, nil
+}
+
+// Start builds pending exports and deletes stale archives in the background
+// until ctx is cancelled
+func (s *PersonalDataService) Start(ctx context.Context) {
+	go runPeriodically(ctx, s.Interval, 0, s.runLogged)
+}
+
+func (s *PersonalDataService) runLogged() {
+	built, deleted, err := s.RunOnce()
+	if err != nil {
+		zap.S().Errorln("Data export run failed: ", err.Error())
+		return
+	}
+	if built > 0 || deleted > 0 {
+		zap.S().Infof("Built %d data exports and deleted %d archives", built, deleted)
+	}
+}
+
+// RunOnce builds a batch of pending exports and deletes the archives of
+// exports downloaded or expired. An export failing to build is marked
+// failed, the user can request another one.
+func (s *PersonalDataService) RunOnce() (built int, deleted int, err error) {
+	pending, err := s.DataExportRepo.ReadPendingDataExports(dataExportBatch)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, export := range pending {
+		if err := s.build(&export); err != nil {
+			zap.S().Warnf("Data export %d failed: %s", export.Id, err.Error())
+			if err := s.DataExportRepo.MarkDataExportFailed(export.Id, err.Error()); err != nil {
+				return built, 0, err
+			}
+			continue
+		}
+		built++
+	}
+
+	stale, err := s.DataExportRepo.ReadStaleDataExports(s.Clock.Now())
+	if err != nil {
+		return built, 0, err
+	}
+
+	for _, export := range stale {
+		ctx, cancel := context.WithTimeout(context.Background(), blobTimeout)
+		err := s.Store.Delete(ctx, *export.StorageKey)
+		cancel()
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return built, deleted, err
+		}
+
+		if err := s.DataExportRepo.ClearDataExportBlob(export.Id); err != nil {
+			return built, deleted, err
+		}
+		deleted++
+	}
+
+	return built, deleted, nil
+}
+
+// build writes the archive of the export: a JSON file per section of the
+// user's data and the files of their attachments
+func (s *PersonalDataService) build(export *domain.DataExport) error {
+	sections, err := s.DataExportRepo.ReadPersonalData(export.UserId)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), blobTimeout)
+	defer cancel()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	for _, section := range sections {
+		f, err := zw.CreateHeader(&zip.FileHeader{Name: section.Name + ".json", Method: zip.Deflate, Modified: s.Clock.Now()})
+		if err != nil {
+			return err
+		}
+
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(section.Rows); err != nil {
+			return err
+		}
+
+		if section.Name != "attachments" {
+			continue
+		}
+
+		for _, row := range section.Rows {
+			if err := s.addAttachment(ctx, zw, row); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+	key := "data-exports/" + strconv.Itoa(export.Id) + "-" + hex.EncodeToString(b) + ".zip"
+
+	if err := s.Store.Put(ctx, key, buf.Bytes(), "application/zip"); err != nil {
+		return err
+	}
+
+	marked, err := s.DataExportRepo.MarkDataExportReady(export.Id, key, int64(buf.Len()), s.Clock.Now().Add(s.TTL))
+	if err != nil || marked {
+		return err
+	}
+
+	// nobody can download it anymore
+	return s.Store.Delete(ctx, key)
+}
+
+// addAttachment copies the file of an attachments row into the archive,
+// files missing from the store are skipped
+func (s *PersonalDataService) addAttachment(ctx context.Context, zw *zip.Writer, row map[string]any) error {
+	key, _ := row["storage_key"].(string)
+	filename, _ := row["filename"].(string)
+
+	content, err := s.Store.Open(ctx, key)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	f, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     fmt.Sprintf("attachments/%v-%s", row["id"], cleanFilename(filename)),
+		Method:   zip.Store, // already compressed for the most part
+		Modified: s.Clock.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, content)
+	return err
+}
+
+// EraseAccount checks the password and starts the deletion grace period,
+// after which the account is erased by the erasure policy. It returns what
+// the erasure will do, a dry run only returns that.
+func (s *PersonalDataService) EraseAccount(req *handlermodel.EraseAccountRequest) (*domain.ErasurePlan, error) {
+	user, err := s.UserRepo.ReadUser(req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkPassword(user, req.Password); err != nil {
+		return nil, ErrWrongPassword
+	}
+
+	steps, err := s.UserRepo.ReadErasurePlan(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &domain.ErasurePlan{Steps: steps}
+	if req.DryRun {
+		return plan, nil
+	}
+
+	if err := s.UserRepo.DeleteUser(user.Email); err != nil {
+		return nil, err
+	}
+
+	plan.EraseAt = s.Clock.Now().Add(s.AccountDeletionGrace).UTC().Format(time.RFC3339)
+	return plan, nil
+}
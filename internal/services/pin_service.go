@@ -0,0 +1,155 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository"
+)
+
+// Authors can pin DefaultPinLimit posts to their profile, admins can feature
+// DefaultFeatureLimit posts on the global listing
+const (
+	DefaultPinLimit     = 3
+	DefaultFeatureLimit = 5
+)
+
+var (
+	ErrNotAdmin     = errors.New("user is not an admin")
+	ErrNotListed    = errors.New("only published posts listed for everyone can be pinned or featured")
+	ErrPinLimit     = errors.New("too many pinned posts, unpin one first")
+	ErrFeatureLimit = errors.New("too many featured posts, unfeature one first")
+)
+
+// PinService pins posts to their owner's profile and features posts on the
+// global listing, the listings are read through the PostService
+type PinService struct {
+	Posts        *PostService
+	PinRepo      repository.PinRepositoryInterface
+	PinLimit     int
+	FeatureLimit int
+}
+
+// NewPinService creates a new instance of PinService with repositories and
+// the default limits
+func NewPinService(db *sql.DB) *PinService {
+	return &PinService{
+		Posts:        NewPostService(db),
+		PinRepo:      repository.NewPinRepository(db),
+		PinLimit:     DefaultPinLimit,
+		FeatureLimit: DefaultFeatureLimit,
+	}
+}
+
+// listedPost reads a post everyone sees in listings, anything else can't be
+// pinned or featured
+func (s *PinService) listedPost(postId int) (*domain.Post, error) {
+	post, err := s.Posts.PostRepo.ReadPost(postId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isListed(post, viewer{}) {
+		return nil, ErrNotListed
+	}
+
+	return post, nil
+}
+
+// Pin pins an owned post to the owner's profile at req.Position, the end
+// when unset. Pinning a pinned post moves it.
+func (s *PinService) Pin(postId int, req *handlermodel.PinPostRequest) error {
+	post, err := s.Posts.verifyAuthor(postId, req.UserEmail, domain.AuthorOwner)
+	if err != nil {
+		return err
+	}
+
+	if !isListed(post, viewer{}) {
+		return ErrNotListed
+	}
+
+	pinned, err := s.PinRepo.PinPost(post.UserId, post.Id, req.Position, s.PinLimit)
+	if err != nil {
+		return err
+	}
+	if !pinned {
+		return fmt.Errorf("%w (%d)", ErrPinLimit, s.PinLimit)
+	}
+
+	return nil
+}
+
+// Unpin takes an owned post off the owner's profile
+func (s *PinService) Unpin(postId int, req *handlermodel.UnpinPostRequest) error {
+	post, err := s.Posts.verifyAuthor(postId, req.UserEmail, domain.AuthorOwner)
+	if err != nil {
+		return err
+	}
+
+	unpinned, err := s.PinRepo.UnpinPost(post.UserId, post.Id)
+	if err != nil {
+		return err
+	}
+	if !unpinned {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// admin resolves the email to an admin
+func (s *PinService) admin(email string) (*domain.User, error) {
+	user, err := s.Posts.UserRepo.ReadUser(email)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsAdmin() {
+		return nil, ErrNotAdmin
+	}
+
+	return user, nil
+}
+
+// Feature features a post on the global listing at req.Position, the end
+// when unset, for admins. Featuring a featured post moves it.
+func (s *PinService) Feature(postId int, req *handlermodel.FeaturePostRequest) error {
+	admin, err := s.admin(req.UserEmail)
+	if err != nil {
+		return err
+	}
+
+	post, err := s.listedPost(postId)
+	if err != nil {
+		return err
+	}
+
+	featured, err := s.PinRepo.FeaturePost(post.Id, admin.Id, req.Position, s.FeatureLimit)
+	if err != nil {
+		return err
+	}
+	if !featured {
+		return fmt.Errorf("%w (%d)", ErrFeatureLimit, s.FeatureLimit)
+	}
+
+	return nil
+}
+
+// Unfeature takes a post off the global listing's featured posts, for admins
+func (s *PinService) Unfeature(postId int, req *handlermodel.UnfeaturePostRequest) error {
+	if _, err := s.admin(req.UserEmail); err != nil {
+		return err
+	}
+
+	unfeatured, err := s.PinRepo.UnfeaturePost(postId)
+	if err != nil {
+		return err
+	}
+	if !unfeatured {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"web/example/internal/repository"
+
+	"go.uber.org/zap"
+)
+
+const (
+	schedulerInterval = 30 * time.Second
+	schedulerJitter   = 5 * time.Second
+)
+
+// PostScheduler publishes drafts once their publish_at is due.
+// All state lives in the posts table so a restart just picks up where it left
+// off, and publishing is a single conditional UPDATE so overlapping runs are harmless.
+type PostScheduler struct {
+	PostRepo repository.PostRepositoryInterface
+	Posts    *PostService // Mentions in the published posts are notified, nil notifies no one
+	Clock    Clock
+	Interval time.Duration
+	Jitter   time.Duration
+}
+
+// NewPostScheduler creates a new instance of PostScheduler with repositories
+func NewPostScheduler(db *sql.DB) *PostScheduler {
+	return &PostScheduler{
+		PostRepo: repository.NewPostRepository(db),
+		Clock:    systemClock{},
+		Interval: schedulerInterval,
+		Jitter:   schedulerJitter,
+	}
+}
+
+// Start runs the scheduler in the background until ctx is cancelled.
+// It catches up right away with posts that became due while the process was down.
+func (s *PostScheduler) Start(ctx context.Context) {
+	go runPeriodically(ctx, s.Interval, s.Jitter, s.runLogged)
+}
+
+func (s *PostScheduler) runLogged() {
+	n, err := s.RunOnce()
+	if err != nil {
+		zap.S().Errorln("Scheduled publishing failed: ", err.Error())
+		return
+	}
+	if n > 0 {
+		zap.S().Infof("Published %d scheduled posts", n)
+	}
+}
+
+// RunOnce publishes every post due at the clock's current time
+func (s *PostScheduler) RunOnce() (int64, error) {
+	ids, err := s.PostRepo.PublishDuePosts(s.Clock.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	if s.Posts != nil {
+		for _, id := range ids {
+			post, err := s.PostRepo.ReadPost(id)
+			if err != nil {
+				zap.S().Warnf("Could not notify mentions in scheduled post %d: %s", id, err.Error())
+				continue
+			}
+			s.Posts.notifyMentions(post, nil, post.Mentions)
+		}
+	}
+
+	return int64(len(ids)), nil
+}
@@ -2,27 +2,86 @@ package services
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
+	"time"
+	"web/example/internal/diff"
 	"web/example/internal/domain"
+	"web/example/internal/filter"
 	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/mention"
+	"web/example/internal/render"
 	"web/example/internal/repository"
+	"web/example/internal/slug"
+	"web/example/internal/views"
+
+	"go.uber.org/zap"
+	"golang.org/x/text/language"
 )
 
 // PostService handles all business logic for posts
 type PostService struct {
-	PostRepo repository.PostRepositoryInterface
-	UserRepo repository.UserRepositoryInterface
+	PostRepo        repository.PostRepositoryInterface
+	UserRepo        repository.UserRepositoryInterface
+	RevisionRepo    repository.PostRevisionRepositoryInterface
+	FollowRepo      repository.FollowRepositoryInterface // Followers-only posts are visible to no one but the owner when nil
+	Clock           Clock
+	Notifications   *NotificationService                          // Mentioned users are notified, nil notifies no one
+	Filters         filter.Chain                                  // Run on new content, nil allows everything
+	RequireVersion  bool                                          // Updates and deletes must name the version they apply to
+	SeriesRepo      repository.SeriesRepositoryInterface          // Single reads place the post in its series, nil leaves it out
+	Views           *views.Counter                                // Counts reads of published posts, nil counts nothing
+	TranslationRepo repository.PostTranslationRepositoryInterface // Reads pick a translation by language, nil always shows the original
 }
 
+var (
+	// ErrContentRejected is returned when a content filter rejects a post
+	ErrContentRejected = errors.New("content rejected")
+	// ErrPreconditionFailed is returned when a write names a version the post is no longer at
+	ErrPreconditionFailed = errors.New("post was changed since it was read")
+	// ErrPreconditionRequired is returned when RequireVersion is set and a write names no version
+	ErrPreconditionRequired = errors.New("the version of the post to change is required")
+	// ErrOriginalLanguage is returned when a translation names the language of the original
+	ErrOriginalLanguage = errors.New("the original of the post is in this language, update the post instead")
+)
+
+// AnyVersion as the version of a write applies it to whatever version the
+// post is at
+const AnyVersion = 0
+
+// DefaultLanguage is the language of posts created without one
+const DefaultLanguage = "en"
+
 // NewPostService creates a new instance of PostService with repositories
 func NewPostService(db *sql.DB) *PostService {
 	return &PostService{
-		PostRepo: repository.NewPostRepository(db),
-		UserRepo: repository.NewUserRepository(db),
+		PostRepo:        repository.NewPostRepository(db),
+		UserRepo:        repository.NewUserRepository(db),
+		RevisionRepo:    repository.NewPostRevisionRepository(db),
+		FollowRepo:      repository.NewFollowRepository(db),
+		SeriesRepo:      repository.NewSeriesRepository(db),
+		TranslationRepo: repository.NewPostTranslationRepository(db),
+		Clock:           systemClock{},
 	}
 }
 
-func (s *PostService) verifyUserOwnership(postId int, userEmail string) (*domain.Post, error) {
+// NewContentFilters builds the filter chain of cfg on the posts and the
+// classifier training in db
+func NewContentFilters(db *sql.DB, cfg filter.Config) (filter.Chain, error) {
+	return cfg.Build(repository.NewPostRepository(db).ReadRecentPosts, repository.NewClassifierRepository(db))
+}
+
+func (s *PostService) now() time.Time {
+	if s.Clock == nil {
+		return time.Now()
+	}
+	return s.Clock.Now()
+}
+
+// verifyAuthor reads the post for one of its authors whose role allows what
+// role may do, see domain.AuthorRole
+func (s *PostService) verifyAuthor(postId int, userEmail string, role domain.AuthorRole) (*domain.Post, error) {
 	// the following is a mock check, since we do not have claims on the token
 	// we get the id from the user based on it's email
 	// the correct approach would be to get the id from the validated claims from the JWT/Paseto token
@@ -37,13 +96,285 @@ func (s *PostService) verifyUserOwnership(postId int, userEmail string) (*domain
 		return nil, err
 	}
 
-	if user.Id != post.UserId {
-		return nil, fmt.Errorf("user does not own this post")
+	if !post.AuthorRole(user.Id).Allows(role) {
+		if role == domain.AuthorOwner {
+			return nil, fmt.Errorf("user does not own this post")
+		}
+		return nil, fmt.Errorf("user is not an author of this post")
 	}
 
 	return post, nil
 }
 
+// viewer is who reads posts, the zero value is an anonymous viewer
+type viewer struct {
+	Id        int
+	Moderator bool
+	follows   func(authorId int) bool // nil follows no one
+}
+
+// viewer resolves the optional viewer email, anonymous viewers (no email or
+// unknown email) get id 0 which matches no post owner
+func (s *PostService) viewer(viewerEmail string) viewer {
+	if viewerEmail == "" {
+		return viewer{}
+	}
+
+	user, err := s.UserRepo.ReadUser(viewerEmail)
+	if err != nil {
+		return viewer{}
+	}
+
+	return s.viewerOf(user)
+}
+
+// viewerOf is the viewer for a known user, who they follow is looked up once
+// per author and only when a followers-only post needs it
+func (s *PostService) viewerOf(user *domain.User) viewer {
+	v := viewer{Id: user.Id, Moderator: user.IsModerator()}
+	if s.FollowRepo == nil {
+		return v
+	}
+
+	following := map[int]bool{}
+	v.follows = func(authorId int) bool {
+		if f, ok := following[authorId]; ok {
+			return f
+		}
+
+		f, err := s.FollowRepo.IsFollowing(user.Id, authorId)
+		if err != nil {
+			zap.S().Warnf("Could not check if user %d follows %d: %s", user.Id, authorId, err.Error())
+			return false
+		}
+
+		following[authorId] = f
+		return f
+	}
+
+	return v
+}
+
+// canView reports whether the viewer may read the post by id or slug. Every
+// read goes through it: drafts are only for the owner, hidden posts for the
+// owner and moderators reviewing them, followers-only posts for followers and
+// private posts for the owner.
+func canView(post *domain.Post, v viewer) bool {
+	switch {
+	case post.AuthorRole(v.Id) != "":
+		return true
+	case post.Status == domain.PostStatusDraft:
+		return false
+	case post.HiddenAt != nil:
+		return v.Moderator
+	}
+
+	switch post.Visibility {
+	case domain.VisibilityPrivate:
+		return false
+	case domain.VisibilityFollowers:
+		return v.follows != nil && v.follows(post.UserId)
+	}
+	return true
+}
+
+// isListed reports whether the post shows up in listings for the viewer,
+// only published posts the viewer can see are listed for everyone but the
+// owner and unlisted posts never are
+func isListed(post *domain.Post, v viewer) bool {
+	return post.AuthorRole(v.Id) != "" ||
+		post.Status == domain.PostStatusPublished && post.Visibility != domain.VisibilityUnlisted && canView(post, v)
+}
+
+// listed keeps the posts listed for the viewer, with their rendered content
+func (s *PostService) listed(posts []domain.Post, v viewer) ([]domain.Post, error) {
+	visible := []domain.Post{}
+
+	for i := range posts {
+		if !isListed(&posts[i], v) {
+			continue
+		}
+
+		if err := s.withHTML(&posts[i]); err != nil {
+			return nil, err
+		}
+
+		visible = append(visible, posts[i])
+	}
+
+	return visible, nil
+}
+
+// uniqueSlug builds the slug for title, adding a numeric suffix when the
+// slug is (or was) used by another post. postId is 0 for new posts.
+func (s *PostService) uniqueSlug(title string, postId int) (string, error) {
+	base := slug.Make(title)
+
+	for n := 1; ; n++ {
+		candidate := slug.WithSuffix(base, n)
+
+		owner, err := s.PostRepo.ReadSlugOwner(candidate)
+		if err != nil {
+			return "", err
+		}
+
+		if owner == 0 || owner == postId {
+			return candidate, nil
+		}
+	}
+}
+
+// checkContent runs the content filters, rejected content is an error and
+// flagged content comes back as the flags to store with it. A failing filter
+// is logged and skipped so it can't block posting.
+func (s *PostService) checkContent(c *filter.Content) ([]domain.PostFlag, error) {
+	decision, err := s.Filters.Run(c)
+	if err != nil {
+		zap.S().Warnf("Content filter failed for user %d: %s", c.UserId, err.Error())
+	}
+
+	switch decision.Verdict {
+	case filter.Reject:
+		return nil, fmt.Errorf("%w: %s", ErrContentRejected, decision.Reason())
+	case filter.Flag:
+		flags := make([]domain.PostFlag, len(decision.Results))
+		for i, r := range decision.Results {
+			flags[i] = domain.PostFlag{Filter: r.Filter, Reason: r.Reason}
+		}
+		return flags, nil
+	}
+
+	return nil, nil
+}
+
+// checkVersion tells which version a write to post applies to. version is
+// the one the client named, nil when it named none.
+func (s *PostService) checkVersion(post *domain.Post, version *int) (int, error) {
+	switch {
+	case version == nil && s.RequireVersion:
+		return 0, ErrPreconditionRequired
+	case version != nil && *version != AnyVersion && *version != post.Version:
+		return 0, ErrPreconditionFailed
+	}
+
+	// the version read is compared again on write, catching concurrent writes
+	return post.Version, nil
+}
+
+// updateContent writes the update if the post is still at version. The slug
+// follows a title change that affects it (the old slug keeps redirecting) and
+// the mentions follow the content, all in the same compare-and-swap write.
+// Flagged content hides the post pending review as it is written.
+func (s *PostService) updateContent(post *domain.Post, version int, update *domain.PostUpdate) error {
+	mentions, err := s.resolveMentions(update.Content)
+	if err != nil {
+		return err
+	}
+	update.Mentions = mentions
+
+	if slug.Make(update.Title) != slug.Make(post.Title) {
+		if update.Slug, err = s.uniqueSlug(update.Title, post.Id); err != nil {
+			return err
+		}
+	}
+
+	updated, err := s.PostRepo.UpdatePost(post.Id, version, update)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return ErrPreconditionFailed
+	}
+
+	if update.Visibility != "" {
+		post.Visibility = update.Visibility
+	}
+	if len(update.Flags) == 0 {
+		s.notifyMentions(post, post.Mentions, mentions)
+	}
+
+	return nil
+}
+
+// resolveMentions finds the @usernames in content naming exactly one user,
+// unknown usernames and usernames shared by several users are ignored
+func (s *PostService) resolveMentions(content string) ([]domain.Mention, error) {
+	tokens := mention.Parse(content)
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	users, err := s.UserRepo.ReadUsersByUsernames(mention.Usernames(tokens))
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string][]domain.User{}
+	for _, u := range users {
+		byName[u.Username] = append(byName[u.Username], u)
+	}
+
+	var mentions []domain.Mention
+	for _, t := range tokens {
+		if matches := byName[t.Username]; len(matches) == 1 {
+			mentions = append(mentions, domain.Mention{UserId: matches[0].Id, Username: t.Username, Start: t.Start, End: t.End})
+		}
+	}
+
+	return mentions, nil
+}
+
+// notifyMentions notifies the users newly mentioned in a published post,
+// users already mentioned before the edit or who can't read the post are
+// not notified. Hidden posts notify no one.
+func (s *PostService) notifyMentions(post *domain.Post, before []domain.Mention, after []domain.Mention) {
+	if post.Status != domain.PostStatusPublished || post.HiddenAt != nil {
+		return
+	}
+
+	notified := map[int]bool{}
+	for _, m := range before {
+		notified[m.UserId] = true
+	}
+
+	for _, m := range after {
+		if notified[m.UserId] {
+			continue
+		}
+		notified[m.UserId] = true
+
+		// users who can't read the post are not told about it
+		if !canView(post, s.viewerOf(&domain.User{Id: m.UserId})) {
+			continue
+		}
+
+		if err := s.Notifications.Notify(m.UserId, post.UserId, domain.NotificationMention, &post.Id); err != nil {
+			zap.S().Warnf("Could not notify user %d of mention in post %d: %s", m.UserId, post.Id, err.Error())
+		}
+	}
+}
+
+// BackfillSlugs gives a slug to posts created before slugs existed
+func (s *PostService) BackfillSlugs() error {
+	posts, err := s.PostRepo.ReadPostsWithoutSlug()
+	if err != nil {
+		return err
+	}
+
+	for _, post := range posts {
+		newSlug, err := s.uniqueSlug(post.Title, post.Id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.PostRepo.UpdatePostSlug(post.Id, newSlug); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *PostService) CreatePostService(req *handlermodel.CreatePostRequest) error {
 
 	// the following is a mock check, since we do not have claims on the token
@@ -54,33 +385,812 @@ func (s *PostService) CreatePostService(req *handlermodel.CreatePostRequest) err
 		return err
 	}
 
-	return s.PostRepo.CreatePost(&domain.Post{UserId: user.Id, Title: req.Title, Content: req.Content})
+	// posts are published right away unless created as a draft
+	status := domain.PostStatusPublished
+	if req.Status != "" {
+		status = domain.PostStatus(req.Status)
+	}
+
+	format := render.FormatPlain
+	if req.ContentFormat != "" {
+		format = req.ContentFormat
+	}
+
+	visibility := domain.VisibilityPublic
+	if req.Visibility != "" {
+		visibility = domain.PostVisibility(req.Visibility)
+	}
+
+	lang := DefaultLanguage
+	if req.Language != "" {
+		if lang, err = canonicalLanguage(req.Language); err != nil {
+			return err
+		}
+	}
+
+	flags, err := s.checkContent(&filter.Content{UserId: user.Id, Title: req.Title, Body: req.Content})
+	if err != nil {
+		return err
+	}
+
+	postSlug, err := s.uniqueSlug(req.Title, 0)
+	if err != nil {
+		return err
+	}
+
+	mentions, err := s.resolveMentions(req.Content)
+	if err != nil {
+		return err
+	}
+
+	post := &domain.Post{
+		UserId:        user.Id,
+		Slug:          postSlug,
+		Title:         req.Title,
+		Content:       req.Content,
+		ContentFormat: format,
+		Language:      lang,
+		Status:        status,
+		Visibility:    visibility,
+		Mentions:      mentions,
+		Tags:          req.Tags,
+		Flags:         flags,
+	}
+
+	if err := s.PostRepo.CreatePost(post); err != nil {
+		return err
+	}
+
+	if len(flags) == 0 {
+		s.notifyMentions(post, nil, mentions)
+	}
+
+	return nil
 }
 
 func (s *PostService) UpdatePostService(req *handlermodel.UpdatePostRequest) error {
-	post, err := s.verifyUserOwnership(req.Id, req.UserEmail)
+	post, err := s.verifyAuthor(req.Id, req.UserEmail, domain.AuthorEditor)
+
+	if err != nil {
+		return err
+	}
+
+	version, err := s.checkVersion(post, req.Version)
+	if err != nil {
+		return err
+	}
+
+	flags, err := s.checkContent(&filter.Content{PostId: post.Id, UserId: post.UserId, Title: req.NewTitle, Body: req.NewContent})
+	if err != nil {
+		return err
+	}
+
+	return s.updateContent(post, version, &domain.PostUpdate{
+		Title:         req.NewTitle,
+		Content:       req.NewContent,
+		ContentFormat: req.NewContentFormat,
+		Visibility:    domain.PostVisibility(req.NewVisibility),
+		Tags:          req.NewTags,
+		Flags:         flags,
+	})
+}
+
+// ChangePostStatusService moves an owned post to the next lifecycle status
+func (s *PostService) ChangePostStatusService(req *handlermodel.ChangePostStatusRequest, next domain.PostStatus) error {
+	post, err := s.verifyAuthor(req.Id, req.UserEmail, domain.AuthorOwner)
+
+	if err != nil {
+		return err
+	}
+
+	if !post.Status.CanTransitionTo(next) {
+		return fmt.Errorf("cannot change post status from %s to %s", post.Status, next)
+	}
+
+	if err := s.PostRepo.UpdatePostStatus(post.Id, next); err != nil {
+		return err
+	}
+
+	// mentions in drafts notify no one until they are published
+	if post.Status == domain.PostStatusDraft && next == domain.PostStatusPublished {
+		post.Status = next
+		s.notifyMentions(post, nil, post.Mentions)
+	}
+
+	return nil
+}
+
+// SchedulePostService sets a future time for the scheduler to publish an owned draft
+func (s *PostService) SchedulePostService(req *handlermodel.SchedulePostRequest) error {
+	post, err := s.verifyAuthor(req.Id, req.UserEmail, domain.AuthorOwner)
 
 	if err != nil {
 		return err
 	}
 
-	return s.PostRepo.UpdatePost(post.Id, req.NewTitle, req.NewContent)
+	if post.Status != domain.PostStatusDraft {
+		return fmt.Errorf("only drafts can be scheduled")
+	}
+
+	if !req.PublishAt.After(s.now()) {
+		return fmt.Errorf("publishAt must be in the future")
+	}
+
+	return s.PostRepo.SchedulePost(post.Id, req.PublishAt)
 }
 
+// ReadScheduledPosts lists the owner's drafts waiting to be published
+func (s *PostService) ReadScheduledPosts(userEmail string) ([]domain.Post, error) {
+	user, err := s.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.PostRepo.ReadScheduledPosts(user.Id)
+}
+
+// DeletePostService moves an owned post to the trash
 func (s *PostService) DeletePostService(req *handlermodel.DeletePostRequest) error {
-	post, err := s.verifyUserOwnership(req.Id, req.UserEmail)
+	post, err := s.verifyAuthor(req.Id, req.UserEmail, domain.AuthorOwner)
+
+	if err != nil {
+		return err
+	}
+
+	version, err := s.checkVersion(post, req.Version)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.PostRepo.DeletePost(post.Id, version)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrPreconditionFailed
+	}
+
+	return nil
+}
+
+// ReadTrash lists the owner's deleted posts still waiting to be purged
+func (s *PostService) ReadTrash(userEmail string) ([]domain.Post, error) {
+	user, err := s.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.PostRepo.ReadTrash(user.Id)
+}
+
+// RestorePostService takes an owned post out of the trash
+func (s *PostService) RestorePostService(req *handlermodel.RestorePostRequest) error {
+	user, err := s.UserRepo.ReadUser(req.UserEmail)
+	if err != nil {
+		return err
+	}
+
+	post, err := s.PostRepo.ReadTrashedPost(req.Id)
+	if err != nil {
+		return err
+	}
+
+	if user.Id != post.UserId {
+		return fmt.Errorf("user does not own this post")
+	}
+
+	return s.PostRepo.RestorePost(post.Id)
+}
+
+// readVisible returns the post if the viewer is allowed to see it, drafts of
+// other users and hidden posts are reported as not found
+func (s *PostService) readVisible(id int, viewerEmail string) (*domain.Post, error) {
+	post, err := s.PostRepo.ReadPost(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canView(post, s.viewer(viewerEmail)) {
+		return nil, sql.ErrNoRows
+	}
+
+	return post, nil
+}
+
+// withHTML fills in the rendered content, rendering and caching it when the
+// cache was invalidated by a write
+func (s *PostService) withHTML(post *domain.Post) error {
+	if post.ContentHTML != nil {
+		return nil
+	}
+
+	contentHTML, err := render.HTML(post.ContentFormat, post.Content)
+	if err != nil {
+		return err
+	}
+	post.ContentHTML = &contentHTML
+
+	// a failed cache write only costs a render on the next read
+	if err := s.PostRepo.CacheContentHTML(post.Id, contentHTML); err != nil {
+		zap.S().Warnf("Could not cache rendered post %d: %s", post.Id, err.Error())
+	}
+
+	return nil
+}
+
+// ReadPost returns the post with its rendered content if the viewer is allowed to see it
+func (s *PostService) ReadPost(id int, viewerEmail string) (*domain.Post, error) {
+	post, err := s.PostRepo.ReadPost(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.present(post, s.viewer(viewerEmail))
+}
+
+// ReadPostBySlug finds the post by its current or any previous slug, callers
+// compare the returned post's slug to redirect old slugs to the current one
+func (s *PostService) ReadPostBySlug(postSlug string, viewerEmail string) (*domain.Post, error) {
+	post, err := s.PostRepo.ReadPostBySlug(postSlug)
+
+	if err == sql.ErrNoRows {
+		var owner int
+		if owner, err = s.PostRepo.ReadSlugOwner(postSlug); err != nil {
+			return nil, err
+		} else if owner == 0 {
+			return nil, sql.ErrNoRows
+		}
+		post, err = s.PostRepo.ReadPost(owner)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.present(post, s.viewer(viewerEmail))
+}
+
+// present readies a single post for the viewer: sql.ErrNoRows when they
+// can't see it, otherwise with its rendered content and series
+func (s *PostService) present(post *domain.Post, v viewer) (*domain.Post, error) {
+	if !canView(post, v) {
+		return nil, sql.ErrNoRows
+	}
+
+	if err := s.withHTML(post); err != nil {
+		return nil, err
+	}
+
+	s.withSeries(post, v)
+
+	return post, nil
+}
+
+// withSeries places the post in its series. Positions, previous and next
+// count only the parts the viewer can see, a failed lookup leaves the series
+// out rather than failing the read.
+func (s *PostService) withSeries(post *domain.Post, v viewer) {
+	if s.SeriesRepo == nil {
+		return
+	}
+
+	series, err := s.SeriesRepo.ReadPostSeries(post.Id)
+	if err == sql.ErrNoRows {
+		return
+	}
+	if err != nil {
+		zap.S().Warnf("Could not read the series of post %d: %s", post.Id, err.Error())
+		return
+	}
+
+	posts, err := s.SeriesRepo.ReadSeriesPosts(series.Id)
+	if err != nil {
+		zap.S().Warnf("Could not read the posts of series %d: %s", series.Id, err.Error())
+		return
+	}
+
+	parts := seriesParts(posts, v)
+	i := slices.IndexFunc(parts, func(p domain.SeriesPost) bool { return p.Id == post.Id })
+	if i < 0 {
+		return
+	}
+
+	ctx := &domain.SeriesContext{Id: series.Id, Title: series.Title, Position: i + 1, Total: len(parts)}
+	if i > 0 {
+		ctx.Prev = &parts[i-1]
+	}
+	if i < len(parts)-1 {
+		ctx.Next = &parts[i+1]
+	}
+	post.Series = ctx
+}
+
+// seriesParts keeps the posts of a series the viewer can see, numbered from 1
+// in series order
+func seriesParts(posts []domain.Post, v viewer) []domain.SeriesPost {
+	parts := []domain.SeriesPost{}
+
+	for i := range posts {
+		if !canView(&posts[i], v) {
+			continue
+		}
+
+		parts = append(parts, domain.SeriesPost{
+			Id:       posts[i].Id,
+			Slug:     posts[i].Slug,
+			Title:    posts[i].Title,
+			Position: len(parts) + 1,
+		})
+	}
+
+	return parts
+}
+
+// CountView counts a read of the post. Only published posts that aren't
+// hidden are counted and their authors reading them are not.
+func (s *PostService) CountView(post *domain.Post, viewerEmail string, v views.View) {
+	if s.Views == nil || post.Status != domain.PostStatusPublished || post.HiddenAt != nil {
+		return
+	}
+
+	if viewerEmail != "" && post.AuthorRole(s.viewer(viewerEmail).Id) != "" {
+		return
+	}
+
+	v.PostId = post.Id
+	v.At = s.now()
+	s.Views.Record(v)
+}
+
+// canonicalLanguage checks a BCP 47 language tag and returns it in the form
+// translations are stored under, e.g. pt-BR for PT-br
+func canonicalLanguage(tag string) (string, error) {
+	t, err := language.Parse(tag)
+	if err != nil {
+		return "", fmt.Errorf("invalid language %q", tag)
+	}
+
+	return t.String(), nil
+}
+
+// pickLanguage picks the variant of available, the original first, that
+// best matches prefs, an Accept-Language value. Without a match it is the
+// original.
+func pickLanguage(available []string, prefs string) string {
+	if len(available) == 0 {
+		return ""
+	}
+
+	desired, _, err := language.ParseAcceptLanguage(prefs)
+	if err != nil || len(desired) == 0 || len(available) == 1 {
+		return available[0]
+	}
+
+	tags := make([]language.Tag, len(available))
+	for i, lang := range available {
+		tags[i] = language.Make(lang)
+	}
+
+	_, i, confidence := language.NewMatcher(tags).Match(desired...)
+	if confidence == language.No {
+		return available[0]
+	}
+
+	return available[i]
+}
+
+// Localize swaps in the title and content of the variant of each post best
+// matching prefs, an Accept-Language value. Posts without a matching
+// variant keep the original, see pickLanguage.
+func (s *PostService) Localize(prefs string, posts ...*domain.Post) error {
+	if s.TranslationRepo == nil || prefs == "" {
+		return nil
+	}
+
+	byLanguage := map[string][]*domain.Post{}
+	for _, post := range posts {
+		if lang := pickLanguage(post.Languages, prefs); lang != post.Language {
+			byLanguage[lang] = append(byLanguage[lang], post)
+		}
+	}
+
+	for lang, localized := range byLanguage {
+		ids := make([]int, len(localized))
+		for i, post := range localized {
+			ids[i] = post.Id
+		}
+
+		translations, err := s.TranslationRepo.ReadTranslations(lang, ids)
+		if err != nil {
+			return err
+		}
 
+		byPost := map[int]*domain.PostTranslation{}
+		for i := range translations {
+			byPost[translations[i].PostId] = &translations[i]
+		}
+
+		for _, post := range localized {
+			// deleted since the post was read, the original stays
+			t, ok := byPost[post.Id]
+			if !ok {
+				continue
+			}
+
+			if err := s.withTranslationHTML(post.ContentFormat, t); err != nil {
+				return err
+			}
+
+			post.Title, post.Content, post.ContentHTML, post.Language = t.Title, t.Content, t.ContentHTML, t.Language
+		}
+	}
+
+	return nil
+}
+
+// withTranslationHTML is withHTML for a translation, rendered in the
+// format of its post
+func (s *PostService) withTranslationHTML(format string, t *domain.PostTranslation) error {
+	if t.ContentHTML != nil {
+		return nil
+	}
+
+	contentHTML, err := render.HTML(format, t.Content)
+	if err != nil {
+		return err
+	}
+	t.ContentHTML = &contentHTML
+
+	if err := s.TranslationRepo.CacheTranslationHTML(t.PostId, t.Language, contentHTML); err != nil {
+		zap.S().Warnf("Could not cache rendered post %d in %s: %s", t.PostId, t.Language, err.Error())
+	}
+
+	return nil
+}
+
+// SaveTranslation adds or replaces the variant of the post in lang, for its
+// authors. The content filters run on it as on the original and flagged
+// content hides the whole post pending review.
+func (s *PostService) SaveTranslation(postId int, lang string, req *handlermodel.SavePostTranslationRequest) error {
+	post, err := s.verifyAuthor(postId, req.UserEmail, domain.AuthorEditor)
+	if err != nil {
+		return err
+	}
+
+	if lang, err = canonicalLanguage(lang); err != nil {
+		return err
+	}
+	if lang == post.Language {
+		return ErrOriginalLanguage
+	}
+
+	flags, err := s.checkContent(&filter.Content{PostId: post.Id, UserId: post.UserId, Title: req.Title, Body: req.Content})
+	if err != nil {
+		return err
+	}
+
+	saved, err := s.TranslationRepo.SaveTranslation(&domain.PostTranslation{PostId: post.Id, Language: lang, Title: req.Title, Content: req.Content}, flags)
+	if err != nil {
+		return err
+	}
+	if !saved {
+		return ErrOriginalLanguage
+	}
+
+	return nil
+}
+
+// DeleteTranslation removes the variant of the post in lang, for its
+// authors. The original can't be removed.
+func (s *PostService) DeleteTranslation(postId int, lang string, req *handlermodel.DeletePostTranslationRequest) error {
+	post, err := s.verifyAuthor(postId, req.UserEmail, domain.AuthorEditor)
 	if err != nil {
 		return err
 	}
 
-	return s.PostRepo.DeletePost(post.Id)
+	if lang, err = canonicalLanguage(lang); err != nil {
+		return err
+	}
+	if lang == post.Language {
+		return ErrOriginalLanguage
+	}
+
+	deleted, err := s.TranslationRepo.DeleteTranslation(post.Id, lang)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return sql.ErrNoRows
+	}
+
+	return nil
 }
 
-func (s *PostService) ReadPost(id int) (*domain.Post, error) {
-	return s.PostRepo.ReadPost(id)
+// ReadAllPosts pages through the global listing for the viewer, newest
+// first. The first page starts with the featured posts in their order, they
+// don't count toward limit and are left out of the pages so the cursor only
+// ever follows created_at.
+func (s *PostService) ReadAllPosts(viewerEmail string, cursor string, limit int) (*domain.Page[domain.Post], error) {
+	before, err := decodeCursor(cursor, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	v := s.viewer(viewerEmail)
+	limit = pageSize(limit)
+
+	var items []domain.Post
+	if cursor == "" {
+		featured, err := s.PostRepo.ReadFeaturedPosts()
+		if err != nil {
+			return nil, err
+		}
+
+		if items, err = s.listed(featured, v); err != nil {
+			return nil, err
+		}
+		for i := range items {
+			items[i].Featured = true
+		}
+	}
+
+	posts, err := s.PostRepo.ReadAllPosts(v.Id, cursorTime(before[0]), int(before[1]), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listedPage(items, posts, v, limit)
 }
 
-func (s *PostService) ReadAllPosts() ([]domain.Post, error) {
-	return s.PostRepo.ReadAllPosts()
+// ReadAuthorPosts pages through the posts the author owns for the viewer,
+// newest first. The first page starts with the author's pinned posts, like
+// the featured posts of ReadAllPosts.
+func (s *PostService) ReadAuthorPosts(authorEmail string, viewerEmail string, cursor string, limit int) (*domain.Page[domain.Post], error) {
+	before, err := decodeCursor(cursor, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := s.UserRepo.ReadUser(authorEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	v := s.viewer(viewerEmail)
+	limit = pageSize(limit)
+
+	var items []domain.Post
+	if cursor == "" {
+		pinned, err := s.PostRepo.ReadPinnedPosts(author.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		if items, err = s.listed(pinned, v); err != nil {
+			return nil, err
+		}
+		for i := range items {
+			items[i].Pinned = true
+		}
+	}
+
+	posts, err := s.PostRepo.ReadAuthorPosts(author.Id, v.Id, cursorTime(before[0]), int(before[1]), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listedPage(items, posts, v, limit)
+}
+
+// cursorTime is the created_at of a (created_at, id) cursor, zero for the
+// first page
+func cursorTime(unix int64) time.Time {
+	if unix == 0 {
+		return time.Time{}
+	}
+	return time.Unix(unix, 0)
+}
+
+// listedPage appends the posts of a page read newest first to items, keeping
+// those listed for the viewer. The cursor follows the posts read so the next
+// page starts after them.
+func (s *PostService) listedPage(items []domain.Post, posts []domain.Post, v viewer, limit int) (*domain.Page[domain.Post], error) {
+	listed, err := s.listed(posts, v)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.Page[domain.Post]{Items: append(items, listed...)}
+	if page.Items == nil {
+		page.Items = []domain.Post{}
+	}
+
+	if len(posts) == limit {
+		last := posts[len(posts)-1]
+
+		createdAt, err := time.Parse(time.RFC3339, last.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		page.NextCursor = encodeCursor(createdAt.Unix(), int64(last.Id))
+	}
+
+	return page, nil
+}
+
+// Feeds list the newest posts up to DefaultFeedLimit, clients can ask for
+// at most MaxFeedLimit
+const (
+	DefaultFeedLimit = 20
+	MaxFeedLimit     = 50
+)
+
+// ReadFeed lists the newest published posts with rendered content for feeds,
+// only those of the author when authorEmail is set. The author is nil for
+// the global feed.
+func (s *PostService) ReadFeed(authorEmail string, tag string, limit int) (*domain.User, []domain.FeedPost, error) {
+	if limit <= 0 {
+		limit = DefaultFeedLimit
+	}
+	limit = min(limit, MaxFeedLimit)
+
+	var author *domain.User
+	userId := 0
+
+	if authorEmail != "" {
+		var err error
+		if author, err = s.UserRepo.ReadUser(authorEmail); err != nil {
+			return nil, nil, err
+		}
+		userId = author.Id
+	}
+
+	posts, err := s.PostRepo.ReadFeedPosts(userId, tag, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// feeds are read anonymously, whoever subscribes
+	listed := []domain.FeedPost{}
+	for i := range posts {
+		if !isListed(&posts[i].Post, viewer{}) {
+			continue
+		}
+
+		if err := s.withHTML(&posts[i].Post); err != nil {
+			return nil, nil, err
+		}
+
+		listed = append(listed, posts[i])
+	}
+
+	return author, listed, nil
+}
+
+// ReadTimeline pages through the published posts of the authors the user
+// follows, newest first. The cursor holds the creation time and id of the
+// last post of the previous page.
+func (s *PostService) ReadTimeline(userEmail string, cursor string, limit int) (*domain.Page[domain.Post], error) {
+	before, err := decodeCursor(cursor, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pageSize(limit)
+
+	posts, err := s.PostRepo.ReadTimeline(user.Id, cursorTime(before[0]), int(before[1]), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listedPage(nil, posts, s.viewerOf(user), limit)
+}
+
+// ReadMentions pages through the public posts mentioning the user with the
+// username, newest first
+func (s *PostService) ReadMentions(username string, cursor string, limit int) (*domain.Page[domain.Post], error) {
+	before, err := decodeCursor(cursor, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.UserRepo.ReadUsersByUsernames([]string{username})
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	limit = pageSize(limit)
+
+	posts, err := s.PostRepo.ReadMentioningPosts(username, int(before[0]), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.listed(posts, viewer{})
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.Page[domain.Post]{Items: items}
+
+	if len(posts) == limit {
+		page.NextCursor = encodeCursor(int64(posts[len(posts)-1].Id))
+	}
+
+	return page, nil
+}
+
+// ReadRevisions lists the revision history of a post the viewer can see
+func (s *PostService) ReadRevisions(postId int, viewerEmail string) ([]domain.PostRevision, error) {
+	if _, err := s.readVisible(postId, viewerEmail); err != nil {
+		return nil, err
+	}
+
+	return s.RevisionRepo.ReadRevisions(postId)
+}
+
+// DiffRevisions compares two revisions of a post the viewer can see
+func (s *PostService) DiffRevisions(postId int, req *handlermodel.DiffPostRevisionsRequest) (*domain.PostRevisionDiff, error) {
+	if _, err := s.readVisible(postId, req.UserEmail); err != nil {
+		return nil, err
+	}
+
+	from, err := s.RevisionRepo.ReadRevision(postId, req.From)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.RevisionRepo.ReadRevision(postId, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	// title and content are diffed as one document, title on the first line
+	fromText := from.Title + "\n\n" + from.Content
+	toText := to.Title + "\n\n" + to.Content
+
+	result := &domain.PostRevisionDiff{From: from.Revision, To: to.Revision, Mode: req.Mode}
+
+	switch req.Mode {
+	case "word":
+		result.Words = diff.Words(fromText, toText)
+	default:
+		result.Mode = "unified"
+		result.Unified = diff.Unified(
+			fmt.Sprintf("revision %d", from.Revision),
+			fmt.Sprintf("revision %d", to.Revision),
+			fromText, toText, 3)
+	}
+
+	return result, nil
+}
+
+// RestoreRevisionService brings back an older revision for an author of the post.
+// History is never rewritten, the restored content is saved as a new revision.
+func (s *PostService) RestoreRevisionService(postId int, revision int, req *handlermodel.RestorePostRevisionRequest) error {
+	post, err := s.verifyAuthor(postId, req.UserEmail, domain.AuthorEditor)
+	if err != nil {
+		return err
+	}
+
+	rev, err := s.RevisionRepo.ReadRevision(post.Id, revision)
+	if err != nil {
+		return err
+	}
+
+	flags, err := s.checkContent(&filter.Content{PostId: post.Id, UserId: post.UserId, Title: rev.Title, Body: rev.Content})
+	if err != nil {
+		return err
+	}
+
+	return s.updateContent(post, post.Version, &domain.PostUpdate{Title: rev.Title, Content: rev.Content, Flags: flags})
 }
@@ -0,0 +1,215 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository"
+)
+
+var (
+	ErrPostInSeries       = errors.New("post is already part of a series")
+	ErrInvalidSeriesOrder = errors.New("order names a post that is not part of the series or names one twice")
+)
+
+// SeriesService handles series of posts and the order of their parts
+type SeriesService struct {
+	Posts      *PostService
+	SeriesRepo repository.SeriesRepositoryInterface
+}
+
+// NewSeriesService creates a new instance of SeriesService with repositories
+func NewSeriesService(db *sql.DB) *SeriesService {
+	return &SeriesService{
+		Posts:      NewPostService(db),
+		SeriesRepo: repository.NewSeriesRepository(db),
+	}
+}
+
+// withParts loads the parts of series the viewer can see
+func (s *SeriesService) withParts(series *domain.Series, v viewer) error {
+	posts, err := s.SeriesRepo.ReadSeriesPosts(series.Id)
+	if err != nil {
+		return err
+	}
+
+	series.Posts = seriesParts(posts, v)
+	return nil
+}
+
+// readOwnSeries reads a series of the user, series of other users are not
+// found
+func (s *SeriesService) readOwnSeries(seriesId int, userEmail string) (*domain.Series, error) {
+	user, err := s.Posts.UserRepo.ReadUser(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := s.SeriesRepo.ReadSeries(seriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	if series.UserId != user.Id {
+		return nil, sql.ErrNoRows
+	}
+
+	return series, nil
+}
+
+// CreateSeries creates an empty series
+func (s *SeriesService) CreateSeries(req *handlermodel.CreateSeriesRequest) (*domain.Series, error) {
+	user, err := s.Posts.UserRepo.ReadUser(req.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	series := &domain.Series{UserId: user.Id, Title: req.Title, Description: req.Description}
+	if err := s.SeriesRepo.CreateSeries(series); err != nil {
+		return nil, err
+	}
+
+	return s.ReadSeries(series.Id, req.UserEmail)
+}
+
+// ReadSeries reads a series with the parts the viewer can see, series are
+// public
+func (s *SeriesService) ReadSeries(seriesId int, viewerEmail string) (*domain.Series, error) {
+	series, err := s.SeriesRepo.ReadSeries(seriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.withParts(series, s.Posts.viewer(viewerEmail)); err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+// ReadUserSeries lists the series of the author, newest first, with the
+// parts the viewer can see
+func (s *SeriesService) ReadUserSeries(authorEmail string, viewerEmail string) ([]domain.Series, error) {
+	author, err := s.Posts.UserRepo.ReadUser(authorEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := s.SeriesRepo.ReadUserSeries(author.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := s.Posts.viewer(viewerEmail)
+	result := []domain.Series{}
+	for _, sr := range series {
+		if err := s.withParts(&sr, v); err != nil {
+			return nil, err
+		}
+		result = append(result, sr)
+	}
+
+	return result, nil
+}
+
+// UpdateSeries changes the title or description of an own series
+func (s *SeriesService) UpdateSeries(seriesId int, req *handlermodel.UpdateSeriesRequest) (*domain.Series, error) {
+	series, err := s.readOwnSeries(seriesId, req.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Title != nil {
+		series.Title = *req.Title
+	}
+	if req.Description != nil {
+		series.Description = *req.Description
+	}
+
+	if err := s.SeriesRepo.UpdateSeries(series); err != nil {
+		return nil, err
+	}
+
+	return s.ReadSeries(series.Id, req.UserEmail)
+}
+
+// DeleteSeries deletes an own series, its posts are kept
+func (s *SeriesService) DeleteSeries(seriesId int, userEmail string) error {
+	series, err := s.readOwnSeries(seriesId, userEmail)
+	if err != nil {
+		return err
+	}
+
+	return s.SeriesRepo.DeleteSeries(series.Id)
+}
+
+// AddPost adds an own post to an own series at position, 0 appends it. A
+// post is part of at most one series.
+func (s *SeriesService) AddPost(seriesId int, req *handlermodel.AddSeriesPostRequest) error {
+	series, err := s.readOwnSeries(seriesId, req.UserEmail)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.Posts.verifyAuthor(req.PostId, req.UserEmail, domain.AuthorOwner); err != nil {
+		return err
+	}
+
+	added, err := s.SeriesRepo.AddSeriesPost(series.Id, req.PostId, req.Position)
+	if err != nil {
+		return err
+	}
+	if !added {
+		return ErrPostInSeries
+	}
+
+	return nil
+}
+
+// MovePost moves a part of an own series to position
+func (s *SeriesService) MovePost(seriesId int, postId int, req *handlermodel.MoveSeriesPostRequest) error {
+	series, err := s.readOwnSeries(seriesId, req.UserEmail)
+	if err != nil {
+		return err
+	}
+
+	moved, err := s.SeriesRepo.MoveSeriesPost(series.Id, postId, req.Position)
+	if err != nil {
+		return err
+	}
+	if !moved {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Reorder puts the named parts of an own series first in the given order,
+// parts left out follow in their current order
+func (s *SeriesService) Reorder(seriesId int, req *handlermodel.ReorderSeriesRequest) (*domain.Series, error) {
+	series, err := s.readOwnSeries(seriesId, req.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	reordered, err := s.SeriesRepo.ReorderSeries(series.Id, req.PostIds)
+	if err != nil {
+		return nil, err
+	}
+	if !reordered {
+		return nil, ErrInvalidSeriesOrder
+	}
+
+	return s.ReadSeries(series.Id, req.UserEmail)
+}
+
+// RemovePost takes a post out of an own series, the parts after it move up
+func (s *SeriesService) RemovePost(seriesId int, postId int, userEmail string) error {
+	series, err := s.readOwnSeries(seriesId, userEmail)
+	if err != nil {
+		return err
+	}
+
+	return s.SeriesRepo.RemoveSeriesPost(series.Id, postId)
+}
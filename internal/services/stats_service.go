@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"web/example/internal/domain"
+	"web/example/internal/repository"
+	"web/example/internal/views"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultViewFlushInterval is how often buffered view counts are written
+	DefaultViewFlushInterval = 10 * time.Second
+	// DefaultStatsDays is how many days of views stats cover
+	DefaultStatsDays = 30
+	// MaxStatsDays caps the days of views stats cover
+	MaxStatsDays = 365
+
+	topReferrers = 10
+)
+
+// StatsService writes the view counts buffered by the post reads and reads
+// them back as per post stats
+type StatsService struct {
+	Posts    *PostService
+	ViewRepo repository.ViewRepositoryInterface
+	Counter  *views.Counter
+	Clock    Clock
+	Interval time.Duration
+}
+
+// NewStatsService creates a new instance of StatsService with repositories,
+// counter is shared with the post service counting the reads
+func NewStatsService(db *sql.DB, counter *views.Counter) *StatsService {
+	return &StatsService{
+		Posts:    NewPostService(db),
+		ViewRepo: repository.NewViewRepository(db),
+		Counter:  counter,
+		Clock:    systemClock{},
+		Interval: DefaultViewFlushInterval,
+	}
+}
+
+// Start flushes the counter in the background until ctx is cancelled, and
+// once more then. Counts not flushed when the process dies are lost.
+func (s *StatsService) Start(ctx context.Context) {
+	go func() {
+		runPeriodically(ctx, s.Interval, 0, s.flushLogged)
+		s.flushLogged()
+	}()
+}
+
+func (s *StatsService) flushLogged() {
+	if _, err := s.Flush(); err != nil {
+		zap.S().Errorln("Flushing view counts failed: ", err.Error())
+	}
+}
+
+// Flush writes the counts buffered since the last flush in one batch, they
+// are kept for the next flush when writing fails
+func (s *StatsService) Flush() (int, error) {
+	counts := s.Counter.Drain(s.Clock.Now())
+	if len(counts) == 0 {
+		return 0, nil
+	}
+
+	if err := s.ViewRepo.AddViews(counts); err != nil {
+		s.Counter.Restore(counts)
+		return 0, err
+	}
+
+	return len(counts), nil
+}
+
+// ReadStats reads the views of an owned post over the last days, today
+// included, per day and by referrer. Views still buffered are not in yet.
+func (s *StatsService) ReadStats(postId int, userEmail string, days int) (*domain.PostStats, error) {
+	post, err := s.Posts.verifyAuthor(postId, userEmail, domain.AuthorOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if days <= 0 {
+		days = DefaultStatsDays
+	}
+	days = min(days, MaxStatsDays)
+
+	today := s.Clock.Now().UTC()
+	since := today.AddDate(0, 0, 1-days).Format(time.DateOnly)
+
+	daily, err := s.ViewRepo.ReadDailyViews(post.Id, since)
+	if err != nil {
+		return nil, err
+	}
+
+	referrers, err := s.ViewRepo.ReadTopReferrers(post.Id, since, topReferrers)
+	if err != nil {
+		return nil, err
+	}
+	if referrers == nil {
+		referrers = []domain.ReferrerViews{}
+	}
+
+	byDay := map[string]int{}
+	for _, d := range daily {
+		byDay[d.Day] = d.Views
+	}
+
+	stats := &domain.PostStats{PostId: post.Id, Days: make([]domain.DayViews, days), Referrers: referrers}
+	for i := range stats.Days {
+		day := today.AddDate(0, 0, i+1-days).Format(time.DateOnly)
+		stats.Days[i] = domain.DayViews{Day: day, Views: byDay[day]}
+		stats.Total += byDay[day]
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"web/example/internal/repository"
+	"web/example/internal/storage"
+
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultTrashRetention       = 30 * 24 * time.Hour
+	DefaultAccountDeletionGrace = 14 * 24 * time.Hour
+	purgerInterval              = time.Hour
+	purgerJitter                = time.Minute
+)
+
+// TrashPurger hard deletes posts that stayed in the trash longer than
+// TrashRetention and accounts whose deletion grace period is over, with the
+// files of their attachments
+type TrashPurger struct {
+	PostRepo             repository.PostRepositoryInterface
+	UserRepo             repository.UserRepositoryInterface
+	Store                storage.BlobStore
+	Clock                Clock
+	TrashRetention       time.Duration
+	AccountDeletionGrace time.Duration
+	Interval             time.Duration
+	Jitter               time.Duration
+}
+
+// NewTrashPurger creates a new instance of TrashPurger with repositories
+func NewTrashPurger(db *sql.DB, store storage.BlobStore, trashRetention time.Duration, accountDeletionGrace time.Duration) *TrashPurger {
+	return &TrashPurger{
+		PostRepo:             repository.NewPostRepository(db),
+		UserRepo:             repository.NewUserRepository(db),
+		Store:                store,
+		Clock:                systemClock{},
+		TrashRetention:       trashRetention,
+		AccountDeletionGrace: accountDeletionGrace,
+		Interval:             purgerInterval,
+		Jitter:               purgerJitter,
+	}
+}
+
+// Start runs the purger in the background until ctx is cancelled
+func (p *TrashPurger) Start(ctx context.Context) {
+	go runPeriodically(ctx, p.Interval, p.Jitter, p.runLogged)
+}
+
+func (p *TrashPurger) runLogged() {
+	posts, users, err := p.RunOnce()
+	if err != nil {
+		zap.S().Errorln("Trash purge failed: ", err.Error())
+		return
+	}
+	if posts > 0 || users > 0 {
+		zap.S().Infof("Purged %d posts and %d accounts", posts, users)
+	}
+}
+
+// RunOnce purges everything past its retention at the clock's current time
+func (p *TrashPurger) RunOnce() (posts int64, users int64, err error) {
+	now := p.Clock.Now()
+
+	posts, keys, err := p.PostRepo.PurgeDeletedPosts(now.Add(-p.TrashRetention))
+	if err != nil {
+		return 0, 0, err
+	}
+	deleteBlobs(p.Store, keys)
+
+	users, keys, err = p.UserRepo.PurgeDeletedUsers(now.Add(-p.AccountDeletionGrace))
+	deleteBlobs(p.Store, keys)
+	if err != nil {
+		return posts, users, err
+	}
+
+	return posts, users, nil
+}
@@ -48,9 +48,7 @@ func (s *UserService) LoginUserService(req *handlermodel.LoginUserRequest) (stri
 		return "", err
 	}
 
-	if decoded, err := base64.StdEncoding.DecodeString(usr.Password_hash); err != nil {
-		return "", err
-	} else if err := bcrypt.CompareHashAndPassword([]byte(decoded), []byte(req.Password)); err != nil {
+	if err := checkPassword(usr, req.Password); err != nil {
 		return "", err
 	}
 
@@ -59,10 +57,37 @@ func (s *UserService) LoginUserService(req *handlermodel.LoginUserRequest) (stri
 
 }
 
+// checkPassword compares the password against the user's stored bcrypt hash
+func checkPassword(usr *domain.User, password string) error {
+	decoded, err := base64.StdEncoding.DecodeString(usr.Password_hash)
+	if err != nil {
+		return err
+	}
+
+	return bcrypt.CompareHashAndPassword(decoded, []byte(password))
+}
+
+// DeleteUser starts the account deletion grace period, the account and its
+// posts are hidden right away and purged once the grace period is over
 func (s *UserService) DeleteUser(email string) error {
 	return s.UserRepo.DeleteUser(email)
 }
 
+// CancelDeletionService stops a pending account deletion, the password is
+// required since the account can't log in while it is pending deletion
+func (s *UserService) CancelDeletionService(req *handlermodel.CancelUserDeletionRequest) error {
+	usr, err := s.UserRepo.ReadUserPendingDeletion(req.Email)
+	if err != nil {
+		return err
+	}
+
+	if err := checkPassword(usr, req.Password); err != nil {
+		return err
+	}
+
+	return s.UserRepo.CancelUserDeletion(usr.Email)
+}
+
 func (s *UserService) ReadUser(email string) (*domain.User, error) {
 	return s.UserRepo.ReadUser(email)
 }
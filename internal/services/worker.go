@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+// Clock tells the current time, tests swap it to fast-forward time
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// runPeriodically calls run once right away and then on every tick of
+// interval, each tick delayed by a random amount up to jitter so several
+// instances don't hit the db in lockstep. It returns when ctx is cancelled.
+func runPeriodically(ctx context.Context, interval time.Duration, jitter time.Duration, run func()) {
+	run()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if jitter > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(rand.N(jitter)):
+			}
+		}
+
+		run()
+	}
+}
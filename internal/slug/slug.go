@@ -0,0 +1,96 @@
+// Package slug turns post titles into URL friendly identifiers.
+package slug
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxLength keeps slugs short enough for URLs, they are cut at a word boundary
+const MaxLength = 80
+
+// fallback is used when nothing of the title survives slugification
+const fallback = "post"
+
+// transliterations covers letters that don't decompose into ASCII plus a mark
+var transliterations = map[rune]string{
+	// apostrophes are dropped instead of splitting words ("bob's" -> "bobs")
+	'\'': "", '’': "",
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'ł': "l", 'þ': "th", 'ı': "i", 'ħ': "h",
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z", 'и': "i",
+	'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+	'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "",
+	'э': "e", 'ю': "yu", 'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+	// Greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i", 'κ': "k",
+	'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t",
+	'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+}
+
+// Make builds the slug for a title: transliterated to ASCII, lowercase,
+// words joined by single dashes. A slug is never only digits so it can't
+// be mistaken for a post id.
+func Make(title string) string {
+	var sb strings.Builder
+	dash := false
+
+	// NFKD splits accented letters into the base letter plus combining marks
+	for _, r := range norm.NFKD.String(strings.ToLower(title)) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+
+		part, ok := transliterations[r]
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			part, ok = string(r), true
+		}
+
+		if !ok {
+			// anything else separates words
+			dash = sb.Len() > 0
+			continue
+		}
+
+		if part == "" {
+			continue
+		}
+
+		if dash {
+			sb.WriteByte('-')
+			dash = false
+		}
+		sb.WriteString(part)
+	}
+
+	s := sb.String()
+
+	if len(s) > MaxLength {
+		s = s[:MaxLength]
+		if i := strings.LastIndexByte(s, '-'); i > 0 {
+			s = s[:i]
+		}
+	}
+
+	if s == "" {
+		return fallback
+	}
+
+	if strings.Trim(s, "0123456789") == "" {
+		return fallback + "-" + s
+	}
+
+	return s
+}
+
+// WithSuffix returns the n-th candidate for a slug that is already taken,
+// n starts at 2 ("title", "title-2", "title-3", ...)
+func WithSuffix(s string, n int) string {
+	if n < 2 {
+		return s
+	}
+	return s + "-" + strconv.Itoa(n)
+}
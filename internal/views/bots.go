@@ -0,0 +1,28 @@
+package views
+
+import "strings"
+
+// botMarkers are lower case fragments of the user agents of crawlers, link
+// previews, monitoring and scripted clients
+var botMarkers = []string{
+	"bot", "crawl", "spider", "slurp", "archiver", "facebookexternalhit", "embedly", "preview",
+	"headless", "lighthouse", "pingdom", "uptime", "monitor",
+	"curl", "wget", "python-requests", "python-urllib", "go-http-client", "java/", "okhttp", "libwww",
+}
+
+// IsBot reports whether the user agent is not a person reading, requests
+// without a user agent are treated as bots
+func IsBot(userAgent string) bool {
+	ua := strings.ToLower(strings.TrimSpace(userAgent))
+	if ua == "" {
+		return true
+	}
+
+	for _, marker := range botMarkers {
+		if strings.Contains(ua, marker) {
+			return true
+		}
+	}
+
+	return false
+}
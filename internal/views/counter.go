@@ -0,0 +1,134 @@
+// Package views counts post views in memory so reads don't write to the db:
+// bots are dropped, repeated views of a visitor are counted once per window
+// and the counts are drained in batches.
+package views
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"web/example/internal/domain"
+)
+
+// DefaultWindow is how long repeated views of a visitor count once
+const DefaultWindow = 30 * time.Minute
+
+// View is one read of a post as seen by the HTTP layer
+type View struct {
+	PostId    int
+	IP        string
+	UserAgent string
+	Referrer  string // Referer header, only its host is kept
+	At        time.Time
+}
+
+type countKey struct {
+	postId   int
+	day      string
+	referrer string
+}
+
+// Counter buffers view counts until they are drained. Visitors are told
+// apart by an HMAC of IP and user agent under a random key that never leaves
+// the process, so neither raw addresses nor reversible hashes are kept. It
+// is safe for concurrent use.
+type Counter struct {
+	Window time.Duration
+
+	key     []byte
+	mu      sync.Mutex
+	seen    map[[sha256.Size]byte]time.Time // visitor hash to when the window ends
+	pending map[countKey]int
+}
+
+func NewCounter(window time.Duration) *Counter {
+	key := make([]byte, 32)
+	rand.Read(key)
+
+	return &Counter{
+		Window:  window,
+		key:     key,
+		seen:    map[[sha256.Size]byte]time.Time{},
+		pending: map[countKey]int{},
+	}
+}
+
+// visitor hashes who viewed which post
+func (c *Counter) visitor(v View) [sha256.Size]byte {
+	mac := hmac.New(sha256.New, c.key)
+	binary.Write(mac, binary.BigEndian, int64(v.PostId))
+	mac.Write([]byte(v.IP + "\n" + v.UserAgent))
+
+	var h [sha256.Size]byte
+	copy(h[:], mac.Sum(nil))
+	return h
+}
+
+// Record counts the view, it reports false for bots and for visitors who
+// already viewed the post within the window
+func (c *Counter) Record(v View) bool {
+	if IsBot(v.UserAgent) {
+		return false
+	}
+
+	h := c.visitor(v)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if until, ok := c.seen[h]; ok && v.At.Before(until) {
+		return false
+	}
+	c.seen[h] = v.At.Add(c.Window)
+
+	c.pending[countKey{postId: v.PostId, day: v.At.UTC().Format(time.DateOnly), referrer: referrerHost(v.Referrer)}]++
+
+	return true
+}
+
+// Drain takes the counts recorded since the last drain and forgets visitors
+// whose window ended before now
+func (c *Counter) Drain(now time.Time) []domain.ViewCount {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for h, until := range c.seen {
+		if !now.Before(until) {
+			delete(c.seen, h)
+		}
+	}
+
+	counts := make([]domain.ViewCount, 0, len(c.pending))
+	for k, n := range c.pending {
+		counts = append(counts, domain.ViewCount{PostId: k.postId, Day: k.day, Referrer: k.referrer, Views: n})
+	}
+	c.pending = map[countKey]int{}
+
+	return counts
+}
+
+// Restore puts drained counts back, for when storing them failed
+func (c *Counter) Restore(counts []domain.ViewCount) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, vc := range counts {
+		c.pending[countKey{postId: vc.PostId, day: vc.Day, referrer: vc.Referrer}] += vc.Views
+	}
+}
+
+// referrerHost keeps the host of the referrer, paths and queries can carry
+// personal data. Anything not an http(s) URL counts as a direct visit.
+func referrerHost(referrer string) string {
+	u, err := url.Parse(referrer)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
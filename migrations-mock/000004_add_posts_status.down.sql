@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_posts_status;
+ALTER TABLE posts DROP COLUMN published_at;
+ALTER TABLE posts DROP COLUMN status;
\ No newline at end of file
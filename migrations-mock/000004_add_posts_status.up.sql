@@ -0,0 +1,7 @@
+ALTER TABLE posts ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'published'
+    CHECK (status IN ('draft', 'published', 'archived'));
+ALTER TABLE posts ADD COLUMN published_at DATETIME;
+
+UPDATE posts SET published_at = created_at WHERE status = 'published';
+
+CREATE INDEX idx_posts_status ON posts(status);
@@ -0,0 +1,5 @@
+ALTER TABLE posts ADD COLUMN deleted_at DATETIME;
+ALTER TABLE users ADD COLUMN deleted_at DATETIME;
+
+CREATE INDEX idx_posts_deleted_at ON posts(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_users_deleted_at ON users(deleted_at) WHERE deleted_at IS NOT NULL;
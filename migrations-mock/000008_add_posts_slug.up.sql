@@ -0,0 +1,16 @@
+ALTER TABLE posts ADD COLUMN slug VARCHAR(96);
+
+-- Slugs need transliteration so existing posts get theirs from the app on
+-- startup (PostService.BackfillSlugs) instead of in SQL
+
+CREATE UNIQUE INDEX idx_posts_slug ON posts(slug);
+
+-- Every slug a post ever had, old ones redirect to the current one
+CREATE TABLE IF NOT EXISTS post_slugs (
+    slug VARCHAR(96) PRIMARY KEY,
+    post_id INTEGER NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_post_slugs_post_id ON post_slugs(post_id);
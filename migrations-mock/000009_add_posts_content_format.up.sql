@@ -0,0 +1,6 @@
+ALTER TABLE posts ADD COLUMN content_format VARCHAR(16) NOT NULL DEFAULT 'plain'
+    CHECK (content_format IN ('plain', 'markdown'));
+
+-- Sanitized HTML rendered from content, NULL until the post is first read
+-- after a write
+ALTER TABLE posts ADD COLUMN content_html TEXT;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS notifications (
+    id INTEGER PRIMARY KEY,
+    user_id INTEGER NOT NULL,  -- who is notified
+    actor_id INTEGER NOT NULL, -- who caused it
+    type TEXT NOT NULL,
+    post_id INTEGER,
+    read_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+-- Notifications are paged newest first by id, the partial index serves the
+-- unread filter and count
+CREATE INDEX idx_notifications_user_id ON notifications(user_id, id);
+CREATE INDEX idx_notifications_unread ON notifications(user_id, id) WHERE read_at IS NULL;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_moderation_actions_post_id;
+DROP TABLE IF EXISTS moderation_actions;
+DROP INDEX IF EXISTS idx_post_reports_open;
+DROP TABLE IF EXISTS post_reports;
+ALTER TABLE posts DROP COLUMN hidden_at;
+ALTER TABLE users DROP COLUMN role;
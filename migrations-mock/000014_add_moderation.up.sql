@@ -0,0 +1,35 @@
+-- Moderators review reported posts, the role is granted directly in the db
+ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'user';
+
+-- Hidden posts are only visible to their author and moderators
+ALTER TABLE posts ADD COLUMN hidden_at DATETIME;
+
+CREATE TABLE IF NOT EXISTS post_reports (
+    id INTEGER PRIMARY KEY,
+    post_id INTEGER NOT NULL,
+    reporter_id INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    details TEXT,
+    resolved_at DATETIME, -- Set once a moderator acted on the post
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (post_id, reporter_id),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE,
+    FOREIGN KEY (reporter_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- The queue only looks at open reports
+CREATE INDEX idx_post_reports_open ON post_reports(post_id) WHERE resolved_at IS NULL;
+
+-- Every moderation decision, moderator_id is NULL for automatic ones
+CREATE TABLE IF NOT EXISTS moderation_actions (
+    id INTEGER PRIMARY KEY,
+    post_id INTEGER NOT NULL,
+    moderator_id INTEGER,
+    action TEXT NOT NULL,
+    note TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE,
+    FOREIGN KEY (moderator_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_moderation_actions_post_id ON moderation_actions(post_id, id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS classifier_tokens;
+DROP TABLE IF EXISTS classifier_documents;
+DROP INDEX IF EXISTS idx_post_flags_open;
+DROP TABLE IF EXISTS post_flags;
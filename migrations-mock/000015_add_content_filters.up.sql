@@ -0,0 +1,29 @@
+-- Content flagged by the filters on create or update, the post is hidden
+-- until a moderator reviews it
+CREATE TABLE IF NOT EXISTS post_flags (
+    id INTEGER PRIMARY KEY,
+    post_id INTEGER NOT NULL,
+    filter TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    resolved_at DATETIME, -- Set once a moderator acted on the post
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_post_flags_open ON post_flags(post_id) WHERE resolved_at IS NULL;
+
+-- Spam classifier training, a post is trained once with its latest label.
+-- There is no foreign key so purging a post keeps what it taught.
+CREATE TABLE IF NOT EXISTS classifier_documents (
+    post_id INTEGER PRIMARY KEY,
+    spam BOOLEAN NOT NULL,
+    tokens TEXT NOT NULL, -- JSON array of the tokens counted, to untrain on relabel
+    trained_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- How many spam and ham documents contain each token
+CREATE TABLE IF NOT EXISTS classifier_tokens (
+    token TEXT PRIMARY KEY,
+    spam INTEGER NOT NULL DEFAULT 0,
+    ham INTEGER NOT NULL DEFAULT 0
+) WITHOUT ROWID;
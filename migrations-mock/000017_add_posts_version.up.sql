@@ -0,0 +1,2 @@
+-- Incremented by every write, compared on update for optimistic concurrency
+ALTER TABLE posts ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
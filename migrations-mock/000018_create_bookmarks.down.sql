@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_bookmarks_post_id;
+DROP INDEX IF EXISTS idx_reading_list_items_post_id;
+DROP INDEX IF EXISTS idx_reading_list_items_position;
+DROP TABLE IF EXISTS reading_list_items;
+DROP TABLE IF EXISTS reading_lists;
+DROP INDEX IF EXISTS idx_bookmarks_user_id;
+DROP TABLE IF EXISTS bookmarks;
@@ -0,0 +1,41 @@
+CREATE TABLE IF NOT EXISTS bookmarks (
+    id INTEGER PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    post_id INTEGER NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, post_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+-- Bookmarks are paged newest first by id
+CREATE INDEX idx_bookmarks_user_id ON bookmarks(user_id, id);
+
+-- Named, ordered lists of posts, shared read-only through share_token when set
+CREATE TABLE IF NOT EXISTS reading_lists (
+    id INTEGER PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    share_token VARCHAR(64) UNIQUE,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- position only orders the items of a list, purged posts can leave gaps
+CREATE TABLE IF NOT EXISTS reading_list_items (
+    list_id INTEGER NOT NULL,
+    post_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    added_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (list_id, post_id),
+    FOREIGN KEY (list_id) REFERENCES reading_lists(id) ON DELETE CASCADE,
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_reading_list_items_position ON reading_list_items(list_id, position);
+CREATE INDEX idx_reading_list_items_post_id ON reading_list_items(post_id);
+CREATE INDEX idx_bookmarks_post_id ON bookmarks(post_id);
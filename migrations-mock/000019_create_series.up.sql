@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS series (
+    id INTEGER PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_series_user_id ON series(user_id);
+
+-- A post is part of at most one series, position orders the parts and is
+-- compacted when a part is removed
+CREATE TABLE IF NOT EXISTS series_posts (
+    post_id INTEGER PRIMARY KEY,
+    series_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    added_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (series_id) REFERENCES series(id) ON DELETE CASCADE,
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_series_posts_position ON series_posts(series_id, position);
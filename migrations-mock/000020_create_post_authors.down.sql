@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS post_authors_owner;
+DROP INDEX IF EXISTS idx_post_authors_owner;
+DROP INDEX IF EXISTS idx_post_authors_user_id;
+DROP TABLE IF EXISTS post_authors;
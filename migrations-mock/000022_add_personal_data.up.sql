@@ -0,0 +1,21 @@
+-- Personal data exports, built in the background and downloadable once.
+-- storage_key is cleared once the archive is deleted from the blob store.
+CREATE TABLE IF NOT EXISTS data_exports (
+    id INTEGER PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'ready', 'failed', 'downloaded', 'expired')),
+    storage_key VARCHAR(255),
+    size INTEGER,
+    error TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    ready_at DATETIME,
+    expires_at DATETIME,
+    downloaded_at DATETIME,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_data_exports_user_id ON data_exports(user_id, id);
+CREATE INDEX idx_data_exports_pending ON data_exports(id) WHERE status == 'pending';
+
+-- Erased accounts are kept anonymized, see the erasure policy
+ALTER TABLE users ADD COLUMN erased_at DATETIME;
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS post_translations_original_update;
+DROP TRIGGER IF EXISTS post_translations_original;
+DROP INDEX IF EXISTS idx_post_translations_original;
+DROP TABLE IF EXISTS post_translations;
+ALTER TABLE posts DROP COLUMN language;
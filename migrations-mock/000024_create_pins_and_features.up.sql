@@ -0,0 +1,23 @@
+-- Posts pinned to the top of their owner's profile listing, positions are
+-- sort keys per user
+CREATE TABLE IF NOT EXISTS post_pins (
+    post_id INTEGER PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_post_pins_user_id ON post_pins(user_id, position);
+
+-- Posts featured by admins at the top of the global listing, positions are
+-- sort keys
+CREATE TABLE IF NOT EXISTS featured_posts (
+    post_id INTEGER PRIMARY KEY,
+    position INTEGER NOT NULL,
+    featured_by INTEGER,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE,
+    FOREIGN KEY (featured_by) REFERENCES users(id) ON DELETE SET NULL
+);
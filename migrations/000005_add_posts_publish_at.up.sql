@@ -0,0 +1,3 @@
+ALTER TABLE posts ADD COLUMN publish_at DATETIME;
+
+CREATE INDEX idx_posts_publish_at ON posts(publish_at) WHERE publish_at IS NOT NULL;
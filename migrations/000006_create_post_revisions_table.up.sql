@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS post_revisions (
+    id INTEGER PRIMARY KEY,
+    post_id INTEGER NOT NULL,
+    revision INTEGER NOT NULL,
+    title VARCHAR(512) NOT NULL,
+    content VARCHAR(2048) NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (post_id, revision),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+-- Existing posts start their history at revision 1
+INSERT INTO post_revisions (post_id, revision, title, content, created_at)
+SELECT id, 1, title, content, created_at FROM posts;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_posts_user_id_created_at;
+DROP TABLE IF EXISTS user_blocks;
+DROP TABLE IF EXISTS follows;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_users_username;
+DROP INDEX IF EXISTS idx_post_mentions_user_id;
+DROP TABLE IF EXISTS post_mentions;
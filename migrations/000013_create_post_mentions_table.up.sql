@@ -0,0 +1,16 @@
+-- One row per @username in a post's content, offsets are in code points
+CREATE TABLE IF NOT EXISTS post_mentions (
+    post_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    PRIMARY KEY (post_id, start_offset),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Lists the posts mentioning a user newest first
+CREATE INDEX idx_post_mentions_user_id ON post_mentions(user_id, post_id);
+
+-- Mentions are resolved by username
+CREATE INDEX idx_users_username ON users(username);
@@ -0,0 +1,2 @@
+-- public, unlisted (readable by link, not listed), followers or private
+ALTER TABLE posts ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_series_posts_position;
+DROP TABLE IF EXISTS series_posts;
+DROP INDEX IF EXISTS idx_series_user_id;
+DROP TABLE IF EXISTS series;
@@ -0,0 +1,28 @@
+-- Authors of a post, posts.user_id stays the owner and is changed together
+-- with the owner row when ownership is transferred. Invites are rows without
+-- accepted_at.
+CREATE TABLE IF NOT EXISTS post_authors (
+    post_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('owner', 'editor')),
+    invited_by INTEGER,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    accepted_at DATETIME,
+    PRIMARY KEY (post_id, user_id),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (invited_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_post_authors_user_id ON post_authors(user_id);
+CREATE UNIQUE INDEX idx_post_authors_owner ON post_authors(post_id) WHERE role == 'owner';
+
+INSERT INTO post_authors (post_id, user_id, role, accepted_at)
+SELECT id, user_id, 'owner', created_at FROM posts;
+
+-- every new post starts with its owner as only author
+CREATE TRIGGER post_authors_owner AFTER INSERT ON posts
+BEGIN
+    INSERT INTO post_authors (post_id, user_id, role, accepted_at)
+    VALUES (NEW.id, NEW.user_id, 'owner', CURRENT_TIMESTAMP);
+END;
@@ -0,0 +1,10 @@
+-- Views per post, day (UTC) and referrer host, '' for direct visits. Counts
+-- are buffered in memory and added in batches, visitors are never stored.
+CREATE TABLE IF NOT EXISTS post_views (
+    post_id INTEGER NOT NULL,
+    day TEXT NOT NULL,
+    referrer TEXT NOT NULL DEFAULT '',
+    views INTEGER NOT NULL,
+    PRIMARY KEY (post_id, day, referrer),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
@@ -0,0 +1,35 @@
+-- Localized variants of a post. The original is the posts row itself,
+-- mirrored here by the triggers below so every language of a post is a row.
+-- content_html caches the rendered content of the other variants, the
+-- original's is cached in posts.
+ALTER TABLE posts ADD COLUMN language TEXT NOT NULL DEFAULT 'en';
+
+CREATE TABLE IF NOT EXISTS post_translations (
+    post_id INTEGER NOT NULL,
+    language TEXT NOT NULL,
+    title TEXT NOT NULL,
+    content TEXT NOT NULL,
+    content_html TEXT,
+    original INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (post_id, language),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_post_translations_original ON post_translations(post_id) WHERE original == 1;
+
+INSERT INTO post_translations (post_id, language, title, content, original, created_at, updated_at)
+SELECT id, language, title, content, 1, created_at, created_at FROM posts;
+
+CREATE TRIGGER post_translations_original AFTER INSERT ON posts
+BEGIN
+    INSERT INTO post_translations (post_id, language, title, content, original)
+    VALUES (NEW.id, NEW.language, NEW.title, NEW.content, 1);
+END;
+
+CREATE TRIGGER post_translations_original_update AFTER UPDATE OF title, content ON posts
+BEGIN
+    UPDATE post_translations SET title = NEW.title, content = NEW.content, updated_at = CURRENT_TIMESTAMP
+    WHERE post_id == NEW.id AND original == 1;
+END;
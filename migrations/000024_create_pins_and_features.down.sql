@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS featured_posts;
+DROP INDEX IF EXISTS idx_post_pins_user_id;
+DROP TABLE IF EXISTS post_pins;
@@ -0,0 +1,11 @@
+-- Tags of a post, lowercase words the author files it under, positions keep
+-- the order they were given in
+CREATE TABLE IF NOT EXISTS post_tags (
+    post_id INTEGER NOT NULL,
+    tag TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (post_id, tag),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_post_tags_tag ON post_tags(tag, post_id);
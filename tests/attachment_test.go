@@ -0,0 +1,436 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/binary"
+	"encoding/hex"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"web/example/internal/domain"
+	"web/example/internal/media"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+	"web/example/internal/signedurl"
+	"web/example/internal/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// jpegWithExif encodes a w x h JPEG carrying an EXIF block with the given
+// orientation and some trailing "location" bytes
+func jpegWithExif(t *testing.T, w, h int, orientation uint16) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+
+	// little endian TIFF header, one IFD with the orientation tag
+	ifd := make([]byte, 2+12+4)
+	binary.LittleEndian.PutUint16(ifd, 1)
+	binary.LittleEndian.PutUint16(ifd[2:], 0x0112)
+	binary.LittleEndian.PutUint16(ifd[4:], 3)
+	binary.LittleEndian.PutUint32(ifd[6:], 1)
+	binary.LittleEndian.PutUint16(ifd[10:], orientation)
+
+	payload := append([]byte("Exif\x00\x00II*\x00\x08\x00\x00\x00"), ifd...)
+	payload = append(payload, "GPS 48.8584 2.2945"...)
+
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+
+	out := []byte{0xFF, 0xD8}
+	out = append(out, segment...)
+	out = append(out, payload...)
+	return append(out, buf.Bytes()[2:]...)
+}
+
+func TestMedia_ProcessImage(t *testing.T) {
+	t.Run("strips exif losslessly", func(t *testing.T) {
+		data := jpegWithExif(t, 64, 32, 1)
+
+		img, err := media.ProcessImage("image/jpeg", data)
+
+		require.NoError(t, err)
+		assert.NotContains(t, string(img.Data), "GPS")
+		assert.Less(t, len(img.Data), len(data))
+		_, err = jpeg.Decode(bytes.NewReader(img.Data))
+		assert.NoError(t, err)
+		assert.Equal(t, []int{64, 32}, []int{img.Width, img.Height})
+		assert.Equal(t, "image/jpeg", img.ThumbnailType)
+	})
+
+	t.Run("applies the orientation before dropping it", func(t *testing.T) {
+		img, err := media.ProcessImage("image/jpeg", jpegWithExif(t, 64, 32, 6))
+
+		require.NoError(t, err)
+		assert.NotContains(t, string(img.Data), "GPS")
+		assert.Equal(t, []int{32, 64}, []int{img.Width, img.Height})
+
+		decoded, err := jpeg.Decode(bytes.NewReader(img.Data))
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 32, 64), decoded.Bounds())
+	})
+
+	t.Run("thumbnails fit the thumbnail size", func(t *testing.T) {
+		img, err := media.ProcessImage("image/jpeg", jpegWithExif(t, 640, 160, 1))
+		require.NoError(t, err)
+
+		thumb, err := jpeg.Decode(bytes.NewReader(img.Thumbnail))
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, media.ThumbnailSize, 80), thumb.Bounds())
+	})
+}
+
+func TestMedia_Sniff(t *testing.T) {
+	assert.Equal(t, "image/png", media.Sniff([]byte("\x89PNG\r\n\x1a\n....")))
+	assert.Equal(t, "application/pdf", media.Sniff([]byte("%PDF-1.7")))
+	assert.Equal(t, "text/plain", media.Sniff([]byte("hello")))
+	assert.Equal(t, "text/html", media.Sniff([]byte("<html><script>")))
+}
+
+func TestSignedURL(t *testing.T) {
+	signer := signedurl.New([]byte("secret"))
+	now := time.Date(2025, 8, 23, 12, 0, 0, 0, time.UTC)
+
+	signed, err := url.Parse(signer.Sign("/attachments/1", now.Add(time.Minute)))
+	require.NoError(t, err)
+	expires, sig := signed.Query().Get("expires"), signed.Query().Get("sig")
+
+	assert.NoError(t, signer.Verify("/attachments/1", expires, sig, now))
+	assert.ErrorIs(t, signer.Verify("/attachments/1", expires, sig, now.Add(2*time.Minute)), signedurl.ErrExpired)
+	assert.ErrorIs(t, signer.Verify("/attachments/2", expires, sig, now), signedurl.ErrInvalidSignature)
+	assert.ErrorIs(t, signer.Verify("/attachments/1", expires+"0", sig, now), signedurl.ErrInvalidSignature)
+	assert.ErrorIs(t, signedurl.New([]byte("other")).Verify("/attachments/1", expires, sig, now), signedurl.ErrInvalidSignature)
+}
+
+// fakeS3 is an in memory S3 endpoint for path style requests, it answers
+// ranged and HEAD requests through http.ServeContent. Like S3 it recomputes
+// the signature of every request and refuses the ones that don't match.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	dates   []string // X-Amz-Date of the accepted requests
+}
+
+func newFakeS3(t *testing.T) (*fakeS3, *httptest.Server) {
+	fake := &fakeS3{objects: map[string][]byte{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return fake, server
+}
+
+// the credentials of the stores using the fake
+const (
+	fakeS3AccessKey = "key"
+	fakeS3Secret    = "secret"
+)
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	if !validSigV4(r, body, fakeS3AccessKey, fakeS3Secret) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.dates = append(f.dates, r.Header.Get("X-Amz-Date"))
+
+	switch r.Method {
+	case http.MethodPut:
+		f.objects[r.URL.Path] = body
+	case http.MethodGet, http.MethodHead:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// validSigV4 checks the Authorization header of a request signed with AWS
+// Signature Version 4 for S3, including the payload hash
+func validSigV4(r *http.Request, body []byte, accessKey, secret string) bool {
+	auth, ok := strings.CutPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ")
+	if !ok {
+		return false
+	}
+
+	fields := map[string]string{}
+	for _, field := range strings.Split(auth, ", ") {
+		name, value, _ := strings.Cut(field, "=")
+		fields[name] = value
+	}
+
+	// Credential=<key>/<date>/<region>/s3/aws4_request
+	credential := strings.Split(fields["Credential"], "/")
+	if len(credential) != 5 || credential[0] != accessKey || credential[3] != "s3" || credential[4] != "aws4_request" {
+		return false
+	}
+	date, region := credential[1], credential[2]
+
+	amzDate := r.Header.Get("X-Amz-Date")
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+	sum := sha256.Sum256(body)
+	if !strings.HasPrefix(amzDate, date+"T") || payloadHash != hex.EncodeToString(sum[:]) {
+		return false
+	}
+
+	signed := strings.Split(fields["SignedHeaders"], ";")
+	for _, required := range []string{"host", "x-amz-content-sha256", "x-amz-date"} {
+		if !slices.Contains(signed, required) {
+			return false
+		}
+	}
+
+	want := sigV4(secret, region, amzDate, r.Method, r.URL.EscapedPath(), r.URL.RawQuery, signed, func(name string) string {
+		if name == "host" {
+			return r.Host
+		}
+		return r.Header.Get(name)
+	}, payloadHash)
+
+	return hmac.Equal([]byte(fields["Signature"]), []byte(want))
+}
+
+// sigV4 computes an S3 request signature as described in the AWS Signature
+// Version 4 documentation, signed lists the header names in sorted order
+func sigV4(secret, region, amzDate, method, path, query string, signed []string, header func(string) string, payloadHash string) string {
+	mac := func(key []byte, data string) []byte {
+		h := hmac.New(sha256.New, key)
+		h.Write([]byte(data))
+		return h.Sum(nil)
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, name := range signed {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(header(name)) + "\n")
+	}
+
+	canonicalRequest := method + "\n" + path + "\n" + query + "\n" + canonicalHeaders.String() + "\n" +
+		strings.Join(signed, ";") + "\n" + payloadHash
+	hashed := sha256.Sum256([]byte(canonicalRequest))
+
+	date := amzDate[:8]
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(hashed[:])
+
+	key := mac([]byte("AWS4"+secret), date)
+	key = mac(key, region)
+	key = mac(key, "s3")
+	key = mac(key, "aws4_request")
+
+	return hex.EncodeToString(mac(key, stringToSign))
+}
+
+func TestSigV4(t *testing.T) {
+	t.Run("matches the AWS example", func(t *testing.T) {
+		// "Example: GET Object" of the S3 Signature Version 4 documentation
+		emptyHash := "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+		headers := map[string]string{
+			"host":                 "examplebucket.s3.amazonaws.com",
+			"range":                "bytes=0-9",
+			"x-amz-content-sha256": emptyHash,
+			"x-amz-date":           "20130524T000000Z",
+		}
+
+		signature := sigV4("wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY", "us-east-1", "20130524T000000Z", http.MethodGet, "/test.txt", "",
+			[]string{"host", "range", "x-amz-content-sha256", "x-amz-date"}, func(name string) string { return headers[name] }, emptyHash)
+
+		assert.Equal(t, "f0e8bdb87c964420e857bd35b5d6ed310bd44f0170aba48dd91039c6036bdb41", signature)
+	})
+
+	t.Run("the store signs at its clock with its credentials", func(t *testing.T) {
+		ctx := context.Background()
+		fake, server := newFakeS3(t)
+		now := func() time.Time { return time.Date(2025, 8, 23, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60)) }
+
+		s3 := &storage.S3Store{Endpoint: server.URL, Bucket: "bucket", Region: "eu-west-1", AccessKeyId: fakeS3AccessKey, SecretAccessKey: fakeS3Secret, Now: now}
+		require.NoError(t, s3.Put(ctx, "posts/1/blob", []byte("0123456789"), "text/plain"))
+		assert.Equal(t, []string{"20250823T100000Z"}, fake.dates)
+
+		s3.SecretAccessKey = "wrong"
+		assert.ErrorContains(t, s3.Put(ctx, "posts/1/blob", []byte("0123456789"), "text/plain"), "403")
+	})
+}
+
+func TestBlobStores(t *testing.T) {
+	ctx := context.Background()
+
+	local, err := storage.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	fake, server := newFakeS3(t)
+	s3 := &storage.S3Store{Endpoint: server.URL, Bucket: "bucket", AccessKeyId: fakeS3AccessKey, SecretAccessKey: fakeS3Secret}
+
+	for name, store := range map[string]storage.BlobStore{"local": local, "s3": s3} {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, store.Put(ctx, "posts/1/blob", []byte("0123456789"), "text/plain"))
+
+			content, err := store.Open(ctx, "posts/1/blob")
+			require.NoError(t, err)
+
+			// seek like http.ServeContent does for a range request
+			size, err := content.Seek(0, io.SeekEnd)
+			require.NoError(t, err)
+			assert.Equal(t, int64(10), size)
+
+			_, err = content.Seek(4, io.SeekStart)
+			require.NoError(t, err)
+			part := make([]byte, 3)
+			_, err = io.ReadFull(content, part)
+			require.NoError(t, err)
+			assert.Equal(t, "456", string(part))
+			require.NoError(t, content.Close())
+
+			require.NoError(t, store.Delete(ctx, "posts/1/blob"))
+			require.NoError(t, store.Delete(ctx, "posts/1/blob"))
+
+			_, err = store.Open(ctx, "posts/1/blob")
+			assert.ErrorIs(t, err, storage.ErrNotFound)
+
+			assert.Error(t, store.Put(ctx, "../escape", []byte("x"), "text/plain"))
+		})
+	}
+
+	assert.Empty(t, fake.objects)
+}
+
+func TestAttachmentService_UploadAttachmentService(t *testing.T) {
+	setup := func(t *testing.T, mockAttachmentRepo *mocks.MockAttachmentRepositoryInterface) *services.AttachmentService {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{Id: 1, Email: "test@example.com"}, nil)
+		mockPostRepo.EXPECT().ReadPost(1).Return(&domain.Post{Id: 1, UserId: 1}, nil)
+
+		store, err := storage.NewLocalStore(t.TempDir())
+		require.NoError(t, err)
+
+		return &services.AttachmentService{
+			Posts:          &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo, Clock: &fakeClock{now: time.Date(2025, 8, 23, 12, 0, 0, 0, time.UTC)}},
+			AttachmentRepo: mockAttachmentRepo,
+			Store:          store,
+			Signer:         signedurl.New([]byte("secret")),
+			MaxSize:        1 << 20,
+			AllowedTypes:   media.DefaultAllowedTypes,
+			URLTTL:         time.Minute,
+		}
+	}
+
+	t.Run("images are stored without metadata with a thumbnail", func(t *testing.T) {
+		mockAttachmentRepo := mocks.NewMockAttachmentRepositoryInterface(t)
+		service := setup(t, mockAttachmentRepo)
+
+		var stored *domain.Attachment
+		mockAttachmentRepo.EXPECT().CreateAttachment(mock.Anything).RunAndReturn(func(a *domain.Attachment) error {
+			a.Id = 7
+			stored = a
+			return nil
+		})
+		mockAttachmentRepo.EXPECT().ReadAttachment(7).RunAndReturn(func(int) (*domain.Attachment, error) {
+			return stored, nil
+		})
+
+		attachment, err := service.UploadAttachmentService(1, "test@example.com", `C:\Users\me\photo.jpg`, bytes.NewReader(jpegWithExif(t, 64, 32, 1)))
+
+		require.NoError(t, err)
+		assert.Equal(t, "photo.jpg", attachment.Filename)
+		assert.Equal(t, "image/jpeg", attachment.ContentType)
+		assert.Equal(t, 64, *attachment.Width)
+		assert.True(t, strings.HasPrefix(attachment.URL, "/attachments/7?"))
+		assert.True(t, strings.HasPrefix(attachment.ThumbnailURL, "/attachments/7/thumbnail?"))
+
+		content, err := service.Store.Open(context.Background(), attachment.StorageKey)
+		require.NoError(t, err)
+		defer content.Close()
+		data, _ := io.ReadAll(content)
+		assert.Equal(t, attachment.Size, int64(len(data)))
+		assert.NotContains(t, string(data), "GPS")
+	})
+
+	t.Run("type is sniffed from the content", func(t *testing.T) {
+		service := setup(t, mocks.NewMockAttachmentRepositoryInterface(t))
+
+		_, err := service.UploadAttachmentService(1, "test@example.com", "cat.png", strings.NewReader("<html><script>alert(1)</script>"))
+
+		assert.ErrorIs(t, err, services.ErrUnsupportedMediaType)
+	})
+
+	t.Run("size limit", func(t *testing.T) {
+		service := setup(t, mocks.NewMockAttachmentRepositoryInterface(t))
+
+		_, err := service.UploadAttachmentService(1, "test@example.com", "big.txt", strings.NewReader(strings.Repeat("a", 1<<20+1)))
+
+		assert.ErrorIs(t, err, services.ErrAttachmentTooLarge)
+	})
+}
+
+func TestAttachmentService_OpenAttachment(t *testing.T) {
+	now := time.Date(2025, 8, 23, 12, 0, 0, 0, time.UTC)
+	signer := signedurl.New([]byte("secret"))
+
+	store, err := storage.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, store.Put(context.Background(), "posts/1/file", []byte("%PDF-1.7"), "application/pdf"))
+
+	attachment := &domain.Attachment{Id: 3, PostId: 1, Filename: "a.pdf", ContentType: "application/pdf", StorageKey: "posts/1/file"}
+
+	open := func(t *testing.T, signedPath string, at time.Time, thumbnail bool) error {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockAttachmentRepo := mocks.NewMockAttachmentRepositoryInterface(t)
+		mockPostRepo.EXPECT().ReadPost(1).Return(&domain.Post{Id: 1, UserId: 1}, nil).Maybe()
+		mockAttachmentRepo.EXPECT().ReadAttachment(3).Return(attachment, nil).Maybe()
+
+		service := &services.AttachmentService{
+			Posts:          &services.PostService{PostRepo: mockPostRepo, Clock: &fakeClock{now: at}},
+			AttachmentRepo: mockAttachmentRepo,
+			Store:          store,
+			Signer:         signer,
+		}
+
+		u, err := url.Parse(signedPath)
+		require.NoError(t, err)
+
+		_, _, content, err := service.OpenAttachment(context.Background(), 3, thumbnail, u.Query().Get("expires"), u.Query().Get("sig"))
+		if err == nil {
+			content.Close()
+		}
+		return err
+	}
+
+	signed := signer.Sign("/attachments/3", now.Add(time.Minute))
+
+	assert.NoError(t, open(t, signed, now, false))
+	assert.ErrorIs(t, open(t, signed, now.Add(time.Hour), false), signedurl.ErrExpired)
+	// a signature for the file is not valid for another route
+	assert.ErrorIs(t, open(t, signed, now, true), signedurl.ErrInvalidSignature)
+	// no thumbnail for a pdf
+	assert.ErrorIs(t, open(t, signer.Sign("/attachments/3/thumbnail", now.Add(time.Minute)), now, true), sql.ErrNoRows)
+}
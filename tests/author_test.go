@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"testing"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostAuthors(t *testing.T) {
+	contentHTML := "<p>Content</p>\n"
+	owner := &domain.User{Id: 1, Email: "owner@example.com"}
+	editor := &domain.User{Id: 2, Email: "editor@example.com"}
+
+	draft := func() *domain.Post {
+		return &domain.Post{
+			Id: 5, UserId: owner.Id, Status: domain.PostStatusDraft, Visibility: domain.VisibilityPublic, ContentHTML: &contentHTML,
+			Authors: []domain.PostAuthor{{UserId: owner.Id, Role: domain.AuthorOwner}, {UserId: editor.Id, Role: domain.AuthorEditor}},
+		}
+	}
+
+	newService := func(t *testing.T) (*services.AuthorService, *mocks.MockPostRepositoryInterface, *mocks.MockUserRepositoryInterface, *mocks.MockPostAuthorRepositoryInterface) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockAuthorRepo := mocks.NewMockPostAuthorRepositoryInterface(t)
+
+		service := &services.AuthorService{
+			Posts:      &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo},
+			AuthorRepo: mockAuthorRepo,
+		}
+
+		return service, mockPostRepo, mockUserRepo, mockAuthorRepo
+	}
+
+	t.Run("editors read drafts they write", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, _ := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(editor.Email).Return(editor, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(draft(), nil)
+
+		post, err := service.Posts.ReadPost(5, editor.Email)
+
+		assert.NoError(t, err)
+		assert.Len(t, post.Authors, 2)
+	})
+
+	t.Run("editors can't change the status", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, _ := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(editor.Email).Return(editor, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(draft(), nil)
+
+		err := service.Posts.ChangePostStatusService(&handlermodel.ChangePostStatusRequest{Id: 5, UserEmail: editor.Email}, domain.PostStatusPublished)
+
+		assert.ErrorContains(t, err, "user does not own this post")
+	})
+
+	t.Run("authors are invited once", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, mockAuthorRepo := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(owner.Email).Return(owner, nil)
+		mockUserRepo.EXPECT().ReadUser(editor.Email).Return(editor, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(draft(), nil)
+		mockAuthorRepo.EXPECT().InviteAuthor(5, editor.Id, owner.Id).Return(false, nil)
+
+		err := service.Invite(5, &handlermodel.InvitePostAuthorRequest{UserEmail: owner.Email, Email: editor.Email})
+
+		assert.ErrorIs(t, err, services.ErrAlreadyAuthor)
+	})
+
+	t.Run("the owner can't leave", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, _ := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(owner.Email).Return(owner, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(draft(), nil)
+
+		err := service.Remove(5, &handlermodel.RemovePostAuthorRequest{UserEmail: owner.Email, Email: owner.Email})
+
+		assert.ErrorIs(t, err, services.ErrOwnerCannotLeave)
+	})
+
+	t.Run("editors can leave", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, mockAuthorRepo := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(editor.Email).Return(editor, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(draft(), nil)
+		mockAuthorRepo.EXPECT().RemoveAuthor(5, editor.Id).Return(true, nil)
+
+		err := service.Remove(5, &handlermodel.RemovePostAuthorRequest{UserEmail: editor.Email, Email: editor.Email})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("ownership goes to editors only", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, mockAuthorRepo := newService(t)
+		other := &domain.User{Id: 3, Email: "other@example.com"}
+
+		mockUserRepo.EXPECT().ReadUser(owner.Email).Return(owner, nil)
+		mockUserRepo.EXPECT().ReadUser(other.Email).Return(other, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(draft(), nil)
+		mockAuthorRepo.EXPECT().TransferOwnership(5, owner.Id, other.Id).Return(false, nil)
+
+		err := service.Transfer(5, &handlermodel.TransferPostRequest{UserEmail: owner.Email, Email: other.Email})
+
+		assert.ErrorIs(t, err, services.ErrNotEditor)
+	})
+}
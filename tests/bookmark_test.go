@@ -0,0 +1,167 @@
+package tests
+
+import (
+	"database/sql"
+	"testing"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBookmarkService_ReadBookmarks(t *testing.T) {
+	contentHTML := "<p>Content</p>\n"
+	hiddenAt := "2025-08-23T12:00:00Z"
+	user := &domain.User{Id: 2, Email: "reader@example.com"}
+
+	mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+	mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+	mockBookmarkRepo := mocks.NewMockBookmarkRepositoryInterface(t)
+
+	mockUserRepo.EXPECT().ReadUser(user.Email).Return(user, nil)
+	mockBookmarkRepo.EXPECT().ReadBookmarks(2, 0, 3).Return([]domain.Bookmark{
+		{Id: 9, PostId: 7},
+		{Id: 8, PostId: 6},
+		{Id: 7, PostId: 5},
+	}, nil)
+	// post 6 was deleted, post 5 hidden by moderation
+	mockPostRepo.EXPECT().ReadPostsByIds([]int{7, 6, 5}).Return([]domain.Post{
+		{Id: 5, UserId: 1, Status: domain.PostStatusPublished, Visibility: domain.VisibilityPublic, HiddenAt: &hiddenAt, ContentHTML: &contentHTML},
+		{Id: 7, UserId: 1, Status: domain.PostStatusPublished, Visibility: domain.VisibilityPublic, ContentHTML: &contentHTML},
+	}, nil)
+
+	service := &services.BookmarkService{
+		Posts:        &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo},
+		BookmarkRepo: mockBookmarkRepo,
+	}
+
+	page, err := service.ReadBookmarks(user.Email, "", 3)
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 3, "bookmarks of unavailable posts are kept")
+	assert.Equal(t, 7, page.Items[0].Post.Id)
+	assert.Nil(t, page.Items[1].Post)
+	assert.Nil(t, page.Items[2].Post)
+	assert.NotEmpty(t, page.NextCursor)
+}
+
+func TestBookmarkService_ReadingLists(t *testing.T) {
+	contentHTML := "<p>Content</p>\n"
+	owner := &domain.User{Id: 2, Email: "owner@example.com"}
+	token := "token"
+
+	post := func(id int, visibility domain.PostVisibility) domain.Post {
+		return domain.Post{Id: id, UserId: 1, Status: domain.PostStatusPublished, Visibility: visibility, ContentHTML: &contentHTML}
+	}
+
+	newService := func(t *testing.T) (*services.BookmarkService, *mocks.MockPostRepositoryInterface, *mocks.MockUserRepositoryInterface, *mocks.MockBookmarkRepositoryInterface) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockBookmarkRepo := mocks.NewMockBookmarkRepositoryInterface(t)
+
+		service := &services.BookmarkService{
+			Posts:        &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo},
+			BookmarkRepo: mockBookmarkRepo,
+		}
+
+		return service, mockPostRepo, mockUserRepo, mockBookmarkRepo
+	}
+
+	t.Run("lists of other users are not found", func(t *testing.T) {
+		service, _, mockUserRepo, mockBookmarkRepo := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser("other@example.com").Return(&domain.User{Id: 3}, nil)
+		mockBookmarkRepo.EXPECT().ReadReadingList(1).Return(&domain.ReadingList{Id: 1, UserId: owner.Id}, nil)
+
+		_, err := service.ReadReadingList(1, "other@example.com")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("shared lists leave out posts anonymous readers can't see", func(t *testing.T) {
+		service, mockPostRepo, _, mockBookmarkRepo := newService(t)
+
+		mockBookmarkRepo.EXPECT().ReadReadingListByToken(token).Return(&domain.ReadingList{Id: 1, UserId: owner.Id, ShareToken: &token, ItemCount: 3}, nil)
+		mockBookmarkRepo.EXPECT().ReadListItems(1).Return([]domain.ReadingListItem{
+			{PostId: 5, Position: 1},
+			{PostId: 6, Position: 2},
+			{PostId: 7, Position: 3},
+		}, nil)
+		mockPostRepo.EXPECT().ReadPostsByIds([]int{5, 6, 7}).Return([]domain.Post{
+			post(5, domain.VisibilityPublic),
+			post(6, domain.VisibilityFollowers),
+			post(7, domain.VisibilityUnlisted),
+		}, nil)
+
+		list, err := service.ReadSharedReadingList(token)
+
+		assert.NoError(t, err)
+		assert.Empty(t, list.SharePath, "only the owner sees the share path")
+		assert.Equal(t, 2, list.ItemCount)
+		assert.Equal(t, []int{5, 7}, []int{list.Items[0].PostId, list.Items[1].PostId})
+		assert.Equal(t, 2, list.Items[1].Position, "positions are renumbered")
+	})
+
+	t.Run("names are unique per user", func(t *testing.T) {
+		service, _, mockUserRepo, mockBookmarkRepo := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(owner.Email).Return(owner, nil)
+		mockBookmarkRepo.EXPECT().ReadReadingLists(owner.Id).Return([]domain.ReadingList{{Id: 1, Name: "Later"}}, nil)
+
+		_, err := service.CreateReadingList(&handlermodel.CreateReadingListRequest{UserEmail: owner.Email, Name: "Later"})
+
+		assert.ErrorIs(t, err, services.ErrReadingListExists)
+	})
+
+	t.Run("making a list public gives it a share path", func(t *testing.T) {
+		service, _, mockUserRepo, mockBookmarkRepo := newService(t)
+		public := true
+
+		mockUserRepo.EXPECT().ReadUser(owner.Email).Return(owner, nil)
+		mockBookmarkRepo.EXPECT().ReadReadingList(1).RunAndReturn(func(int) (*domain.ReadingList, error) {
+			return &domain.ReadingList{Id: 1, UserId: owner.Id, Name: "Later"}, nil
+		}).Once()
+
+		var shared *string
+		mockBookmarkRepo.EXPECT().UpdateReadingList(mock.MatchedBy(func(l *domain.ReadingList) bool {
+			shared = l.ShareToken
+			return assert.NotNil(t, l.ShareToken)
+		})).Return(nil)
+		mockBookmarkRepo.EXPECT().ReadReadingList(1).RunAndReturn(func(int) (*domain.ReadingList, error) {
+			return &domain.ReadingList{Id: 1, UserId: owner.Id, Name: "Later", ShareToken: shared}, nil
+		}).Once()
+
+		list, err := service.UpdateReadingList(1, &handlermodel.UpdateReadingListRequest{UserEmail: owner.Email, Public: &public})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "/lists/"+*shared, list.SharePath)
+	})
+
+	t.Run("full lists take no more posts", func(t *testing.T) {
+		service, _, mockUserRepo, mockBookmarkRepo := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(owner.Email).Return(owner, nil)
+		mockBookmarkRepo.EXPECT().ReadReadingList(1).Return(&domain.ReadingList{Id: 1, UserId: owner.Id, ItemCount: services.MaxReadingListItems}, nil)
+
+		err := service.AddListItem(1, &handlermodel.AddReadingListItemRequest{UserEmail: owner.Email, PostId: 5})
+
+		assert.ErrorIs(t, err, services.ErrReadingListFull)
+	})
+
+	t.Run("posts already in the list are a conflict", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, mockBookmarkRepo := newService(t)
+		p := post(5, domain.VisibilityPublic)
+
+		mockUserRepo.EXPECT().ReadUser(owner.Email).Return(owner, nil)
+		mockBookmarkRepo.EXPECT().ReadReadingList(1).Return(&domain.ReadingList{Id: 1, UserId: owner.Id}, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(&p, nil)
+		mockBookmarkRepo.EXPECT().AddListItem(1, 5, "", 2).Return(false, nil)
+
+		err := service.AddListItem(1, &handlermodel.AddReadingListItemRequest{UserEmail: owner.Email, PostId: 5, Position: 2})
+
+		assert.ErrorIs(t, err, services.ErrAlreadyInList)
+	})
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"testing"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostService_Versions(t *testing.T) {
+	user := &domain.User{Id: 1, Email: "test@example.com"}
+	version := func(v int) *int { return &v }
+
+	update := func(v *int) *handlermodel.UpdatePostRequest {
+		return &handlermodel.UpdatePostRequest{Id: 5, UserEmail: user.Email, NewTitle: "Hello", NewContent: "edited", Version: v}
+	}
+
+	tests := []struct {
+		name    string
+		require bool
+		version *int
+		written int // version the update is compared against, 0 when nothing is written
+		swapped bool
+		wantErr error
+	}{
+		{name: "matching version", version: version(3), written: 3, swapped: true},
+		{name: "stale version", version: version(2), wantErr: services.ErrPreconditionFailed},
+		{name: "unparsable version", version: version(-1), wantErr: services.ErrPreconditionFailed},
+		{name: "any version", version: version(services.AnyVersion), written: 3, swapped: true},
+		{name: "no version", written: 3, swapped: true},
+		{name: "no version when required", require: true, wantErr: services.ErrPreconditionRequired},
+		{name: "changed between read and write", version: version(3), written: 3, wantErr: services.ErrPreconditionFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+			mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+			mockUserRepo.EXPECT().ReadUser(user.Email).Return(user, nil)
+			mockPostRepo.EXPECT().ReadPost(5).Return(&domain.Post{Id: 5, UserId: 1, Title: "Hello", Version: 3}, nil)
+			if tt.written != 0 {
+				mockPostRepo.EXPECT().UpdatePost(5, tt.written, &domain.PostUpdate{Title: "Hello", Content: "edited"}).Return(tt.swapped, nil)
+			}
+
+			service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo, RequireVersion: tt.require}
+
+			err := service.UpdatePostService(update(tt.version))
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("settings are part of the compare-and-swap write", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser(user.Email).Return(user, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(&domain.Post{Id: 5, UserId: 1, Title: "Hello", Visibility: domain.VisibilityPublic, Version: 3}, nil)
+		mockPostRepo.EXPECT().UpdatePost(5, 3, &domain.PostUpdate{Title: "Hello", Content: "edited", Visibility: domain.VisibilityPrivate}).Return(false, nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo}
+
+		req := update(nil)
+		req.NewVisibility = string(domain.VisibilityPrivate)
+
+		assert.ErrorIs(t, service.UpdatePostService(req), services.ErrPreconditionFailed)
+	})
+
+	t.Run("deletes compare the version", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser(user.Email).Return(user, nil).Times(3)
+		mockPostRepo.EXPECT().ReadPost(5).Return(&domain.Post{Id: 5, UserId: 1, Version: 3}, nil).Times(3)
+		mockPostRepo.EXPECT().DeletePost(5, 3).Return(false, nil).Once()
+		mockPostRepo.EXPECT().DeletePost(5, 3).Return(true, nil).Once()
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo}
+
+		err := service.DeletePostService(&handlermodel.DeletePostRequest{Id: 5, UserEmail: user.Email, Version: version(2)})
+		assert.ErrorIs(t, err, services.ErrPreconditionFailed, "stale versions are not written")
+
+		err = service.DeletePostService(&handlermodel.DeletePostRequest{Id: 5, UserEmail: user.Email, Version: version(3)})
+		assert.ErrorIs(t, err, services.ErrPreconditionFailed, "the post changed before the write")
+
+		err = service.DeletePostService(&handlermodel.DeletePostRequest{Id: 5, UserEmail: user.Email, Version: version(3)})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,188 @@
+package tests
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"web/example/internal/domain"
+	"web/example/internal/export"
+	"web/example/internal/filter"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExportFormats(t *testing.T) {
+	publishedAt := time.Date(2025, 3, 2, 10, 0, 0, 0, time.UTC)
+	archive := &export.Archive{
+		Posts: []export.Post{
+			{Id: 1, Slug: "first", Title: "First", ContentFormat: "markdown", Status: "published", Visibility: "public",
+				CreatedAt: publishedAt, PublishedAt: &publishedAt, ContentHash: export.Hash("First", "# Hi\n\n---\nbody\n"), Content: "# Hi\n\n---\nbody\n"},
+			{Id: 2, Slug: "second", Title: "Second", ContentFormat: "plain", Status: "draft", Visibility: "private",
+				CreatedAt: publishedAt, ContentHash: export.Hash("Second", "no newline"), Content: "no newline"},
+		},
+		Series: []export.Series{{Title: "Both", PostIds: []int{2, 1}}},
+	}
+
+	read := func(t *testing.T, b []byte) *export.Archive {
+		a, err := export.Read(b)
+		assert.NoError(t, err)
+
+		for i := range a.Posts {
+			a.Posts[i].Source = ""
+		}
+		for i := range a.Series {
+			a.Series[i].Source = ""
+		}
+		return a
+	}
+
+	t.Run("JSON Lines round trip", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, export.WriteJSONL(&buf, archive))
+
+		assert.Equal(t, archive, read(t, buf.Bytes()))
+	})
+
+	t.Run("ZIP round trip keeps the content as is", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, export.WriteZip(&buf, archive))
+
+		assert.Equal(t, archive, read(t, buf.Bytes()))
+	})
+
+	t.Run("bad lines are reported, the others read", func(t *testing.T) {
+		a, err := export.Read([]byte("{\"type\":\"post\",\"id\":1,\"title\":\"A\",\"content\":\"a\"}\n\nnot json\n{\"type\":\"comment\"}\n"))
+
+		assert.NoError(t, err)
+		assert.Len(t, a.Posts, 1)
+		assert.Equal(t, "line 1", a.Posts[0].Source)
+		assert.Len(t, a.Invalid, 2)
+		assert.Equal(t, "line 3", a.Invalid[0].Source)
+		assert.Equal(t, "line 4", a.Invalid[1].Source)
+	})
+}
+
+func TestExportService_Import(t *testing.T) {
+	user := &domain.User{Id: 2, Email: "bob@example.com"}
+	existing := domain.Post{Id: 40, UserId: 2, Title: "Old", Content: "kept"}
+
+	newService := func(t *testing.T) (*services.ExportService, *mocks.MockImportRepositoryInterface) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockImportRepo := mocks.NewMockImportRepositoryInterface(t)
+		mockSeriesRepo := mocks.NewMockSeriesRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser("bob@example.com").Return(user, nil)
+		mockPostRepo.EXPECT().ReadUserPosts(2).Return([]domain.Post{existing}, nil)
+		mockPostRepo.EXPECT().ReadSlugOwner(mock.Anything).Return(0, nil).Maybe()
+
+		service := &services.ExportService{
+			Posts:      &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo},
+			ImportRepo: mockImportRepo,
+			SeriesRepo: mockSeriesRepo,
+		}
+
+		return service, mockImportRepo
+	}
+
+	archive := func() *export.Archive {
+		return &export.Archive{
+			Posts: []export.Post{
+				{Id: 1, Title: "Part", Content: "one", Source: "line 1"},
+				{Id: 2, Title: "Part", Content: "two", Status: "draft", Source: "line 2"},
+				{Id: 3, Title: "Old", Content: "kept", Source: "line 3"}, // already there
+				{Id: 4, Title: "Part", Content: "one", Source: "line 4"}, // same as line 1
+			},
+			Series: []export.Series{{Title: "Parts", PostIds: []int{2, 1}, Source: "line 5"}},
+		}
+	}
+
+	t.Run("ids are remapped and duplicates skipped", func(t *testing.T) {
+		service, mockImportRepo := newService(t)
+
+		mockImportRepo.EXPECT().ImportPosts(mock.Anything, mock.Anything).
+			Run(func(posts []domain.PostImport, series []domain.SeriesImport) {
+				assert.Len(t, posts, 2)
+				// slugs are unique within the import too
+				assert.Equal(t, "part", posts[0].Post.Slug)
+				assert.Equal(t, "part-2", posts[1].Post.Slug)
+				assert.Equal(t, domain.PostStatusDraft, posts[1].Post.Status)
+				for i, p := range posts {
+					p.Post.Id = 100 + i
+				}
+
+				assert.Len(t, series, 1)
+				assert.Equal(t, []*domain.Post{posts[1].Post, posts[0].Post}, series[0].Posts)
+			}).Return(nil)
+
+		report, err := service.Import("bob@example.com", archive(), false)
+
+		assert.NoError(t, err)
+		assert.True(t, report.Imported)
+		assert.Equal(t, 2, report.Created)
+		assert.Equal(t, 2, report.Duplicates)
+		assert.Equal(t, 1, report.Series)
+		assert.Equal(t, map[int]int{1: 100, 2: 101, 3: 40, 4: 100}, report.PostIds)
+		assert.Equal(t, 40, report.Items[2].PostId)
+		assert.Equal(t, domain.ImportDuplicate, report.Items[3].Result)
+	})
+
+	t.Run("a dry run stores nothing", func(t *testing.T) {
+		service, _ := newService(t)
+
+		report, err := service.Import("bob@example.com", archive(), true)
+
+		assert.NoError(t, err)
+		assert.False(t, report.Imported)
+		assert.Equal(t, 2, report.Created)
+		assert.Equal(t, 1, report.Series)
+	})
+
+	t.Run("a failed item stores nothing", func(t *testing.T) {
+		service, _ := newService(t)
+
+		a := archive()
+		a.Posts[0].Visibility = "everyone"
+		a.Invalid = []export.Invalid{{Source: "line 6", Err: assert.AnError}}
+
+		report, err := service.Import("bob@example.com", a, false)
+
+		assert.NoError(t, err)
+		assert.False(t, report.Imported)
+		assert.Equal(t, 3, report.Failed)
+		assert.Equal(t, `invalid visibility "everyone"`, report.Items[0].Error)
+		// the series names the failed post
+		assert.Equal(t, "post 1 failed to import", report.Items[4].Error)
+	})
+
+	t.Run("rejected content fails the import", func(t *testing.T) {
+		service, _ := newService(t)
+		service.Posts.Filters = filter.Chain{fixedFilter{name: "links", verdict: filter.Reject}}
+
+		report, err := service.Import("bob@example.com", archive(), false)
+
+		assert.NoError(t, err)
+		assert.False(t, report.Imported)
+		assert.Equal(t, "content rejected: links: links reason", report.Items[0].Error)
+	})
+
+	t.Run("posts are held to the request limits", func(t *testing.T) {
+		service, _ := newService(t)
+
+		a := archive()
+		a.Posts[1].Title = strings.Repeat("x", domain.MaxTitleLength+1)
+		// duplicate of line 3 once trimmed
+		a.Posts[0].Title = "  Old "
+		a.Posts[0].Content = "kept"
+
+		report, err := service.Import("bob@example.com", a, true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "title must be at most 512 characters", report.Items[1].Error)
+		assert.Equal(t, domain.ImportDuplicate, report.Items[0].Result)
+	})
+}
@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"database/sql"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+	"web/example/internal/domain"
+	"web/example/internal/feed"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testFeed() *feed.Feed {
+	published := time.Date(2025, 8, 23, 12, 0, 0, 0, time.UTC)
+
+	return &feed.Feed{
+		Title:   "go-web-demo",
+		Link:    "https://blog.example.com/posts",
+		FeedURL: "https://blog.example.com/feed",
+		Updated: published,
+		Items: []feed.Item{{
+			Id:          "https://blog.example.com/posts/1",
+			URL:         "https://blog.example.com/posts/tom-jerry",
+			Title:       "Tom & Jerry",
+			Author:      "alice",
+			ContentHTML: "<p>a < b</p>]]>\x01",
+			Published:   published,
+			Tags:        []string{"go", "web"},
+		}},
+	}
+}
+
+func TestFeed_RSS(t *testing.T) {
+	body, err := feed.RSS(testFeed())
+	assert.NoError(t, err)
+
+	var doc struct {
+		Items []struct {
+			Title       string   `xml:"title"`
+			Guid        string   `xml:"guid"`
+			PubDate     string   `xml:"pubDate"`
+			Categories  []string `xml:"category"`
+			Description string   `xml:"description"`
+		} `xml:"channel>item"`
+	}
+	assert.NoError(t, xml.Unmarshal(body, &doc))
+
+	assert.Len(t, doc.Items, 1)
+	assert.Equal(t, "Tom & Jerry", doc.Items[0].Title)
+	assert.Equal(t, "https://blog.example.com/posts/1", doc.Items[0].Guid)
+	assert.Equal(t, "Sat, 23 Aug 2025 12:00:00 +0000", doc.Items[0].PubDate)
+	assert.Equal(t, []string{"go", "web"}, doc.Items[0].Categories)
+	// characters not allowed in XML are replaced, the markup is escaped text
+	assert.Equal(t, "<p>a < b</p>]]>�", doc.Items[0].Description)
+}
+
+func TestFeed_Atom(t *testing.T) {
+	body, err := feed.Atom(testFeed())
+	assert.NoError(t, err)
+
+	var doc struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		Updated string   `xml:"updated"`
+		Entries []struct {
+			Id         string `xml:"id"`
+			Author     string `xml:"author>name"`
+			Categories []struct {
+				Term string `xml:"term,attr"`
+			} `xml:"category"`
+			Content struct {
+				Type  string `xml:"type,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"content"`
+		} `xml:"entry"`
+	}
+	assert.NoError(t, xml.Unmarshal(body, &doc))
+
+	assert.Equal(t, "2025-08-23T12:00:00Z", doc.Updated)
+	assert.Equal(t, "https://blog.example.com/posts/1", doc.Entries[0].Id)
+	assert.Equal(t, "alice", doc.Entries[0].Author)
+	assert.Len(t, doc.Entries[0].Categories, 2)
+	assert.Equal(t, "web", doc.Entries[0].Categories[1].Term)
+	assert.Equal(t, "html", doc.Entries[0].Content.Type)
+	assert.Equal(t, "<p>a < b</p>]]>�", doc.Entries[0].Content.Value)
+}
+
+func TestFeed_JSON(t *testing.T) {
+	body, err := feed.JSON(&feed.Feed{Title: "empty"})
+	assert.NoError(t, err)
+
+	var doc map[string]any
+	assert.NoError(t, json.Unmarshal(body, &doc))
+	assert.Equal(t, "https://jsonfeed.org/version/1.1", doc["version"])
+	assert.Equal(t, []any{}, doc["items"])
+}
+
+func TestPostService_ReadFeed(t *testing.T) {
+	contentHTML := "<p>Content</p>\n"
+
+	t.Run("global feed is capped", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+
+		mockPostRepo.EXPECT().ReadFeedPosts(0, "", services.MaxFeedLimit).Return([]domain.FeedPost{
+			{Post: domain.Post{Id: 1, ContentHTML: &contentHTML}, AuthorName: "alice"},
+		}, nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mocks.NewMockUserRepositoryInterface(t)}
+
+		author, posts, err := service.ReadFeed("", "", 500)
+
+		assert.NoError(t, err)
+		assert.Nil(t, author)
+		assert.Len(t, posts, 1)
+	})
+
+	t.Run("author feed renders uncached content", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{Id: 1, Username: "test"}, nil)
+		mockPostRepo.EXPECT().ReadFeedPosts(1, "", services.DefaultFeedLimit).Return([]domain.FeedPost{
+			{Post: domain.Post{Id: 2, Content: "Content", ContentFormat: "plain"}, AuthorName: "test"},
+		}, nil)
+		mockPostRepo.EXPECT().CacheContentHTML(2, contentHTML).Return(nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo}
+
+		author, posts, err := service.ReadFeed("test@example.com", "", 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test", author.Username)
+		assert.Equal(t, contentHTML, *posts[0].ContentHTML)
+	})
+
+	t.Run("tag feed", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+
+		mockPostRepo.EXPECT().ReadFeedPosts(0, "go", services.DefaultFeedLimit).Return([]domain.FeedPost{
+			{Post: domain.Post{Id: 1, ContentHTML: &contentHTML, Tags: []string{"go"}}, AuthorName: "alice"},
+		}, nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mocks.NewMockUserRepositoryInterface(t)}
+
+		author, posts, err := service.ReadFeed("", "go", 0)
+
+		assert.NoError(t, err)
+		assert.Nil(t, author)
+		assert.Equal(t, []string{"go"}, posts[0].Tags)
+	})
+
+	t.Run("unknown author", func(t *testing.T) {
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser("nobody@example.com").Return(nil, sql.ErrNoRows)
+
+		service := &services.PostService{PostRepo: mocks.NewMockPostRepositoryInterface(t), UserRepo: mockUserRepo}
+
+		_, _, err := service.ReadFeed("nobody@example.com", "", 0)
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
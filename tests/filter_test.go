@@ -0,0 +1,265 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"web/example/internal/domain"
+	"web/example/internal/filter"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// fixedFilter always returns the same verdict
+type fixedFilter struct {
+	name    string
+	verdict filter.Verdict
+	err     error
+	checked *int
+}
+
+func (f fixedFilter) Name() string { return f.name }
+
+func (f fixedFilter) Check(c *filter.Content) (filter.Verdict, string, error) {
+	if f.checked != nil {
+		*f.checked++
+	}
+	return f.verdict, f.name + " reason", f.err
+}
+
+func TestFilter_Chain(t *testing.T) {
+	t.Run("the strongest verdict wins and reject stops the chain", func(t *testing.T) {
+		var checked int
+		chain := filter.Chain{
+			fixedFilter{name: "a", verdict: filter.Allow},
+			fixedFilter{name: "b", verdict: filter.Flag},
+			fixedFilter{name: "c", verdict: filter.Reject},
+			fixedFilter{name: "d", verdict: filter.Flag, checked: &checked},
+		}
+
+		decision, err := chain.Run(&filter.Content{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, filter.Reject, decision.Verdict)
+		assert.Len(t, decision.Results, 2)
+		assert.Equal(t, "c: c reason", decision.Reason())
+		assert.Zero(t, checked)
+	})
+
+	t.Run("failing filters are skipped", func(t *testing.T) {
+		chain := filter.Chain{
+			fixedFilter{name: "broken", verdict: filter.Reject, err: errors.New("db is down")},
+			fixedFilter{name: "b", verdict: filter.Flag},
+		}
+
+		decision, err := chain.Run(&filter.Content{})
+
+		assert.ErrorContains(t, err, "broken: db is down")
+		assert.Equal(t, filter.Flag, decision.Verdict)
+	})
+
+	t.Run("an empty chain allows", func(t *testing.T) {
+		decision, err := filter.Chain(nil).Run(&filter.Content{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, filter.Allow, decision.Verdict)
+	})
+}
+
+func TestFilter_Rules(t *testing.T) {
+	words, err := filter.NewWordlist([]string{"Casino"}, []string{"crypto"}, []string{`(?i)buy now`}, []string{`\d{3}-\d{4}`})
+	assert.NoError(t, err)
+
+	_, err = filter.NewWordlist(nil, nil, []string{"("}, nil)
+	assert.Error(t, err)
+
+	links := &filter.LinkLimit{FlagAbove: 1, RejectAbove: 2}
+
+	tests := []struct {
+		name   string
+		filter filter.ContentFilter
+		body   string
+		want   filter.Verdict
+	}{
+		{name: "listed word ignoring case", filter: words, body: "Best CASINO in town", want: filter.Reject},
+		{name: "words match whole words only", filter: words, body: "casinos and cryptography", want: filter.Allow},
+		{name: "flagged word", filter: words, body: "about crypto", want: filter.Flag},
+		{name: "reject pattern", filter: words, body: "BUY NOW!", want: filter.Reject},
+		{name: "flag pattern", filter: words, body: "call 555-1234", want: filter.Flag},
+		{name: "links under the limit", filter: links, body: "see https://a.example", want: filter.Allow},
+		{name: "links over the flag limit", filter: links, body: "https://a.example http://b.example", want: filter.Flag},
+		{name: "links over the reject limit", filter: links, body: "https://a https://b HTTPS://c", want: filter.Reject},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, _, err := tt.filter.Check(&filter.Content{Title: "Title", Body: tt.body})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, verdict)
+		})
+	}
+}
+
+func TestFilter_Duplicate(t *testing.T) {
+	body := "The exact same long promotional text posted again"
+	duplicate := &filter.Duplicate{
+		Window: 5,
+		Recent: func(userId int, limit int) ([]domain.Post, error) {
+			assert.Equal(t, 1, userId)
+			assert.Equal(t, 5, limit)
+			return []domain.Post{{Id: 7, Content: body}}, nil
+		},
+	}
+
+	verdict, reason, err := duplicate.Check(&filter.Content{UserId: 1, Body: "  the EXACT same long promotional\ntext posted again "})
+	assert.NoError(t, err)
+	assert.Equal(t, filter.Reject, verdict)
+	assert.Equal(t, "same content as post 7", reason)
+
+	verdict, _, _ = duplicate.Check(&filter.Content{PostId: 7, UserId: 1, Body: body})
+	assert.Equal(t, filter.Allow, verdict, "a post is no duplicate of itself")
+
+	verdict, _, _ = (&filter.Duplicate{Recent: func(int, int) ([]domain.Post, error) {
+		return []domain.Post{{Id: 7, Content: "thanks!"}}, nil
+	}}).Check(&filter.Content{Body: "thanks!"})
+	assert.Equal(t, filter.Allow, verdict, "short bodies are not checked")
+}
+
+func TestFilter_Bayes(t *testing.T) {
+	t.Run("tokens are distinct lowercase words", func(t *testing.T) {
+		assert.Equal(t, []string{"free", "money", "olá"}, filter.Tokenize("FREE money, free! a OK Olá"))
+	})
+
+	t.Run("telling tokens decide", func(t *testing.T) {
+		counts := map[string]domain.TokenCount{
+			"casino": {Spam: 9, Ham: 0},
+			"bonus":  {Spam: 8, Ham: 1},
+			"the":    {Spam: 10, Ham: 10},
+		}
+		assert.Greater(t, filter.Classify(counts, 10, 10), 0.99)
+
+		counts = map[string]domain.TokenCount{
+			"golang": {Spam: 0, Ham: 9},
+			"the":    {Spam: 10, Ham: 10},
+		}
+		assert.Less(t, filter.Classify(counts, 10, 10), 0.1)
+
+		assert.Equal(t, 0.5, filter.Classify(nil, 10, 10), "unknown tokens are neutral")
+	})
+
+	t.Run("untrained classifiers allow", func(t *testing.T) {
+		store := mocks.NewMockClassifierRepositoryInterface(t)
+		store.EXPECT().ReadDocumentCounts().Return(3, 20, nil)
+
+		verdict, _, err := (&filter.Bayes{Store: store, FlagAt: 0.9, MinDocuments: 5}).Check(&filter.Content{Body: "casino"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, filter.Allow, verdict)
+	})
+
+	t.Run("likely spam is flagged", func(t *testing.T) {
+		store := mocks.NewMockClassifierRepositoryInterface(t)
+		store.EXPECT().ReadDocumentCounts().Return(10, 10, nil)
+		store.EXPECT().ReadTokenCounts([]string{"title", "casino", "bonus"}).Return(map[string]domain.TokenCount{
+			"casino": {Spam: 9},
+			"bonus":  {Spam: 8, Ham: 1},
+		}, nil)
+
+		verdict, reason, err := (&filter.Bayes{Store: store, FlagAt: 0.9, MinDocuments: 5}).Check(&filter.Content{Title: "Title", Body: "casino bonus"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, filter.Flag, verdict)
+		assert.True(t, strings.HasPrefix(reason, "spam probability"))
+	})
+}
+
+func TestPostService_ContentFilters(t *testing.T) {
+	user := &domain.User{Id: 1, Email: "test@example.com"}
+
+	t.Run("rejected posts are not stored", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser(user.Email).Return(user, nil)
+
+		service := &services.PostService{
+			PostRepo: mockPostRepo,
+			UserRepo: mockUserRepo,
+			Filters:  filter.Chain{fixedFilter{name: "links", verdict: filter.Reject}},
+		}
+
+		err := service.CreatePostService(&handlermodel.CreatePostRequest{Title: "Hello", Content: "spam", UserEmail: user.Email})
+
+		assert.ErrorIs(t, err, services.ErrContentRejected)
+		assert.ErrorContains(t, err, "links: links reason")
+	})
+
+	t.Run("flagged posts are stored with their flags", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser(user.Email).Return(user, nil)
+		mockPostRepo.EXPECT().ReadSlugOwner("hello").Return(0, nil)
+		mockPostRepo.EXPECT().CreatePost(mock.MatchedBy(func(p *domain.Post) bool {
+			return assert.Equal(t, []domain.PostFlag{{Filter: "bayes", Reason: "bayes reason"}}, p.Flags)
+		})).Return(nil)
+
+		service := &services.PostService{
+			PostRepo: mockPostRepo,
+			UserRepo: mockUserRepo,
+			Filters:  filter.Chain{fixedFilter{name: "bayes", verdict: filter.Flag}},
+		}
+
+		assert.NoError(t, service.CreatePostService(&handlermodel.CreatePostRequest{Title: "Hello", Content: "maybe spam", UserEmail: user.Email}))
+	})
+
+	t.Run("flagged edits are written with their flags", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser(user.Email).Return(user, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(&domain.Post{Id: 5, UserId: 1, Title: "Hello", Version: 2}, nil)
+		mockPostRepo.EXPECT().UpdatePost(5, 2, &domain.PostUpdate{Title: "Hello", Content: "edited", Flags: []domain.PostFlag{{Filter: "wordlist", Reason: "wordlist reason"}}}).Return(true, nil)
+
+		service := &services.PostService{
+			PostRepo: mockPostRepo,
+			UserRepo: mockUserRepo,
+			Filters:  filter.Chain{fixedFilter{name: "wordlist", verdict: filter.Flag}},
+		}
+
+		assert.NoError(t, service.UpdatePostService(&handlermodel.UpdatePostRequest{Id: 5, UserEmail: user.Email, NewTitle: "Hello", NewContent: "edited"}))
+	})
+}
+
+func TestModerationService_TrainsClassifier(t *testing.T) {
+	for action, spam := range map[domain.ModerationAction]bool{
+		domain.ModerationHide:    true,
+		domain.ModerationRestore: false,
+		domain.ModerationDismiss: false,
+	} {
+		t.Run(string(action), func(t *testing.T) {
+			mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+			mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+			mockModerationRepo := mocks.NewMockModerationRepositoryInterface(t)
+			mockClassifierRepo := mocks.NewMockClassifierRepositoryInterface(t)
+
+			mockUserRepo.EXPECT().ReadUser("mod@example.com").Return(&domain.User{Id: 9, Role: domain.RoleModerator}, nil)
+			mockPostRepo.EXPECT().ReadPost(5).Return(&domain.Post{Id: 5, Title: "Cheap", Content: "casino bonus"}, nil)
+			mockModerationRepo.EXPECT().ApplyModeration(5, mock.Anything, action, (*string)(nil)).Return(nil)
+			mockClassifierRepo.EXPECT().Train(5, spam, []string{"cheap", "casino", "bonus"}).Return(nil)
+
+			service := &services.ModerationService{
+				Posts:          &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo},
+				ModerationRepo: mockModerationRepo,
+				ClassifierRepo: mockClassifierRepo,
+			}
+
+			assert.NoError(t, service.Moderate(5, "mod@example.com", action, nil))
+		})
+	}
+}
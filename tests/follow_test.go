@@ -0,0 +1,167 @@
+package tests
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+	"web/example/internal/domain"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFollowService_Follow(t *testing.T) {
+	alice := &domain.User{Id: 1, Email: "alice@example.com"}
+	bob := &domain.User{Id: 2, Email: "bob@example.com"}
+
+	tests := []struct {
+		name      string
+		target    string
+		setupMock func(*mocks.MockUserRepositoryInterface, *mocks.MockFollowRepositoryInterface)
+		wantErr   error
+	}{
+		{
+			name:   "follows",
+			target: bob.Email,
+			setupMock: func(userRepo *mocks.MockUserRepositoryInterface, followRepo *mocks.MockFollowRepositoryInterface) {
+				userRepo.EXPECT().ReadUser(alice.Email).Return(alice, nil)
+				userRepo.EXPECT().ReadUser(bob.Email).Return(bob, nil)
+				followRepo.EXPECT().IsBlocked(1, 2).Return(false, nil)
+				followRepo.EXPECT().Follow(1, 2).Return(true, nil)
+			},
+		},
+		{
+			name:   "can't follow yourself",
+			target: alice.Email,
+			setupMock: func(userRepo *mocks.MockUserRepositoryInterface, followRepo *mocks.MockFollowRepositoryInterface) {
+				userRepo.EXPECT().ReadUser(alice.Email).Return(alice, nil)
+			},
+			wantErr: services.ErrFollowSelf,
+		},
+		{
+			name:   "unknown user",
+			target: "nobody@example.com",
+			setupMock: func(userRepo *mocks.MockUserRepositoryInterface, followRepo *mocks.MockFollowRepositoryInterface) {
+				userRepo.EXPECT().ReadUser(alice.Email).Return(alice, nil)
+				userRepo.EXPECT().ReadUser("nobody@example.com").Return(nil, sql.ErrNoRows)
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name:   "blocked",
+			target: bob.Email,
+			setupMock: func(userRepo *mocks.MockUserRepositoryInterface, followRepo *mocks.MockFollowRepositoryInterface) {
+				userRepo.EXPECT().ReadUser(alice.Email).Return(alice, nil)
+				userRepo.EXPECT().ReadUser(bob.Email).Return(bob, nil)
+				followRepo.EXPECT().IsBlocked(1, 2).Return(true, nil)
+			},
+			wantErr: services.ErrBlocked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+			mockFollowRepo := mocks.NewMockFollowRepositoryInterface(t)
+			tt.setupMock(mockUserRepo, mockFollowRepo)
+
+			service := &services.FollowService{UserRepo: mockUserRepo, FollowRepo: mockFollowRepo}
+
+			err := service.Follow(alice.Email, tt.target)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFollowService_ReadFollowers(t *testing.T) {
+	alice := &domain.User{Id: 1, Email: "alice@example.com"}
+
+	t.Run("full page has a cursor to the next one", func(t *testing.T) {
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockFollowRepo := mocks.NewMockFollowRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser(alice.Email).Return(alice, nil).Times(2)
+		mockFollowRepo.EXPECT().ReadFollowers(1, 0, 2).Return([]domain.Follow{{Id: 9}, {Id: 7}}, nil)
+		mockFollowRepo.EXPECT().ReadFollowers(1, 7, 2).Return([]domain.Follow{{Id: 3}}, nil)
+
+		service := &services.FollowService{UserRepo: mockUserRepo, FollowRepo: mockFollowRepo}
+
+		page, err := service.ReadFollowers(alice.Email, "", 2)
+		assert.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.NotEmpty(t, page.NextCursor)
+
+		page, err = service.ReadFollowers(alice.Email, page.NextCursor, 2)
+		assert.NoError(t, err)
+		assert.Len(t, page.Items, 1)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("the public list carries no email", func(t *testing.T) {
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockFollowRepo := mocks.NewMockFollowRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser(alice.Email).Return(alice, nil)
+		mockFollowRepo.EXPECT().ReadFollowers(1, 0, services.DefaultPageSize).
+			Return([]domain.Follow{{Id: 3, Username: "amy", FollowedAt: "2025-08-23T12:00:00Z"}}, nil)
+
+		service := &services.FollowService{UserRepo: mockUserRepo, FollowRepo: mockFollowRepo}
+
+		page, err := service.ReadFollowers(alice.Email, "", 0)
+		assert.NoError(t, err)
+
+		body, err := json.Marshal(page)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"items": [{"username": "amy", "followedAt": "2025-08-23T12:00:00Z"}]}`, string(body))
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		service := &services.FollowService{
+			UserRepo:   mocks.NewMockUserRepositoryInterface(t),
+			FollowRepo: mocks.NewMockFollowRepositoryInterface(t),
+		}
+
+		_, err := service.ReadFollowers(alice.Email, "not a cursor", 0)
+
+		assert.ErrorIs(t, err, services.ErrInvalidCursor)
+	})
+}
+
+func TestPostService_ReadTimeline(t *testing.T) {
+	contentHTML := "<p>Content</p>\n"
+	alice := &domain.User{Id: 1, Email: "alice@example.com"}
+
+	mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+	mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+	mockUserRepo.EXPECT().ReadUser(alice.Email).Return(alice, nil)
+	mockPostRepo.EXPECT().ReadTimeline(1, time.Time{}, 0, 2).Return([]domain.Post{
+		{Id: 5, UserId: 2, Status: domain.PostStatusPublished, CreatedAt: "2025-08-23T12:00:00Z", ContentHTML: &contentHTML},
+		{Id: 4, UserId: 3, Status: domain.PostStatusPublished, CreatedAt: "2025-08-23T11:00:00Z", ContentHTML: &contentHTML},
+	}, nil).Once()
+
+	// the next page continues after the creation time and id of the last post
+	mockPostRepo.EXPECT().ReadTimeline(1, mock.MatchedBy(func(before time.Time) bool {
+		return before.Equal(time.Date(2025, 8, 23, 11, 0, 0, 0, time.UTC))
+	}), 4, 2).Return([]domain.Post{}, nil).Once()
+
+	service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo}
+
+	page, err := service.ReadTimeline(alice.Email, "", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{5, 4}, []int{page.Items[0].Id, page.Items[1].Id})
+	assert.NotEmpty(t, page.NextCursor)
+
+	page, err = service.ReadTimeline(alice.Email, page.NextCursor, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, page.Items)
+	assert.Empty(t, page.NextCursor)
+}
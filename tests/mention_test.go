@@ -0,0 +1,241 @@
+package tests
+
+import (
+	"testing"
+	"time"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/mention"
+	"web/example/internal/notify"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMention_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []mention.Token
+	}{
+		{
+			name:    "start and middle of the text",
+			content: "@ana meet @bob_2",
+			want:    []mention.Token{{Username: "ana", Start: 0, End: 4}, {Username: "bob_2", Start: 10, End: 16}},
+		},
+		{
+			name:    "offsets count code points",
+			content: "Olá @joão!",
+			want:    []mention.Token{{Username: "joão", Start: 4, End: 9}},
+		},
+		{
+			name:    "sentence punctuation is not part of the name",
+			content: "thanks @ana. and (@bob), @c.d-",
+			want: []mention.Token{
+				{Username: "ana", Start: 7, End: 11},
+				{Username: "bob", Start: 18, End: 22},
+				{Username: "c.d", Start: 25, End: 29},
+			},
+		},
+		{
+			name:    "emails, paths and lone @ are not mentions",
+			content: "ana@example.com x.com/@ana @@ana @ @.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mention.Parse(tt.content))
+		})
+	}
+
+	t.Run("usernames are deduplicated", func(t *testing.T) {
+		assert.Equal(t, []string{"ana", "bob"}, mention.Usernames(mention.Parse("@ana @bob @ana")))
+	})
+}
+
+func TestPostService_Mentions(t *testing.T) {
+	author := &domain.User{Id: 1, Email: "test@example.com", Username: "test"}
+	ana := domain.User{Id: 2, Username: "ana"}
+	bob := domain.User{Id: 3, Username: "bob"}
+
+	newService := func(t *testing.T) (*services.PostService, *mocks.MockPostRepositoryInterface, *mocks.MockUserRepositoryInterface, *mocks.MockNotificationRepositoryInterface) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockFollowRepo := mocks.NewMockFollowRepositoryInterface(t)
+		mockNotificationRepo := mocks.NewMockNotificationRepositoryInterface(t)
+
+		mockFollowRepo.EXPECT().IsBlocked(mock.Anything, author.Id).Return(false, nil).Maybe()
+
+		return &services.PostService{
+			PostRepo: mockPostRepo,
+			UserRepo: mockUserRepo,
+			Notifications: &services.NotificationService{
+				NotificationRepo: mockNotificationRepo,
+				FollowRepo:       mockFollowRepo,
+				Hub:              notify.NewHub(),
+			},
+		}, mockPostRepo, mockUserRepo, mockNotificationRepo
+	}
+
+	expectNotification := func(notificationRepo *mocks.MockNotificationRepositoryInterface, userId int) {
+		postId := 5
+		notificationRepo.EXPECT().CreateNotification(&domain.Notification{UserId: userId, ActorId: author.Id, Type: domain.NotificationMention, PostId: &postId}).
+			Run(func(n *domain.Notification) { n.Id = userId * 10 }).Return(nil).Once()
+		notificationRepo.EXPECT().ReadNotification(userId*10).Return(&domain.Notification{Id: userId * 10, UserId: userId}, nil).Once()
+	}
+
+	t.Run("create stores resolved mentions and notifies", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, mockNotificationRepo := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(author.Email).Return(author, nil)
+		mockPostRepo.EXPECT().ReadSlugOwner("hello").Return(0, nil)
+		// dup is shared by two users and ghost doesn't exist, both are ignored
+		mockUserRepo.EXPECT().ReadUsersByUsernames([]string{"ana", "ghost", "dup"}).Return([]domain.User{
+			ana, {Id: 7, Username: "dup"}, {Id: 8, Username: "dup"},
+		}, nil)
+		mockPostRepo.EXPECT().CreatePost(mock.MatchedBy(func(p *domain.Post) bool {
+			return assert.Equal(t, []domain.Mention{{UserId: 2, Username: "ana", Start: 3, End: 7}}, p.Mentions)
+		})).Run(func(p *domain.Post) { p.Id = 5 }).Return(nil)
+		expectNotification(mockNotificationRepo, ana.Id)
+
+		err := service.CreatePostService(&handlermodel.CreatePostRequest{
+			Title:     "Hello",
+			Content:   "hi @ana @ghost @dup",
+			UserEmail: author.Email,
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("drafts don't notify", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, _ := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(author.Email).Return(author, nil)
+		mockPostRepo.EXPECT().ReadSlugOwner("hello").Return(0, nil)
+		mockUserRepo.EXPECT().ReadUsersByUsernames([]string{"ana"}).Return([]domain.User{ana}, nil)
+		mockPostRepo.EXPECT().CreatePost(mock.Anything).Return(nil)
+
+		err := service.CreatePostService(&handlermodel.CreatePostRequest{
+			Title:     "Hello",
+			Content:   "@ana",
+			Status:    "draft",
+			UserEmail: author.Email,
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("publishing a draft notifies", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, mockNotificationRepo := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(author.Email).Return(author, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(&domain.Post{
+			Id:       5,
+			UserId:   1,
+			Content:  "@ana",
+			Status:   domain.PostStatusDraft,
+			Mentions: []domain.Mention{{UserId: 2, Username: "ana", Start: 0, End: 4}},
+		}, nil)
+		mockPostRepo.EXPECT().UpdatePostStatus(5, domain.PostStatusPublished).Return(nil)
+		expectNotification(mockNotificationRepo, ana.Id)
+
+		err := service.ChangePostStatusService(&handlermodel.ChangePostStatusRequest{Id: 5, UserEmail: author.Email}, domain.PostStatusPublished)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("scheduled drafts notify once published", func(t *testing.T) {
+		service, mockPostRepo, _, mockNotificationRepo := newService(t)
+		now := time.Date(2025, 8, 23, 12, 0, 0, 0, time.UTC)
+
+		mockPostRepo.EXPECT().PublishDuePosts(now).Return([]int{5}, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(&domain.Post{
+			Id:       5,
+			UserId:   1,
+			Content:  "@ana",
+			Status:   domain.PostStatusPublished,
+			Mentions: []domain.Mention{{UserId: 2, Username: "ana", Start: 0, End: 4}},
+		}, nil)
+		expectNotification(mockNotificationRepo, ana.Id)
+
+		scheduler := &services.PostScheduler{PostRepo: mockPostRepo, Posts: service, Clock: &fakeClock{now: now}}
+
+		n, err := scheduler.RunOnce()
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+	})
+
+	t.Run("users who can't read the post are not notified", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, _ := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(author.Email).Return(author, nil)
+		mockPostRepo.EXPECT().ReadSlugOwner("hello").Return(0, nil)
+		mockUserRepo.EXPECT().ReadUsersByUsernames([]string{"ana"}).Return([]domain.User{ana}, nil)
+		mockPostRepo.EXPECT().CreatePost(mock.Anything).Return(nil)
+
+		err := service.CreatePostService(&handlermodel.CreatePostRequest{
+			Title:      "Hello",
+			Content:    "@ana",
+			Visibility: "private",
+			UserEmail:  author.Email,
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("edits replace mentions and notify only new ones", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, mockNotificationRepo := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(author.Email).Return(author, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(&domain.Post{
+			Id:       5,
+			UserId:   1,
+			Title:    "Hello",
+			Content:  "@ana",
+			Status:   domain.PostStatusPublished,
+			Mentions: []domain.Mention{{UserId: 2, Username: "ana", Start: 0, End: 4}},
+		}, nil)
+		mockUserRepo.EXPECT().ReadUsersByUsernames([]string{"bob", "ana"}).Return([]domain.User{ana, bob}, nil)
+		mockPostRepo.EXPECT().UpdatePost(5, 0, &domain.PostUpdate{Title: "Hello", Content: "@bob and @ana", Mentions: []domain.Mention{
+			{UserId: 3, Username: "bob", Start: 0, End: 4},
+			{UserId: 2, Username: "ana", Start: 9, End: 13},
+		}}).Return(true, nil)
+		expectNotification(mockNotificationRepo, bob.Id)
+
+		err := service.UpdatePostService(&handlermodel.UpdatePostRequest{
+			Id:         5,
+			UserEmail:  author.Email,
+			NewTitle:   "Hello",
+			NewContent: "@bob and @ana",
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("removing every mention clears them", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, _ := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(author.Email).Return(author, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(&domain.Post{
+			Id:       5,
+			UserId:   1,
+			Title:    "Hello",
+			Content:  "@ana",
+			Mentions: []domain.Mention{{UserId: 2, Username: "ana", Start: 0, End: 4}},
+		}, nil)
+		mockPostRepo.EXPECT().UpdatePost(5, 0, &domain.PostUpdate{Title: "Hello", Content: "nobody"}).Return(true, nil)
+
+		err := service.UpdatePostService(&handlermodel.UpdatePostRequest{
+			Id:         5,
+			UserEmail:  author.Email,
+			NewTitle:   "Hello",
+			NewContent: "nobody",
+		})
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"database/sql"
+	"testing"
+	"web/example/internal/domain"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestModerationService_ReportPost(t *testing.T) {
+	author := &domain.User{Id: 1, Email: "author@example.com"}
+	reporter := &domain.User{Id: 2, Email: "reporter@example.com"}
+	hiddenAt := "2025-08-23T12:00:00Z"
+
+	tests := []struct {
+		name      string
+		email     string
+		post      *domain.Post
+		setupMock func(*mocks.MockModerationRepositoryInterface)
+		wantErr   error
+	}{
+		{
+			name:  "below the threshold",
+			email: reporter.Email,
+			post:  &domain.Post{Id: 5, UserId: 1, Status: domain.PostStatusPublished},
+			setupMock: func(repo *mocks.MockModerationRepositoryInterface) {
+				repo.EXPECT().CreateReport(&domain.PostReport{PostId: 5, ReporterId: 2, Reason: domain.ReportSpam}).Return(true, nil)
+				repo.EXPECT().CountOpenReports(5).Return(1, nil)
+			},
+		},
+		{
+			name:  "reaching the threshold hides the post",
+			email: reporter.Email,
+			post:  &domain.Post{Id: 5, UserId: 1, Status: domain.PostStatusPublished},
+			setupMock: func(repo *mocks.MockModerationRepositoryInterface) {
+				repo.EXPECT().CreateReport(mock.Anything).Return(true, nil)
+				repo.EXPECT().CountOpenReports(5).Return(2, nil)
+				repo.EXPECT().ApplyModeration(5, (*int)(nil), domain.ModerationAutoHide, (*string)(nil)).Return(nil)
+			},
+		},
+		{
+			name:  "reported twice",
+			email: reporter.Email,
+			post:  &domain.Post{Id: 5, UserId: 1, Status: domain.PostStatusPublished},
+			setupMock: func(repo *mocks.MockModerationRepositoryInterface) {
+				repo.EXPECT().CreateReport(mock.Anything).Return(false, nil)
+			},
+			wantErr: services.ErrAlreadyReported,
+		},
+		{
+			name:      "own post",
+			email:     author.Email,
+			post:      &domain.Post{Id: 5, UserId: 1, Status: domain.PostStatusPublished},
+			setupMock: func(repo *mocks.MockModerationRepositoryInterface) {},
+			wantErr:   services.ErrReportOwnPost,
+		},
+		{
+			name:      "hidden posts can't be seen so they can't be reported",
+			email:     reporter.Email,
+			post:      &domain.Post{Id: 5, UserId: 1, Status: domain.PostStatusPublished, HiddenAt: &hiddenAt},
+			setupMock: func(repo *mocks.MockModerationRepositoryInterface) {},
+			wantErr:   sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+			mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+			mockModerationRepo := mocks.NewMockModerationRepositoryInterface(t)
+
+			mockUserRepo.EXPECT().ReadUser(author.Email).Return(author, nil).Maybe()
+			mockUserRepo.EXPECT().ReadUser(reporter.Email).Return(reporter, nil).Maybe()
+			mockPostRepo.EXPECT().ReadPost(5).Return(tt.post, nil)
+			tt.setupMock(mockModerationRepo)
+
+			service := &services.ModerationService{
+				Posts:          &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo},
+				ModerationRepo: mockModerationRepo,
+				HideThreshold:  2,
+			}
+
+			err := service.ReportPost(5, tt.email, domain.ReportSpam, nil)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestModerationService_Moderate(t *testing.T) {
+	t.Run("moderators act on posts", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockModerationRepo := mocks.NewMockModerationRepositoryInterface(t)
+		note := "spam"
+		moderatorId := 9
+
+		mockUserRepo.EXPECT().ReadUser("mod@example.com").Return(&domain.User{Id: 9, Role: domain.RoleModerator}, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(&domain.Post{Id: 5}, nil)
+		mockModerationRepo.EXPECT().ApplyModeration(5, &moderatorId, domain.ModerationHide, &note).Return(nil)
+
+		service := &services.ModerationService{
+			Posts:          &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo},
+			ModerationRepo: mockModerationRepo,
+		}
+
+		assert.NoError(t, service.Moderate(5, "mod@example.com", domain.ModerationHide, &note))
+	})
+
+	t.Run("other users can't", func(t *testing.T) {
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser("user@example.com").Return(&domain.User{Id: 2, Role: domain.RoleUser}, nil)
+
+		service := &services.ModerationService{
+			Posts:          &services.PostService{PostRepo: mocks.NewMockPostRepositoryInterface(t), UserRepo: mockUserRepo},
+			ModerationRepo: mocks.NewMockModerationRepositoryInterface(t),
+		}
+
+		assert.ErrorIs(t, service.Moderate(5, "user@example.com", domain.ModerationRestore, nil), services.ErrNotModerator)
+	})
+
+	t.Run("automatic hides are not a moderator action", func(t *testing.T) {
+		service := &services.ModerationService{}
+
+		assert.ErrorIs(t, service.Moderate(5, "mod@example.com", domain.ModerationAutoHide, nil), services.ErrModerationAction)
+	})
+}
+
+func TestPostService_HiddenPosts(t *testing.T) {
+	contentHTML := "<p>Content</p>\n"
+	hiddenAt := "2025-08-23T12:00:00Z"
+	hidden := domain.Post{Id: 5, UserId: 1, Status: domain.PostStatusPublished, HiddenAt: &hiddenAt, ContentHTML: &contentHTML}
+	visible := domain.Post{Id: 6, UserId: 1, Status: domain.PostStatusPublished, ContentHTML: &contentHTML}
+
+	viewers := []struct {
+		email   string
+		user    *domain.User
+		canRead bool
+	}{
+		{email: ""},
+		{email: "other@example.com", user: &domain.User{Id: 2}},
+		{email: "author@example.com", user: &domain.User{Id: 1}, canRead: true},
+		{email: "mod@example.com", user: &domain.User{Id: 3, Role: domain.RoleModerator}, canRead: true},
+	}
+
+	for _, v := range viewers {
+		t.Run("viewer "+v.email, func(t *testing.T) {
+			mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+			mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+			if v.user != nil {
+				mockUserRepo.EXPECT().ReadUser(v.email).Return(v.user, nil)
+			}
+			mockPostRepo.EXPECT().ReadPost(5).Return(&hidden, nil)
+			mockPostRepo.EXPECT().ReadFeaturedPosts().Return(nil, nil)
+			mockPostRepo.EXPECT().ReadAllPosts(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]domain.Post{hidden, visible}, nil)
+
+			service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo}
+
+			_, err := service.ReadPost(5, v.email)
+			page, listErr := service.ReadAllPosts(v.email, "", 0)
+			assert.NoError(t, listErr)
+			posts := page.Items
+
+			if v.canRead {
+				assert.NoError(t, err)
+				assert.Len(t, posts, 2)
+			} else {
+				assert.ErrorIs(t, err, sql.ErrNoRows)
+				assert.Equal(t, 6, posts[0].Id)
+				assert.Len(t, posts, 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"web/example/internal/domain"
+	"web/example/internal/http/handler"
+	"web/example/internal/notify"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifyHub(t *testing.T) {
+	t.Run("fans out to every subscription of the user", func(t *testing.T) {
+		hub := notify.NewHub()
+		a, b, other := hub.Subscribe(1), hub.Subscribe(1), hub.Subscribe(2)
+
+		hub.Publish(domain.Notification{Id: 7, UserId: 1})
+
+		assert.Equal(t, 7, (<-a.C).Id)
+		assert.Equal(t, 7, (<-b.C).Id)
+		assert.Empty(t, other.C)
+
+		hub.Unsubscribe(a)
+		hub.Unsubscribe(a)
+		assert.Equal(t, 1, hub.Subscribers(1))
+
+		_, open := <-a.C
+		assert.False(t, open)
+	})
+
+	t.Run("drops subscriptions that fall behind", func(t *testing.T) {
+		hub := notify.NewHub()
+		slow := hub.Subscribe(1)
+
+		for i := range 100 {
+			hub.Publish(domain.Notification{Id: i + 1, UserId: 1})
+		}
+
+		assert.Equal(t, 0, hub.Subscribers(1))
+
+		received := 0
+		for range slow.C {
+			received++
+		}
+		assert.Less(t, received, 100)
+	})
+}
+
+func TestNotificationService_Notify(t *testing.T) {
+	t.Run("stores and publishes", func(t *testing.T) {
+		mockNotificationRepo := mocks.NewMockNotificationRepositoryInterface(t)
+		mockFollowRepo := mocks.NewMockFollowRepositoryInterface(t)
+		hub := notify.NewHub()
+		sub := hub.Subscribe(2)
+
+		mockFollowRepo.EXPECT().IsBlocked(2, 1).Return(false, nil)
+		mockNotificationRepo.EXPECT().CreateNotification(&domain.Notification{UserId: 2, ActorId: 1, Type: domain.NotificationFollow}).
+			Run(func(n *domain.Notification) { n.Id = 5 }).Return(nil)
+		mockNotificationRepo.EXPECT().ReadNotification(5).Return(&domain.Notification{Id: 5, UserId: 2, ActorUsername: "alice"}, nil)
+
+		service := &services.NotificationService{NotificationRepo: mockNotificationRepo, FollowRepo: mockFollowRepo, Hub: hub}
+
+		assert.NoError(t, service.Notify(2, 1, domain.NotificationFollow, nil))
+		assert.Equal(t, "alice", (<-sub.C).ActorUsername)
+	})
+
+	t.Run("skips own actions and blocked users", func(t *testing.T) {
+		mockFollowRepo := mocks.NewMockFollowRepositoryInterface(t)
+
+		mockFollowRepo.EXPECT().IsBlocked(2, 3).Return(true, nil)
+
+		service := &services.NotificationService{NotificationRepo: mocks.NewMockNotificationRepositoryInterface(t), FollowRepo: mockFollowRepo}
+
+		assert.NoError(t, service.Notify(2, 2, domain.NotificationFollow, nil))
+		assert.NoError(t, service.Notify(2, 3, domain.NotificationFollow, nil))
+	})
+
+	t.Run("nil service", func(t *testing.T) {
+		var service *services.NotificationService
+
+		assert.NoError(t, service.Notify(2, 1, domain.NotificationFollow, nil))
+	})
+}
+
+func TestNotificationService_ReadNotifications(t *testing.T) {
+	mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+	mockNotificationRepo := mocks.NewMockNotificationRepositoryInterface(t)
+
+	mockUserRepo.EXPECT().ReadUser("bob@example.com").Return(&domain.User{Id: 2}, nil)
+	mockNotificationRepo.EXPECT().ReadNotifications(2, true, 0, services.DefaultPageSize).Return(nil, nil)
+	mockNotificationRepo.EXPECT().CountUnread(2).Return(0, nil)
+
+	service := &services.NotificationService{UserRepo: mockUserRepo, NotificationRepo: mockNotificationRepo}
+
+	page, err := service.ReadNotifications("bob@example.com", true, "", 0)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, page.Items)
+	assert.Empty(t, page.NextCursor)
+}
+
+func TestNotificationHandler_Stream(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+	mockNotificationRepo := mocks.NewMockNotificationRepositoryInterface(t)
+	hub := notify.NewHub()
+
+	mockUserRepo.EXPECT().ReadUser("bob@example.com").Return(&domain.User{Id: 2}, nil)
+	// reconnecting after 3 replays what was missed
+	mockNotificationRepo.EXPECT().ReadNotificationsAfter(2, 3, services.MaxPageSize).
+		Return([]domain.Notification{{Id: 4, UserId: 2, Type: domain.NotificationFollow}}, nil)
+
+	service := &services.NotificationService{UserRepo: mockUserRepo, NotificationRepo: mockNotificationRepo, Hub: hub}
+
+	r := gin.New()
+	r.GET("/notifications/stream", handler.NewNotificationHandler(service).Stream)
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/notifications/stream?userEmail=bob@example.com", nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", "3")
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	lines := bufio.NewScanner(res.Body)
+	readEvent := func() string {
+		var event []string
+		for lines.Scan() && lines.Text() != "" {
+			event = append(event, lines.Text())
+		}
+		return strings.Join(event, "\n")
+	}
+
+	assert.Equal(t, "retry: 3000", readEvent())
+	assert.Contains(t, readEvent(), "id: 4\nevent: notification\n")
+
+	// the replayed notification is not sent twice
+	hub.Publish(domain.Notification{Id: 4, UserId: 2})
+	hub.Publish(domain.Notification{Id: 5, UserId: 2})
+	assert.Contains(t, readEvent(), "id: 5\n")
+
+	// disconnecting removes the subscription
+	cancel()
+	assert.Eventually(t, func() bool { return hub.Subscribers(2) == 0 }, time.Second, 10*time.Millisecond)
+}
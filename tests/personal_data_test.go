@@ -0,0 +1,178 @@
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+	"web/example/internal/signedurl"
+	"web/example/internal/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPersonalDataService_Exports(t *testing.T) {
+	now := time.Date(2025, 8, 23, 12, 0, 0, 0, time.UTC)
+
+	newService := func(t *testing.T) (*services.PersonalDataService, *mocks.MockDataExportRepositoryInterface) {
+		store, err := storage.NewLocalStore(t.TempDir())
+		assert.NoError(t, err)
+
+		mockDataExportRepo := mocks.NewMockDataExportRepositoryInterface(t)
+
+		return &services.PersonalDataService{
+			DataExportRepo: mockDataExportRepo,
+			Store:          store,
+			Signer:         signedurl.New([]byte("secret")),
+			Clock:          &fakeClock{now: now},
+			TTL:            time.Hour,
+		}, mockDataExportRepo
+	}
+
+	t.Run("pending exports are built into a zip of the sections and attachment files", func(t *testing.T) {
+		service, mockDataExportRepo := newService(t)
+
+		assert.NoError(t, service.Store.Put(context.Background(), "posts/1/abc", []byte("image bytes"), "image/png"))
+
+		mockDataExportRepo.EXPECT().ReadPendingDataExports(mock.Anything).Return([]domain.DataExport{{Id: 3, UserId: 7}}, nil)
+		mockDataExportRepo.EXPECT().ReadPersonalData(7).Return([]domain.DataSection{
+			{Name: "profile", Rows: []map[string]any{{"email": "ana@example.com"}}},
+			{Name: "attachments", Rows: []map[string]any{{"id": 9, "filename": "cat.png", "storage_key": "posts/1/abc"}}},
+		}, nil)
+
+		var key string
+		mockDataExportRepo.EXPECT().MarkDataExportReady(3, mock.Anything, mock.Anything, now.Add(time.Hour)).
+			Run(func(_ int, storageKey string, _ int64, _ time.Time) { key = storageKey }).Return(true, nil)
+		mockDataExportRepo.EXPECT().ReadStaleDataExports(now).Return(nil, nil)
+
+		built, deleted, err := service.RunOnce()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, built)
+		assert.Equal(t, 0, deleted)
+
+		content, err := service.Store.Open(context.Background(), key)
+		assert.NoError(t, err)
+		b, _ := io.ReadAll(content)
+		content.Close()
+
+		zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+		assert.NoError(t, err)
+
+		files := map[string]string{}
+		for _, f := range zr.File {
+			rc, _ := f.Open()
+			data, _ := io.ReadAll(rc)
+			rc.Close()
+			files[f.Name] = string(data)
+		}
+
+		assert.Contains(t, files["profile.json"], "ana@example.com")
+		assert.Equal(t, "image bytes", files["attachments/9-cat.png"])
+	})
+
+	t.Run("archives of downloaded exports are deleted", func(t *testing.T) {
+		service, mockDataExportRepo := newService(t)
+
+		key := "data-exports/3-abc.zip"
+		assert.NoError(t, service.Store.Put(context.Background(), key, []byte("zip"), "application/zip"))
+
+		mockDataExportRepo.EXPECT().ReadPendingDataExports(mock.Anything).Return(nil, nil)
+		mockDataExportRepo.EXPECT().ReadStaleDataExports(now).Return([]domain.DataExport{{Id: 3, Status: domain.DataExportDownloaded, StorageKey: &key}}, nil)
+		mockDataExportRepo.EXPECT().ClearDataExportBlob(3).Return(nil)
+
+		_, deleted, err := service.RunOnce()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+		_, err = service.Store.Open(context.Background(), key)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("an export downloads once", func(t *testing.T) {
+		service, mockDataExportRepo := newService(t)
+
+		key := "data-exports/3-abc.zip"
+		assert.NoError(t, service.Store.Put(context.Background(), key, []byte("zip"), "application/zip"))
+
+		signed, err := url.Parse(service.Signer.Sign("/data-exports/3/download", now.Add(time.Hour)))
+		assert.NoError(t, err)
+		expires, sig := signed.Query().Get("expires"), signed.Query().Get("sig")
+
+		mockDataExportRepo.EXPECT().ReadDataExport(3).Return(&domain.DataExport{Id: 3, Status: domain.DataExportReady, StorageKey: &key}, nil)
+		mockDataExportRepo.EXPECT().ClaimDataExportDownload(3, now).Return(true, nil).Once()
+		mockDataExportRepo.EXPECT().ClaimDataExportDownload(3, now).Return(false, nil).Once()
+
+		content, err := service.Download(context.Background(), 3, expires, sig)
+		assert.NoError(t, err)
+		content.Close()
+
+		_, err = service.Download(context.Background(), 3, expires, sig)
+		assert.ErrorIs(t, err, services.ErrDataExportGone)
+
+		// the signature covers the export id
+		_, err = service.Download(context.Background(), 4, expires, sig)
+		assert.ErrorIs(t, err, signedurl.ErrInvalidSignature)
+	})
+}
+
+func TestPersonalDataService_EraseAccount(t *testing.T) {
+	now := time.Date(2025, 8, 23, 12, 0, 0, 0, time.UTC)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("Secret123!"), bcrypt.MinCost)
+	assert.NoError(t, err)
+	user := &domain.User{Id: 4, Email: "dora@example.com", Password_hash: base64.StdEncoding.EncodeToString(hash)}
+	steps := []domain.ErasureStep{{Data: "posts", Action: domain.ErasureDelete, Rows: 2}}
+
+	newService := func(t *testing.T) (*services.PersonalDataService, *mocks.MockUserRepositoryInterface) {
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockUserRepo.EXPECT().ReadUser("dora@example.com").Return(user, nil)
+
+		return &services.PersonalDataService{
+			UserRepo:             mockUserRepo,
+			Clock:                &fakeClock{now: now},
+			AccountDeletionGrace: 14 * 24 * time.Hour,
+		}, mockUserRepo
+	}
+
+	t.Run("a wrong password erases nothing", func(t *testing.T) {
+		service, _ := newService(t)
+
+		_, err := service.EraseAccount(&handlermodel.EraseAccountRequest{Email: "dora@example.com", Password: "nope"})
+
+		assert.ErrorIs(t, err, services.ErrWrongPassword)
+	})
+
+	t.Run("a dry run only returns the plan", func(t *testing.T) {
+		service, mockUserRepo := newService(t)
+		mockUserRepo.EXPECT().ReadErasurePlan(4).Return(steps, nil)
+
+		plan, err := service.EraseAccount(&handlermodel.EraseAccountRequest{Email: "dora@example.com", Password: "Secret123!", DryRun: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, steps, plan.Steps)
+		assert.Empty(t, plan.EraseAt)
+	})
+
+	t.Run("erasure starts the grace period", func(t *testing.T) {
+		service, mockUserRepo := newService(t)
+		mockUserRepo.EXPECT().ReadErasurePlan(4).Return(steps, nil)
+		mockUserRepo.EXPECT().DeleteUser("dora@example.com").Return(nil)
+
+		plan, err := service.EraseAccount(&handlermodel.EraseAccountRequest{Email: "dora@example.com", Password: "Secret123!"})
+
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(plan.EraseAt, "2025-09-06T12:00:00"))
+	})
+}
@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"testing"
+	"time"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPinService_Pin(t *testing.T) {
+	owner := &domain.User{Id: 1, Email: "test@example.com"}
+
+	newService := func(t *testing.T, post *domain.Post) (*services.PinService, *mocks.MockPinRepositoryInterface) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockPinRepo := mocks.NewMockPinRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser("test@example.com").Return(owner, nil)
+		mockPostRepo.EXPECT().ReadPost(1).Return(post, nil)
+
+		return &services.PinService{
+			Posts:    &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo},
+			PinRepo:  mockPinRepo,
+			PinLimit: services.DefaultPinLimit,
+		}, mockPinRepo
+	}
+	req := &handlermodel.PinPostRequest{UserEmail: "test@example.com", Position: 1}
+
+	t.Run("success", func(t *testing.T) {
+		service, mockPinRepo := newService(t, &domain.Post{Id: 1, UserId: 1, Status: domain.PostStatusPublished})
+		mockPinRepo.EXPECT().PinPost(1, 1, 1, services.DefaultPinLimit).Return(true, nil)
+
+		assert.NoError(t, service.Pin(1, req))
+	})
+
+	t.Run("the limit is enforced", func(t *testing.T) {
+		service, mockPinRepo := newService(t, &domain.Post{Id: 1, UserId: 1, Status: domain.PostStatusPublished})
+		mockPinRepo.EXPECT().PinPost(1, 1, 1, services.DefaultPinLimit).Return(false, nil)
+
+		assert.ErrorIs(t, service.Pin(1, req), services.ErrPinLimit)
+	})
+
+	t.Run("drafts can't be pinned", func(t *testing.T) {
+		service, _ := newService(t, &domain.Post{Id: 1, UserId: 1, Status: domain.PostStatusDraft})
+
+		assert.ErrorIs(t, service.Pin(1, req), services.ErrNotListed)
+	})
+
+	t.Run("only the owner pins", func(t *testing.T) {
+		service, _ := newService(t, &domain.Post{Id: 1, UserId: 2, Status: domain.PostStatusPublished})
+
+		assert.Error(t, service.Pin(1, req))
+	})
+}
+
+func TestPinService_Feature(t *testing.T) {
+	req := &handlermodel.FeaturePostRequest{UserEmail: "test@example.com"}
+
+	t.Run("success", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockPinRepo := mocks.NewMockPinRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{Id: 1, Role: domain.RoleAdmin}, nil)
+		mockPostRepo.EXPECT().ReadPost(4).Return(&domain.Post{Id: 4, UserId: 3, Status: domain.PostStatusPublished}, nil)
+		mockPinRepo.EXPECT().FeaturePost(4, 1, 0, services.DefaultFeatureLimit).Return(true, nil)
+
+		service := &services.PinService{
+			Posts:        &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo},
+			PinRepo:      mockPinRepo,
+			FeatureLimit: services.DefaultFeatureLimit,
+		}
+
+		assert.NoError(t, service.Feature(4, req))
+	})
+
+	t.Run("only admins feature", func(t *testing.T) {
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockUserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{Id: 1}, nil)
+
+		service := &services.PinService{Posts: &services.PostService{UserRepo: mockUserRepo}}
+
+		assert.ErrorIs(t, service.Feature(4, req), services.ErrNotAdmin)
+	})
+}
+
+func TestPostService_ReadAuthorPosts(t *testing.T) {
+	contentHTML := "<p>Content</p>\n"
+	author := &domain.User{Id: 2, Email: "bob@example.com"}
+
+	t.Run("pinned posts come first", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser("bob@example.com").Return(author, nil)
+		mockPostRepo.EXPECT().ReadPinnedPosts(2).Return([]domain.Post{
+			{Id: 3, UserId: 2, Status: domain.PostStatusPublished, ContentHTML: &contentHTML},
+		}, nil)
+		mockPostRepo.EXPECT().ReadAuthorPosts(2, 0, time.Time{}, 0, services.DefaultPageSize).Return([]domain.Post{
+			{Id: 8, UserId: 2, Status: domain.PostStatusPublished, ContentHTML: &contentHTML},
+		}, nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo}
+
+		page, err := service.ReadAuthorPosts("bob@example.com", "", "", 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, 3, page.Items[0].Id)
+		assert.True(t, page.Items[0].Pinned)
+		assert.False(t, page.Items[1].Pinned)
+	})
+}
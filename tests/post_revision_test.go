@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"database/sql"
+	"testing"
+	"web/example/internal/diff"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostService_ReadRevisions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockRevisionRepo := mocks.NewMockPostRevisionRepositoryInterface(t)
+
+		revisions := []domain.PostRevision{
+			{PostId: 1, Revision: 1, Title: "Title", Content: "Content"},
+			{PostId: 1, Revision: 2, Title: "Title", Content: "New Content"},
+		}
+
+		mockPostRepo.EXPECT().ReadPost(1).Return(&domain.Post{Id: 1, UserId: 1, Status: domain.PostStatusPublished}, nil)
+		mockRevisionRepo.EXPECT().ReadRevisions(1).Return(revisions, nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo, RevisionRepo: mockRevisionRepo}
+
+		got, err := service.ReadRevisions(1, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, revisions, got)
+	})
+
+	t.Run("history of other users drafts is hidden", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockRevisionRepo := mocks.NewMockPostRevisionRepositoryInterface(t)
+
+		mockPostRepo.EXPECT().ReadPost(1).Return(&domain.Post{Id: 1, UserId: 1, Status: domain.PostStatusDraft}, nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo, RevisionRepo: mockRevisionRepo}
+
+		_, err := service.ReadRevisions(1, "")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestPostService_DiffRevisions(t *testing.T) {
+	from := &domain.PostRevision{PostId: 1, Revision: 1, Title: "Hello", Content: "first line\nsecond line"}
+	to := &domain.PostRevision{PostId: 1, Revision: 2, Title: "Hello", Content: "first line\nchanged line"}
+
+	setup := func(t *testing.T) *services.PostService {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockRevisionRepo := mocks.NewMockPostRevisionRepositoryInterface(t)
+
+		mockPostRepo.EXPECT().ReadPost(1).Return(&domain.Post{Id: 1, UserId: 1, Status: domain.PostStatusPublished}, nil)
+		mockRevisionRepo.EXPECT().ReadRevision(1, 1).Return(from, nil)
+		mockRevisionRepo.EXPECT().ReadRevision(1, 2).Return(to, nil)
+
+		return &services.PostService{
+			PostRepo:     mockPostRepo,
+			UserRepo:     mocks.NewMockUserRepositoryInterface(t),
+			RevisionRepo: mockRevisionRepo,
+		}
+	}
+
+	t.Run("unified by default", func(t *testing.T) {
+		got, err := setup(t).DiffRevisions(1, &handlermodel.DiffPostRevisionsRequest{From: 1, To: 2})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "unified", got.Mode)
+		assert.Equal(t, "--- revision 1\n+++ revision 2\n@@ -1,4 +1,4 @@\n Hello\n \n first line\n-second line\n+changed line\n", got.Unified)
+		assert.Nil(t, got.Words)
+	})
+
+	t.Run("word level", func(t *testing.T) {
+		got, err := setup(t).DiffRevisions(1, &handlermodel.DiffPostRevisionsRequest{From: 1, To: 2, Mode: "word"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []diff.Segment{
+			{Op: diff.Equal, Text: "Hello\n\nfirst line\n"},
+			{Op: diff.Delete, Text: "second"},
+			{Op: diff.Insert, Text: "changed"},
+			{Op: diff.Equal, Text: " line"},
+		}, got.Words)
+		assert.Empty(t, got.Unified)
+	})
+}
+
+func TestPostService_RestoreRevisionService(t *testing.T) {
+	t.Run("restore saves the old content as a new revision", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockRevisionRepo := mocks.NewMockPostRevisionRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{Id: 1, Email: "test@example.com"}, nil)
+		mockPostRepo.EXPECT().ReadPost(1).Return(&domain.Post{Id: 1, UserId: 1, Title: "New", Content: "New"}, nil)
+		mockRevisionRepo.EXPECT().ReadRevision(1, 1).Return(&domain.PostRevision{PostId: 1, Revision: 1, Title: "Old", Content: "Old"}, nil)
+		mockPostRepo.EXPECT().ReadSlugOwner("old").Return(1, nil)
+		mockPostRepo.EXPECT().UpdatePost(1, 0, &domain.PostUpdate{Title: "Old", Content: "Old", Slug: "old"}).Return(true, nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo, RevisionRepo: mockRevisionRepo}
+
+		err := service.RestoreRevisionService(1, 1, &handlermodel.RestorePostRevisionRequest{UserEmail: "test@example.com"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("only authors can restore", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockRevisionRepo := mocks.NewMockPostRevisionRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser("other@example.com").Return(&domain.User{Id: 2, Email: "other@example.com"}, nil)
+		mockPostRepo.EXPECT().ReadPost(1).Return(&domain.Post{Id: 1, UserId: 1}, nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo, RevisionRepo: mockRevisionRepo}
+
+		err := service.RestoreRevisionService(1, 1, &handlermodel.RestorePostRevisionRequest{UserEmail: "other@example.com"})
+
+		assert.ErrorContains(t, err, "user is not an author of this post")
+	})
+}
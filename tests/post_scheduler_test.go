@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a Clock tests can move forward by hand
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestPostScheduler_RunOnce(t *testing.T) {
+	start := time.Date(2025, 8, 23, 12, 0, 0, 0, time.UTC)
+
+	t.Run("publishes posts due at the fast-forwarded time", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		clock := &fakeClock{now: start}
+
+		mockPostRepo.EXPECT().PublishDuePosts(start).Return(nil, nil).Once()
+		mockPostRepo.EXPECT().PublishDuePosts(start.Add(time.Hour)).Return([]int{3, 4}, nil).Once()
+
+		scheduler := &services.PostScheduler{PostRepo: mockPostRepo, Clock: clock}
+
+		n, err := scheduler.RunOnce()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), n)
+
+		clock.Advance(time.Hour)
+
+		n, err = scheduler.RunOnce()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), n)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+
+		mockPostRepo.EXPECT().PublishDuePosts(start).Return(nil, errors.New("database is locked"))
+
+		scheduler := &services.PostScheduler{PostRepo: mockPostRepo, Clock: &fakeClock{now: start}}
+
+		_, err := scheduler.RunOnce()
+		assert.ErrorContains(t, err, "database is locked")
+	})
+}
+
+func TestPostService_SchedulePostService(t *testing.T) {
+	now := time.Date(2025, 8, 23, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		status    domain.PostStatus
+		publishAt time.Time
+		wantRepo  bool
+		errMsg    string
+	}{
+		{name: "success", status: domain.PostStatusDraft, publishAt: now.Add(time.Hour), wantRepo: true},
+		{name: "publishAt in the past", status: domain.PostStatusDraft, publishAt: now.Add(-time.Minute), errMsg: "must be in the future"},
+		{name: "post already published", status: domain.PostStatusPublished, publishAt: now.Add(time.Hour), errMsg: "only drafts can be scheduled"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+			mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+			mockUserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{Id: 1, Email: "test@example.com"}, nil)
+			mockPostRepo.EXPECT().ReadPost(1).Return(&domain.Post{Id: 1, UserId: 1, Status: tt.status}, nil)
+			if tt.wantRepo {
+				mockPostRepo.EXPECT().SchedulePost(1, tt.publishAt).Return(nil)
+			}
+
+			service := &services.PostService{
+				PostRepo: mockPostRepo,
+				UserRepo: mockUserRepo,
+				Clock:    &fakeClock{now: now},
+			}
+
+			err := service.SchedulePostService(&handlermodel.SchedulePostRequest{
+				Id:        1,
+				UserEmail: "test@example.com",
+				PublishAt: tt.publishAt,
+			})
+
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPostService_ReadScheduledPosts(t *testing.T) {
+	mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+	mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+	publishAt := "2025-08-24T09:00:00Z"
+	queue := []domain.Post{{Id: 7, UserId: 1, Status: domain.PostStatusDraft, PublishAt: &publishAt}}
+
+	mockUserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{Id: 1, Email: "test@example.com"}, nil)
+	mockPostRepo.EXPECT().ReadScheduledPosts(1).Return(queue, nil)
+
+	service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo}
+
+	posts, err := service.ReadScheduledPosts("test@example.com")
+
+	assert.NoError(t, err)
+	assert.Equal(t, queue, posts)
+}
@@ -1,14 +1,23 @@
 package tests
 
 import (
+	"database/sql"
+	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 	"web/example/internal/domain"
+	"web/example/internal/http/handler"
 	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/http/middleware"
 	"web/example/internal/repository/mocks"
 	"web/example/internal/services"
 
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestPostService_CreatePostService(t *testing.T) {
@@ -33,10 +42,78 @@ func TestPostService_CreatePostService(t *testing.T) {
 					Username: "testuser",
 				}, nil)
 
+				PostRepo.EXPECT().ReadSlugOwner("test-title").Return(0, nil)
+
 				PostRepo.EXPECT().CreatePost(&domain.Post{
-					UserId:  1,
-					Title:   "Test Title",
-					Content: "Test Content",
+					UserId:        1,
+					Slug:          "test-title",
+					Title:         "Test Title",
+					Content:       "Test Content",
+					ContentFormat: "plain",
+					Language:      "en",
+					Status:        domain.PostStatusPublished,
+					Visibility:    domain.VisibilityPublic,
+				}).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "create as draft",
+			request: &handlermodel.CreatePostRequest{
+				UserEmail: "test@example.com",
+				Title:     "Test Title",
+				Content:   "Test Content",
+				Status:    "draft",
+			},
+			setupMocks: func(PostRepo *mocks.MockPostRepositoryInterface, UserRepo *mocks.MockUserRepositoryInterface) {
+				UserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{
+					Id:       1,
+					Email:    "test@example.com",
+					Username: "testuser",
+				}, nil)
+
+				PostRepo.EXPECT().ReadSlugOwner("test-title").Return(0, nil)
+
+				PostRepo.EXPECT().CreatePost(&domain.Post{
+					UserId:        1,
+					Slug:          "test-title",
+					Title:         "Test Title",
+					Content:       "Test Content",
+					ContentFormat: "plain",
+					Language:      "en",
+					Status:        domain.PostStatusDraft,
+					Visibility:    domain.VisibilityPublic,
+				}).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "create with tags",
+			request: &handlermodel.CreatePostRequest{
+				UserEmail: "test@example.com",
+				Title:     "Test Title",
+				Content:   "Test Content",
+				Tags:      []string{"go", "web"},
+			},
+			setupMocks: func(PostRepo *mocks.MockPostRepositoryInterface, UserRepo *mocks.MockUserRepositoryInterface) {
+				UserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{
+					Id:       1,
+					Email:    "test@example.com",
+					Username: "testuser",
+				}, nil)
+
+				PostRepo.EXPECT().ReadSlugOwner("test-title").Return(0, nil)
+
+				PostRepo.EXPECT().CreatePost(&domain.Post{
+					UserId:        1,
+					Slug:          "test-title",
+					Title:         "Test Title",
+					Content:       "Test Content",
+					ContentFormat: "plain",
+					Language:      "en",
+					Status:        domain.PostStatusPublished,
+					Visibility:    domain.VisibilityPublic,
+					Tags:          []string{"go", "web"},
 				}).Return(nil)
 			},
 			wantErr: false,
@@ -68,10 +145,17 @@ func TestPostService_CreatePostService(t *testing.T) {
 					Username: "testuser",
 				}, nil)
 
+				PostRepo.EXPECT().ReadSlugOwner("test-title").Return(0, nil)
+
 				PostRepo.EXPECT().CreatePost(&domain.Post{
-					UserId:  1,
-					Title:   "Test Title",
-					Content: "Test Content",
+					UserId:        1,
+					Slug:          "test-title",
+					Title:         "Test Title",
+					Content:       "Test Content",
+					ContentFormat: "plain",
+					Language:      "en",
+					Status:        domain.PostStatusPublished,
+					Visibility:    domain.VisibilityPublic,
 				}).Return(errors.New("database error"))
 			},
 			wantErr: true,
@@ -133,14 +217,78 @@ func TestPostService_UpdatePostService(t *testing.T) {
 					UserId:  1,
 					Title:   "Old Title",
 					Content: "Old Content",
+					Version: 3,
 				}, nil)
 
-				PostRepo.EXPECT().UpdatePost(1, "Updated Title", "Updated Content").Return(nil)
+				// the title changed so the post moves to a new slug in the same write
+				PostRepo.EXPECT().ReadSlugOwner("updated-title").Return(0, nil)
+				PostRepo.EXPECT().UpdatePost(1, 3, &domain.PostUpdate{Title: "Updated Title", Content: "Updated Content", Slug: "updated-title"}).Return(true, nil)
 			},
 			wantErr: false,
 		},
 		{
-			name: "user does not own post",
+			name: "switch to markdown",
+			request: &handlermodel.UpdatePostRequest{
+				Id:               1,
+				UserEmail:        "test@example.com",
+				NewTitle:         "Title",
+				NewContent:       "# Content",
+				NewContentFormat: "markdown",
+			},
+			setupMocks: func(PostRepo *mocks.MockPostRepositoryInterface, UserRepo *mocks.MockUserRepositoryInterface) {
+				UserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{
+					Id:       1,
+					Email:    "test@example.com",
+					Username: "testuser",
+				}, nil)
+
+				PostRepo.EXPECT().ReadPost(1).Return(&domain.Post{
+					Id:            1,
+					UserId:        1,
+					Slug:          "title",
+					Title:         "Title",
+					Content:       "Content",
+					ContentFormat: "plain",
+					Version:       1,
+				}, nil)
+
+				PostRepo.EXPECT().UpdatePost(1, 1, &domain.PostUpdate{Title: "Title", Content: "# Content", ContentFormat: "markdown"}).Return(true, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "clear the tags",
+			request: &handlermodel.UpdatePostRequest{
+				Id:         1,
+				UserEmail:  "test@example.com",
+				NewTitle:   "Title",
+				NewContent: "Content",
+				NewTags:    []string{},
+			},
+			setupMocks: func(PostRepo *mocks.MockPostRepositoryInterface, UserRepo *mocks.MockUserRepositoryInterface) {
+				UserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{
+					Id:       1,
+					Email:    "test@example.com",
+					Username: "testuser",
+				}, nil)
+
+				PostRepo.EXPECT().ReadPost(1).Return(&domain.Post{
+					Id:      1,
+					UserId:  1,
+					Slug:    "title",
+					Title:   "Title",
+					Content: "Content",
+					Tags:    []string{"go"},
+					Version: 2,
+				}, nil)
+
+				// an empty list replaces the tags, a missing one keeps them
+				PostRepo.EXPECT().UpdatePost(1, 2, &domain.PostUpdate{Title: "Title", Content: "Content", Tags: []string{}}).Return(true, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "user is not an author",
 			request: &handlermodel.UpdatePostRequest{
 				Id:         1,
 				UserEmail:  "other@example.com",
@@ -162,7 +310,7 @@ func TestPostService_UpdatePostService(t *testing.T) {
 				}, nil)
 			},
 			wantErr: true,
-			errMsg:  "user does not own this post",
+			errMsg:  "user is not an author of this post",
 		},
 		{
 			name: "post not found",
@@ -238,9 +386,10 @@ func TestPostService_DeletePostService(t *testing.T) {
 					UserId:  1,
 					Title:   "Title",
 					Content: "Content",
+					Version: 1,
 				}, nil)
 
-				PostRepo.EXPECT().DeletePost(1).Return(nil)
+				PostRepo.EXPECT().DeletePost(1, 1).Return(true, nil)
 			},
 			wantErr: false,
 		},
@@ -296,11 +445,15 @@ func TestPostService_DeletePostService(t *testing.T) {
 }
 
 func TestPostService_ReadPost(t *testing.T) {
+	contentHTML := "<p>Test Content</p>\n"
 	expectedPost := &domain.Post{
-		Id:      1,
-		UserId:  1,
-		Title:   "Test Title",
-		Content: "Test Content",
+		Id:            1,
+		UserId:        1,
+		Title:         "Test Title",
+		Content:       "Test Content",
+		ContentFormat: "plain",
+		ContentHTML:   &contentHTML,
+		Status:        domain.PostStatusPublished,
 	}
 
 	t.Run("success", func(t *testing.T) {
@@ -314,7 +467,7 @@ func TestPostService_ReadPost(t *testing.T) {
 			UserRepo: mockUserRepo,
 		}
 
-		post, err := service.ReadPost(1)
+		post, err := service.ReadPost(1, "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedPost, post)
@@ -331,27 +484,109 @@ func TestPostService_ReadPost(t *testing.T) {
 			UserRepo: mockUserRepo,
 		}
 
-		post, err := service.ReadPost(999)
+		post, err := service.ReadPost(999, "")
 
 		assert.Error(t, err)
 		assert.Nil(t, post)
 		assert.Contains(t, err.Error(), "post not found")
 	})
+	t.Run("draft hidden from other users", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockPostRepo.EXPECT().ReadPost(1).Return(&domain.Post{Id: 1, UserId: 1, Status: domain.PostStatusDraft}, nil)
+		mockUserRepo.EXPECT().ReadUser("other@example.com").Return(&domain.User{Id: 2, Email: "other@example.com"}, nil)
+
+		service := &services.PostService{
+			PostRepo: mockPostRepo,
+			UserRepo: mockUserRepo,
+		}
+
+		post, err := service.ReadPost(1, "other@example.com")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, post)
+	})
+
+	t.Run("draft hidden from anonymous", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockPostRepo.EXPECT().ReadPost(1).Return(&domain.Post{Id: 1, UserId: 1, Status: domain.PostStatusDraft}, nil)
+
+		service := &services.PostService{
+			PostRepo: mockPostRepo,
+			UserRepo: mockUserRepo,
+		}
+
+		post, err := service.ReadPost(1, "")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, post)
+	})
+
+	t.Run("draft visible to owner", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		draft := &domain.Post{Id: 1, UserId: 1, Status: domain.PostStatusDraft, ContentHTML: &contentHTML}
+		mockPostRepo.EXPECT().ReadPost(1).Return(draft, nil)
+		mockUserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{Id: 1, Email: "test@example.com"}, nil)
+
+		service := &services.PostService{
+			PostRepo: mockPostRepo,
+			UserRepo: mockUserRepo,
+		}
+
+		post, err := service.ReadPost(1, "test@example.com")
+
+		assert.NoError(t, err)
+		assert.Equal(t, draft, post)
+	})
+
+	t.Run("renders and caches content when the cache is empty", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockPostRepo.EXPECT().ReadPost(1).Return(&domain.Post{
+			Id:            1,
+			UserId:        1,
+			Content:       "# Title\n\n**bold**",
+			ContentFormat: "markdown",
+			Status:        domain.PostStatusPublished,
+		}, nil)
+		mockPostRepo.EXPECT().CacheContentHTML(1, `<h1 id="title">Title</h1>`+"\n<p><strong>bold</strong></p>\n").Return(nil)
+
+		service := &services.PostService{
+			PostRepo: mockPostRepo,
+			UserRepo: mockUserRepo,
+		}
+
+		post, err := service.ReadPost(1, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, `<h1 id="title">Title</h1>`+"\n<p><strong>bold</strong></p>\n", *post.ContentHTML)
+	})
 }
 
 func TestPostService_ReadAllPosts(t *testing.T) {
+	contentHTML := "<p>Content</p>\n"
 	expectedPosts := []domain.Post{
 		{
-			Id:      1,
-			UserId:  1,
-			Title:   "First Post",
-			Content: "Content 1",
+			Id:          1,
+			UserId:      1,
+			Title:       "First Post",
+			Content:     "Content 1",
+			ContentHTML: &contentHTML,
+			Status:      domain.PostStatusPublished,
 		},
 		{
-			Id:      2,
-			UserId:  2,
-			Title:   "Second Post",
-			Content: "Content 2",
+			Id:          2,
+			UserId:      2,
+			Title:       "Second Post",
+			Content:     "Content 2",
+			ContentHTML: &contentHTML,
+			Status:      domain.PostStatusPublished,
 		},
 	}
 
@@ -359,51 +594,192 @@ func TestPostService_ReadAllPosts(t *testing.T) {
 		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
 		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
 
-		mockPostRepo.EXPECT().ReadAllPosts().Return(expectedPosts, nil)
+		mockPostRepo.EXPECT().ReadFeaturedPosts().Return(nil, nil)
+		mockPostRepo.EXPECT().ReadAllPosts(0, time.Time{}, 0, services.DefaultPageSize).Return(expectedPosts, nil)
 
 		service := &services.PostService{
 			PostRepo: mockPostRepo,
 			UserRepo: mockUserRepo,
 		}
 
-		posts, err := service.ReadAllPosts()
+		page, err := service.ReadAllPosts("", "", 0)
 
 		assert.NoError(t, err)
-		assert.Equal(t, expectedPosts, posts)
+		assert.Equal(t, expectedPosts, page.Items)
+		assert.Empty(t, page.NextCursor)
 	})
 
 	t.Run("empty result", func(t *testing.T) {
 		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
 		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
 
-		mockPostRepo.EXPECT().ReadAllPosts().Return([]domain.Post{}, nil)
+		mockPostRepo.EXPECT().ReadFeaturedPosts().Return(nil, nil)
+		mockPostRepo.EXPECT().ReadAllPosts(0, time.Time{}, 0, services.DefaultPageSize).Return([]domain.Post{}, nil)
 
 		service := &services.PostService{
 			PostRepo: mockPostRepo,
 			UserRepo: mockUserRepo,
 		}
 
-		posts, err := service.ReadAllPosts()
+		page, err := service.ReadAllPosts("", "", 0)
 
 		assert.NoError(t, err)
-		assert.Empty(t, posts)
+		assert.Empty(t, page.Items)
 	})
 
 	t.Run("database error", func(t *testing.T) {
 		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
 		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
 
-		mockPostRepo.EXPECT().ReadAllPosts().Return(nil, errors.New("database connection failed"))
+		mockPostRepo.EXPECT().ReadFeaturedPosts().Return(nil, nil)
+		mockPostRepo.EXPECT().ReadAllPosts(0, time.Time{}, 0, services.DefaultPageSize).Return(nil, errors.New("database connection failed"))
 
 		service := &services.PostService{
 			PostRepo: mockPostRepo,
 			UserRepo: mockUserRepo,
 		}
 
-		posts, err := service.ReadAllPosts()
+		page, err := service.ReadAllPosts("", "", 0)
 
 		assert.Error(t, err)
-		assert.Nil(t, posts)
+		assert.Nil(t, page)
 		assert.Contains(t, err.Error(), "database connection failed")
 	})
+
+	t.Run("drafts and archived posts of other users are excluded", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockPostRepo.EXPECT().ReadFeaturedPosts().Return(nil, nil)
+		mockPostRepo.EXPECT().ReadAllPosts(1, time.Time{}, 0, services.DefaultPageSize).Return([]domain.Post{
+			{Id: 1, UserId: 1, Status: domain.PostStatusPublished, ContentHTML: &contentHTML},
+			{Id: 2, UserId: 2, Status: domain.PostStatusDraft, ContentHTML: &contentHTML},
+			{Id: 3, UserId: 1, Status: domain.PostStatusDraft, ContentHTML: &contentHTML},
+			{Id: 4, UserId: 2, Status: domain.PostStatusArchived, ContentHTML: &contentHTML},
+		}, nil)
+		mockUserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{Id: 1, Email: "test@example.com"}, nil)
+
+		service := &services.PostService{
+			PostRepo: mockPostRepo,
+			UserRepo: mockUserRepo,
+		}
+
+		page, err := service.ReadAllPosts("test@example.com", "", 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Post{
+			{Id: 1, UserId: 1, Status: domain.PostStatusPublished, ContentHTML: &contentHTML},
+			{Id: 3, UserId: 1, Status: domain.PostStatusDraft, ContentHTML: &contentHTML},
+		}, page.Items)
+	})
+
+	t.Run("featured posts come first on the first page only", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+
+		featured := domain.Post{Id: 9, UserId: 2, Status: domain.PostStatusPublished, ContentHTML: &contentHTML}
+		page1 := []domain.Post{
+			{Id: 7, UserId: 1, Status: domain.PostStatusPublished, ContentHTML: &contentHTML, CreatedAt: "2025-08-23T12:00:00Z"},
+			// not listed, the cursor still moves past it
+			{Id: 6, UserId: 1, Status: domain.PostStatusDraft, ContentHTML: &contentHTML, CreatedAt: "2025-08-23T11:00:00Z"},
+		}
+		last := time.Date(2025, 8, 23, 11, 0, 0, 0, time.UTC)
+
+		mockPostRepo.EXPECT().ReadFeaturedPosts().Return([]domain.Post{featured}, nil).Once()
+		mockPostRepo.EXPECT().ReadAllPosts(0, time.Time{}, 0, 2).Return(page1, nil)
+		mockPostRepo.EXPECT().ReadAllPosts(0, mock.MatchedBy(last.Equal), 6, 2).Return([]domain.Post{}, nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo}
+
+		page, err := service.ReadAllPosts("", "", 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, 9, page.Items[0].Id)
+		assert.True(t, page.Items[0].Featured)
+		assert.Equal(t, 7, page.Items[1].Id)
+		assert.NotEmpty(t, page.NextCursor)
+
+		next, err := service.ReadAllPosts("", page.NextCursor, 2)
+
+		assert.NoError(t, err)
+		assert.Empty(t, next.Items)
+		assert.Empty(t, next.NextCursor)
+	})
+}
+
+// TestPostHandler_ReadAllLegacy pins the deprecated GET /post/all to a bare
+// array of every listed post, only /posts is paginated
+func TestPostHandler_ReadAllLegacy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	contentHTML := "<p>Content</p>\n"
+
+	featured := domain.Post{Id: 100, UserId: 1, Status: domain.PostStatusPublished, CreatedAt: "2025-08-23T12:00:00Z", ContentHTML: &contentHTML}
+	var listed []domain.Post
+	for id := services.MaxPageSize + 1; id > 0; id-- {
+		listed = append(listed, domain.Post{Id: id, UserId: 1, Status: domain.PostStatusPublished, CreatedAt: "2025-08-23T12:00:00Z", ContentHTML: &contentHTML})
+	}
+
+	mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+	mockPostRepo.EXPECT().ReadFeaturedPosts().Return([]domain.Post{featured}, nil)
+	mockPostRepo.EXPECT().ReadAllPosts(0, time.Time{}, 0, services.MaxPageSize).Return(listed[:services.MaxPageSize], nil).Once()
+	mockPostRepo.EXPECT().ReadAllPosts(0, mock.Anything, 2, services.MaxPageSize).Return(listed[services.MaxPageSize:], nil).Once()
+
+	service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mocks.NewMockUserRepositoryInterface(t)}
+
+	r := gin.New()
+	r.GET("/post/all", middleware.OptionalMockToken(), handler.NewPostHandler(service).ReadAllLegacy)
+
+	res := httptest.NewRecorder()
+	r.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/post/all", nil))
+	assert.Equal(t, http.StatusOK, res.Code)
+
+	var posts []domain.Post
+	assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &posts), "a bare array")
+	assert.Len(t, posts, services.MaxPageSize+2)
+	assert.Equal(t, 100, posts[0].Id)
+	assert.Equal(t, 1, posts[len(posts)-1].Id)
+}
+
+func TestPostService_ChangePostStatusService(t *testing.T) {
+	tests := []struct {
+		name       string
+		current    domain.PostStatus
+		next       domain.PostStatus
+		wantUpdate bool
+		errMsg     string
+	}{
+		{name: "publish draft", current: domain.PostStatusDraft, next: domain.PostStatusPublished, wantUpdate: true},
+		{name: "unpublish", current: domain.PostStatusPublished, next: domain.PostStatusDraft, wantUpdate: true},
+		{name: "archive published", current: domain.PostStatusPublished, next: domain.PostStatusArchived, wantUpdate: true},
+		{name: "archive draft", current: domain.PostStatusDraft, next: domain.PostStatusArchived, errMsg: "cannot change post status"},
+		{name: "republish archived", current: domain.PostStatusArchived, next: domain.PostStatusPublished, errMsg: "cannot change post status"},
+		{name: "publish published", current: domain.PostStatusPublished, next: domain.PostStatusPublished, errMsg: "cannot change post status"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+			mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+			mockUserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{Id: 1, Email: "test@example.com"}, nil)
+			mockPostRepo.EXPECT().ReadPost(1).Return(&domain.Post{Id: 1, UserId: 1, Status: tt.current}, nil)
+			if tt.wantUpdate {
+				mockPostRepo.EXPECT().UpdatePostStatus(1, tt.next).Return(nil)
+			}
+
+			service := &services.PostService{
+				PostRepo: mockPostRepo,
+				UserRepo: mockUserRepo,
+			}
+
+			err := service.ChangePostStatusService(&handlermodel.ChangePostStatusRequest{Id: 1, UserEmail: "test@example.com"}, tt.next)
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
 }
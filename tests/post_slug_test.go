@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"database/sql"
+	"testing"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+	"web/example/internal/slug"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlug_Make(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{title: "Hello World!", want: "hello-world"},
+		{title: "Bob's Thoughts", want: "bobs-thoughts"},
+		{title: "  Olá, São Paulo — açaí & pão ", want: "ola-sao-paulo-acai-pao"},
+		{title: "Straße Ærø", want: "strasse-aero"},
+		{title: "Привет мир", want: "privet-mir"},
+		{title: "C++ / Go: 10 tips", want: "c-go-10-tips"},
+		{title: "2024", want: "post-2024"},
+		{title: "!!!", want: "post"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.want, slug.Make(tt.title))
+		})
+	}
+}
+
+func TestPostService_CreatePostService_SlugCollision(t *testing.T) {
+	mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+	mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+	mockUserRepo.EXPECT().ReadUser("test@example.com").Return(&domain.User{Id: 1, Email: "test@example.com"}, nil)
+	mockPostRepo.EXPECT().ReadSlugOwner("test-title").Return(3, nil)
+	mockPostRepo.EXPECT().ReadSlugOwner("test-title-2").Return(4, nil)
+	mockPostRepo.EXPECT().ReadSlugOwner("test-title-3").Return(0, nil)
+	mockPostRepo.EXPECT().CreatePost(&domain.Post{
+		UserId:        1,
+		Slug:          "test-title-3",
+		Title:         "Test Title",
+		Content:       "Test Content",
+		ContentFormat: "plain",
+		Language:      "en",
+		Status:        domain.PostStatusPublished,
+		Visibility:    domain.VisibilityPublic,
+	}).Return(nil)
+
+	service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo}
+
+	err := service.CreatePostService(&handlermodel.CreatePostRequest{
+		UserEmail: "test@example.com",
+		Title:     "Test Title",
+		Content:   "Test Content",
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestPostService_ReadPostBySlug(t *testing.T) {
+	contentHTML := "<p>Content</p>\n"
+	current := &domain.Post{Id: 1, UserId: 1, Slug: "new-title", Status: domain.PostStatusPublished, ContentHTML: &contentHTML}
+
+	t.Run("current slug", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+
+		mockPostRepo.EXPECT().ReadPostBySlug("new-title").Return(current, nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mocks.NewMockUserRepositoryInterface(t)}
+
+		post, err := service.ReadPostBySlug("new-title", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, current, post)
+	})
+
+	t.Run("old slug resolves to the post with its current slug", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+
+		mockPostRepo.EXPECT().ReadPostBySlug("old-title").Return(nil, sql.ErrNoRows)
+		mockPostRepo.EXPECT().ReadSlugOwner("old-title").Return(1, nil)
+		mockPostRepo.EXPECT().ReadPost(1).Return(current, nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mocks.NewMockUserRepositoryInterface(t)}
+
+		post, err := service.ReadPostBySlug("old-title", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "new-title", post.Slug)
+	})
+
+	t.Run("unknown slug", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+
+		mockPostRepo.EXPECT().ReadPostBySlug("nope").Return(nil, sql.ErrNoRows)
+		mockPostRepo.EXPECT().ReadSlugOwner("nope").Return(0, nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mocks.NewMockUserRepositoryInterface(t)}
+
+		_, err := service.ReadPostBySlug("nope", "")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
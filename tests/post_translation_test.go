@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"web/example/internal/domain"
+	"web/example/internal/http/handler"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/http/middleware"
+	"web/example/internal/http/validation"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPostService_Localize(t *testing.T) {
+	post := func() *domain.Post {
+		return &domain.Post{Id: 1, Title: "Hello", Content: "Hi", ContentFormat: "plain", Language: "en", Languages: []string{"en", "pt-BR"}}
+	}
+	contentHTML := "<p>Olá</p>\n"
+	pt := domain.PostTranslation{PostId: 1, Language: "pt-BR", Title: "Olá", Content: "Olá", ContentHTML: &contentHTML}
+
+	tests := []struct {
+		name     string
+		prefs    string
+		language string
+	}{
+		{"best match by quality", "fr;q=0.9, pt;q=0.8, en;q=0.5", "pt-BR"},
+		{"region falls back to the language", "pt-PT", "pt-BR"},
+		{"the original when it is preferred", "en-US, pt;q=0.5", "en"},
+		{"the original without a match", "de, fr", "en"},
+		{"the original on a bad header", ";;;", "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTranslationRepo := mocks.NewMockPostTranslationRepositoryInterface(t)
+			if tt.language != "en" {
+				mockTranslationRepo.EXPECT().ReadTranslations(tt.language, []int{1}).Return([]domain.PostTranslation{pt}, nil)
+			}
+
+			service := &services.PostService{TranslationRepo: mockTranslationRepo}
+			p := post()
+
+			assert.NoError(t, service.Localize(tt.prefs, p))
+			assert.Equal(t, tt.language, p.Language)
+			// the languages offered stay the same whichever was picked
+			assert.Equal(t, []string{"en", "pt-BR"}, p.Languages)
+			if tt.language != "en" {
+				assert.Equal(t, "Olá", p.Title)
+				assert.Equal(t, &contentHTML, p.ContentHTML)
+			}
+		})
+	}
+
+	t.Run("uncached translations are rendered and cached", func(t *testing.T) {
+		mockTranslationRepo := mocks.NewMockPostTranslationRepositoryInterface(t)
+		mockTranslationRepo.EXPECT().ReadTranslations("pt-BR", []int{1, 2}).Return([]domain.PostTranslation{
+			{PostId: 1, Language: "pt-BR", Title: "Olá", Content: "Olá"},
+		}, nil)
+		mockTranslationRepo.EXPECT().CacheTranslationHTML(1, "pt-BR", "<p>Olá</p>\n").Return(nil)
+
+		service := &services.PostService{TranslationRepo: mockTranslationRepo}
+		// the second translation was deleted after the post was read
+		a, b := post(), post()
+		b.Id = 2
+
+		assert.NoError(t, service.Localize("pt", a, b))
+		assert.Equal(t, "pt-BR", a.Language)
+		assert.Equal(t, "en", b.Language)
+		assert.Equal(t, "Hello", b.Title)
+	})
+}
+
+// TestPostHandler_ETag reads a post in two languages, the variants carry
+// distinct tags and either one is accepted back in If-Match
+func TestPostHandler_ETag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	binding.Validator = validation.New()
+	contentHTML := "<p>Hi</p>\n"
+	owner := &domain.User{Id: 1, Email: "owner@example.com"}
+
+	post := func() *domain.Post {
+		return &domain.Post{Id: 1, UserId: 1, Title: "Hello", Content: "Hi", ContentHTML: &contentHTML, Status: domain.PostStatusPublished,
+			Visibility: domain.VisibilityPublic, Version: 3, Language: "en", Languages: []string{"en", "pt-BR"}}
+	}
+
+	mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+	mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+	mockTranslationRepo := mocks.NewMockPostTranslationRepositoryInterface(t)
+
+	mockPostRepo.EXPECT().ReadPost(1).RunAndReturn(func(int) (*domain.Post, error) { return post(), nil })
+	mockUserRepo.EXPECT().ReadUser(owner.Email).Return(owner, nil)
+	mockTranslationRepo.EXPECT().ReadTranslations("pt-BR", []int{1}).
+		Return([]domain.PostTranslation{{PostId: 1, Language: "pt-BR", Title: "Olá", Content: "Oi", ContentHTML: &contentHTML}}, nil)
+
+	service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo, TranslationRepo: mockTranslationRepo}
+	h := handler.NewPostHandler(service)
+
+	r := gin.New()
+	r.GET("/posts/:id", middleware.OptionalMockToken(), h.ReadByRef)
+	r.PUT("/posts/:id", middleware.RequireMockToken(), h.UpdateById)
+
+	read := func(lang string) string {
+		req := httptest.NewRequest(http.MethodGet, "/posts/1", nil)
+		req.Header.Set("Accept-Language", lang)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+		assert.Equal(t, http.StatusOK, res.Code)
+		return res.Header().Get("ETag")
+	}
+
+	assert.Equal(t, `"3-en"`, read("en"))
+	assert.Equal(t, `"3-pt-BR"`, read("pt"))
+
+	mockPostRepo.EXPECT().UpdatePost(1, 3, &domain.PostUpdate{Title: "Hello", Content: "Oi"}).Return(true, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/posts/1", strings.NewReader(`{"userEmail": "owner@example.com", "newTitle": "Hello", "newContent": "Oi"}`))
+	req.Header.Set("Authorization", "Bearer "+middleware.MockValidJWT)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `"3-pt-BR"`)
+	res := httptest.NewRecorder()
+	r.ServeHTTP(res, req)
+
+	assert.Equal(t, http.StatusAccepted, res.Code)
+}
+
+func TestPostService_SaveTranslation(t *testing.T) {
+	user := &domain.User{Id: 1, Email: "test@example.com"}
+	post := &domain.Post{Id: 1, UserId: 1, Language: "en", Languages: []string{"en"}}
+	req := &handlermodel.SavePostTranslationRequest{UserEmail: "test@example.com", Title: "Olá", Content: "Conteúdo"}
+
+	newService := func(t *testing.T) (*services.PostService, *mocks.MockPostTranslationRepositoryInterface) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockTranslationRepo := mocks.NewMockPostTranslationRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser("test@example.com").Return(user, nil)
+		mockPostRepo.EXPECT().ReadPost(1).Return(post, nil)
+
+		return &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo, TranslationRepo: mockTranslationRepo}, mockTranslationRepo
+	}
+
+	t.Run("the language is stored in canonical form", func(t *testing.T) {
+		service, mockTranslationRepo := newService(t)
+		mockTranslationRepo.EXPECT().SaveTranslation(mock.MatchedBy(func(tr *domain.PostTranslation) bool {
+			return tr.PostId == 1 && tr.Language == "pt-BR" && tr.Title == "Olá"
+		}), ([]domain.PostFlag)(nil)).Return(true, nil)
+
+		assert.NoError(t, service.SaveTranslation(1, "PT-br", req))
+	})
+
+	t.Run("the original is updated through the post", func(t *testing.T) {
+		service, _ := newService(t)
+
+		assert.ErrorIs(t, service.SaveTranslation(1, "EN", req), services.ErrOriginalLanguage)
+	})
+}
@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"testing"
+	"web/example/internal/render"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender_HTML(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		content string
+		want    string
+	}{
+		{
+			name:    "plain text is escaped",
+			format:  render.FormatPlain,
+			content: "<b>hi</b>\nthere\n\nnext",
+			want:    "<p>&lt;b&gt;hi&lt;/b&gt;<br>\nthere</p>\n<p>next</p>\n",
+		},
+		{
+			name:    "scripts and handlers are stripped",
+			format:  render.FormatMarkdown,
+			content: "[x](javascript:alert(1)) <img src=x onerror=alert(1)>\n\n<script>alert(1)</script>",
+			want:    "<p>x </p>\n\n",
+		},
+		{
+			name:    "heading ids",
+			format:  render.FormatMarkdown,
+			content: "## Getting Started",
+			want:    "<h2 id=\"getting-started\">Getting Started</h2>\n",
+		},
+		{
+			name:    "code fences keep the language",
+			format:  render.FormatMarkdown,
+			content: "```go\nfmt.Println()\n```",
+			want:    "<pre><code class=\"language-go\">fmt.Println()\n</code></pre>\n",
+		},
+		{
+			name:    "tables",
+			format:  render.FormatMarkdown,
+			content: "| a |\n|---|\n| b |",
+			want:    "<table>\n<thead>\n<tr>\n<th>a</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>b</td>\n</tr>\n</tbody>\n</table>\n",
+		},
+		{
+			name:    "autolinks are nofollow",
+			format:  render.FormatMarkdown,
+			content: "see https://example.com",
+			want:    "<p>see <a href=\"https://example.com\" rel=\"nofollow noopener\" target=\"_blank\">https://example.com</a></p>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := render.HTML(tt.format, tt.content)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"database/sql"
+	"testing"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostService_ReadPostSeries(t *testing.T) {
+	contentHTML := "<p>Content</p>\n"
+	post := func(id int, status domain.PostStatus) domain.Post {
+		return domain.Post{Id: id, UserId: 1, Title: "Part", Status: status, Visibility: domain.VisibilityPublic, ContentHTML: &contentHTML}
+	}
+
+	mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+	mockSeriesRepo := mocks.NewMockSeriesRepositoryInterface(t)
+
+	p := post(7, domain.PostStatusPublished)
+	mockPostRepo.EXPECT().ReadPost(7).Return(&p, nil)
+	mockSeriesRepo.EXPECT().ReadPostSeries(7).Return(&domain.Series{Id: 3, UserId: 1, Title: "Go tour"}, nil)
+	// the draft part in between is skipped for other readers
+	mockSeriesRepo.EXPECT().ReadSeriesPosts(3).Return([]domain.Post{
+		post(5, domain.PostStatusPublished),
+		post(6, domain.PostStatusDraft),
+		post(7, domain.PostStatusPublished),
+	}, nil)
+
+	service := &services.PostService{PostRepo: mockPostRepo, SeriesRepo: mockSeriesRepo}
+
+	result, err := service.ReadPost(7, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Series.Position)
+	assert.Equal(t, 2, result.Series.Total)
+	assert.Equal(t, 5, result.Series.Prev.Id)
+	assert.Nil(t, result.Series.Next)
+}
+
+func TestPostService_ReadPostWithoutSeries(t *testing.T) {
+	contentHTML := "<p>Content</p>\n"
+	p := &domain.Post{Id: 7, UserId: 1, Status: domain.PostStatusPublished, Visibility: domain.VisibilityPublic, ContentHTML: &contentHTML}
+
+	mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+	mockSeriesRepo := mocks.NewMockSeriesRepositoryInterface(t)
+
+	mockPostRepo.EXPECT().ReadPost(7).Return(p, nil)
+	mockSeriesRepo.EXPECT().ReadPostSeries(7).Return(nil, sql.ErrNoRows)
+
+	service := &services.PostService{PostRepo: mockPostRepo, SeriesRepo: mockSeriesRepo}
+
+	result, err := service.ReadPost(7, "")
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.Series)
+}
+
+func TestSeriesService(t *testing.T) {
+	owner := &domain.User{Id: 1, Email: "owner@example.com"}
+
+	newService := func(t *testing.T) (*services.SeriesService, *mocks.MockPostRepositoryInterface, *mocks.MockUserRepositoryInterface, *mocks.MockSeriesRepositoryInterface) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockSeriesRepo := mocks.NewMockSeriesRepositoryInterface(t)
+
+		service := &services.SeriesService{
+			Posts:      &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo},
+			SeriesRepo: mockSeriesRepo,
+		}
+
+		return service, mockPostRepo, mockUserRepo, mockSeriesRepo
+	}
+
+	t.Run("series of other users are not found", func(t *testing.T) {
+		service, _, mockUserRepo, mockSeriesRepo := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser("other@example.com").Return(&domain.User{Id: 2}, nil)
+		mockSeriesRepo.EXPECT().ReadSeries(3).Return(&domain.Series{Id: 3, UserId: owner.Id}, nil)
+
+		err := service.DeleteSeries(3, "other@example.com")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("posts of other users can't be added", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, mockSeriesRepo := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(owner.Email).Return(owner, nil)
+		mockSeriesRepo.EXPECT().ReadSeries(3).Return(&domain.Series{Id: 3, UserId: owner.Id}, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(&domain.Post{Id: 5, UserId: 2}, nil)
+
+		err := service.AddPost(3, &handlermodel.AddSeriesPostRequest{UserEmail: owner.Email, PostId: 5})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("a post is part of one series only", func(t *testing.T) {
+		service, mockPostRepo, mockUserRepo, mockSeriesRepo := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(owner.Email).Return(owner, nil)
+		mockSeriesRepo.EXPECT().ReadSeries(3).Return(&domain.Series{Id: 3, UserId: owner.Id}, nil)
+		mockPostRepo.EXPECT().ReadPost(5).Return(&domain.Post{Id: 5, UserId: owner.Id}, nil)
+		mockSeriesRepo.EXPECT().AddSeriesPost(3, 5, 0).Return(false, nil)
+
+		err := service.AddPost(3, &handlermodel.AddSeriesPostRequest{UserEmail: owner.Email, PostId: 5})
+
+		assert.ErrorIs(t, err, services.ErrPostInSeries)
+	})
+
+	t.Run("orders naming other posts are rejected", func(t *testing.T) {
+		service, _, mockUserRepo, mockSeriesRepo := newService(t)
+
+		mockUserRepo.EXPECT().ReadUser(owner.Email).Return(owner, nil)
+		mockSeriesRepo.EXPECT().ReadSeries(3).Return(&domain.Series{Id: 3, UserId: owner.Id}, nil)
+		mockSeriesRepo.EXPECT().ReorderSeries(3, []int{5, 9}).Return(false, nil)
+
+		_, err := service.Reorder(3, &handlermodel.ReorderSeriesRequest{UserEmail: owner.Email, PostIds: []int{5, 9}})
+
+		assert.ErrorIs(t, err, services.ErrInvalidSeriesOrder)
+	})
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"web/example/internal/domain"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+	"web/example/internal/views"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const browserUA = "Mozilla/5.0 (X11; Linux x86_64; rv:130.0) Gecko/20100101 Firefox/130.0"
+
+func TestViewCounter(t *testing.T) {
+	start := time.Date(2025, 8, 23, 12, 0, 0, 0, time.UTC)
+
+	t.Run("bots are not counted", func(t *testing.T) {
+		counter := views.NewCounter(time.Hour)
+
+		assert.False(t, counter.Record(views.View{PostId: 1, IP: "10.0.0.1", UserAgent: "Mozilla/5.0 (compatible; Googlebot/2.1)", At: start}))
+		assert.False(t, counter.Record(views.View{PostId: 1, IP: "10.0.0.1", UserAgent: "", At: start}))
+		assert.False(t, counter.Record(views.View{PostId: 1, IP: "10.0.0.1", UserAgent: "curl/8.5.0", At: start}))
+		assert.Empty(t, counter.Drain(start))
+	})
+
+	t.Run("visitors count once per window", func(t *testing.T) {
+		counter := views.NewCounter(time.Hour)
+		view := views.View{PostId: 1, IP: "10.0.0.1", UserAgent: browserUA, At: start}
+
+		assert.True(t, counter.Record(view))
+		view.At = start.Add(30 * time.Minute)
+		assert.False(t, counter.Record(view))
+		view.At = start.Add(time.Hour)
+		assert.True(t, counter.Record(view))
+
+		other := views.View{PostId: 2, IP: "10.0.0.1", UserAgent: browserUA, At: start}
+		assert.True(t, counter.Record(other), "the window is per post")
+	})
+
+	t.Run("referrers are reduced to their host", func(t *testing.T) {
+		counter := views.NewCounter(time.Hour)
+
+		counter.Record(views.View{PostId: 1, IP: "10.0.0.1", UserAgent: browserUA, Referrer: "https://www.Example.com/search?q=secret", At: start})
+		counter.Record(views.View{PostId: 1, IP: "10.0.0.2", UserAgent: browserUA, Referrer: "https://example.com/", At: start})
+		counter.Record(views.View{PostId: 1, IP: "10.0.0.3", UserAgent: browserUA, Referrer: "android-app://x", At: start})
+
+		counts := counter.Drain(start)
+
+		assert.ElementsMatch(t, []domain.ViewCount{
+			{PostId: 1, Day: "2025-08-23", Referrer: "example.com", Views: 2},
+			{PostId: 1, Day: "2025-08-23", Referrer: "", Views: 1},
+		}, counts)
+		assert.Empty(t, counter.Drain(start), "draining takes the counts")
+	})
+}
+
+func TestStatsService_Flush(t *testing.T) {
+	start := time.Date(2025, 8, 23, 12, 0, 0, 0, time.UTC)
+	counter := views.NewCounter(time.Hour)
+	counter.Record(views.View{PostId: 1, IP: "10.0.0.1", UserAgent: browserUA, At: start})
+
+	mockViewRepo := mocks.NewMockViewRepositoryInterface(t)
+	counts := []domain.ViewCount{{PostId: 1, Day: "2025-08-23", Views: 1}}
+	mockViewRepo.EXPECT().AddViews(counts).Return(errors.New("database is locked")).Once()
+	mockViewRepo.EXPECT().AddViews(counts).Return(nil).Once()
+
+	service := &services.StatsService{ViewRepo: mockViewRepo, Counter: counter, Clock: &fakeClock{now: start}}
+
+	_, err := service.Flush()
+	assert.Error(t, err)
+
+	n, err := service.Flush()
+	assert.NoError(t, err, "failed counts are kept for the next flush")
+	assert.Equal(t, 1, n)
+}
+
+func TestStatsService_ReadStats(t *testing.T) {
+	now := time.Date(2025, 8, 23, 12, 0, 0, 0, time.UTC)
+	owner := &domain.User{Id: 1, Email: "owner@example.com"}
+
+	mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+	mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+	mockViewRepo := mocks.NewMockViewRepositoryInterface(t)
+
+	mockUserRepo.EXPECT().ReadUser(owner.Email).Return(owner, nil)
+	mockPostRepo.EXPECT().ReadPost(5).Return(&domain.Post{Id: 5, UserId: owner.Id}, nil)
+	mockViewRepo.EXPECT().ReadDailyViews(5, "2025-08-21").Return([]domain.DayViews{{Day: "2025-08-21", Views: 4}, {Day: "2025-08-23", Views: 2}}, nil)
+	mockViewRepo.EXPECT().ReadTopReferrers(5, "2025-08-21", 10).Return(nil, nil)
+
+	service := &services.StatsService{
+		Posts:    &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo},
+		ViewRepo: mockViewRepo,
+		Clock:    &fakeClock{now: now},
+	}
+
+	stats, err := service.ReadStats(5, owner.Email, 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 6, stats.Total)
+	assert.Equal(t, []domain.DayViews{{Day: "2025-08-21", Views: 4}, {Day: "2025-08-22", Views: 0}, {Day: "2025-08-23", Views: 2}}, stats.Days)
+	assert.Empty(t, stats.Referrers)
+}
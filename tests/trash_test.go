@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+	"web/example/internal/storage"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPostService_RestorePostService(t *testing.T) {
+	tests := []struct {
+		name        string
+		userEmail   string
+		userId      int
+		wantRestore bool
+		errMsg      string
+	}{
+		{name: "success", userEmail: "test@example.com", userId: 1, wantRestore: true},
+		{name: "user does not own post", userEmail: "other@example.com", userId: 2, errMsg: "user does not own this post"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+			mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+			mockUserRepo.EXPECT().ReadUser(tt.userEmail).Return(&domain.User{Id: tt.userId, Email: tt.userEmail}, nil)
+			mockPostRepo.EXPECT().ReadTrashedPost(1).Return(&domain.Post{Id: 1, UserId: 1}, nil)
+			if tt.wantRestore {
+				mockPostRepo.EXPECT().RestorePost(1).Return(nil)
+			}
+
+			service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo}
+
+			err := service.RestorePostService(&handlermodel.RestorePostRequest{Id: 1, UserEmail: tt.userEmail})
+
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTrashPurger_RunOnce(t *testing.T) {
+	now := time.Date(2025, 8, 23, 12, 0, 0, 0, time.UTC)
+
+	mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+	mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+	mockPostRepo.EXPECT().PurgeDeletedPosts(now.Add(-30*24*time.Hour)).Return(3, []string{"posts/1/a", "posts/1/a-thumb"}, nil)
+	mockUserRepo.EXPECT().PurgeDeletedUsers(now.Add(-14*24*time.Hour)).Return(1, []string{"posts/9/b"}, nil)
+
+	store := &recordingStore{}
+
+	purger := &services.TrashPurger{
+		PostRepo:             mockPostRepo,
+		UserRepo:             mockUserRepo,
+		Store:                store,
+		Clock:                &fakeClock{now: now},
+		TrashRetention:       30 * 24 * time.Hour,
+		AccountDeletionGrace: 14 * 24 * time.Hour,
+	}
+
+	posts, users, err := purger.RunOnce()
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), posts)
+	assert.Equal(t, int64(1), users)
+	// the attachment rows went with the posts and accounts, their files go too
+	assert.Equal(t, []string{"posts/1/a", "posts/1/a-thumb", "posts/9/b"}, store.deleted)
+}
+
+// recordingStore is a BlobStore that only records deletes
+type recordingStore struct {
+	storage.BlobStore
+	deleted []string
+}
+
+func (s *recordingStore) Delete(ctx context.Context, key string) error {
+	s.deleted = append(s.deleted, key)
+	return nil
+}
+
+func TestUserService_CancelDeletionService(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("VeryNicePassw00rd!"), bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	pending := &domain.User{Id: 1, Email: "test@example.com", Password_hash: base64.StdEncoding.EncodeToString(hash)}
+
+	t.Run("success", func(t *testing.T) {
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUserPendingDeletion("test@example.com").Return(pending, nil)
+		mockUserRepo.EXPECT().CancelUserDeletion("test@example.com").Return(nil)
+
+		service := &services.UserService{UserRepo: mockUserRepo}
+
+		err := service.CancelDeletionService(&handlermodel.CancelUserDeletionRequest{Email: "test@example.com", Password: "VeryNicePassw00rd!"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUserPendingDeletion("test@example.com").Return(pending, nil)
+
+		service := &services.UserService{UserRepo: mockUserRepo}
+
+		err := service.CancelDeletionService(&handlermodel.CancelUserDeletionRequest{Email: "test@example.com", Password: "wrong"})
+
+		assert.ErrorIs(t, err, bcrypt.ErrMismatchedHashAndPassword)
+	})
+}
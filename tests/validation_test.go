@@ -0,0 +1,199 @@
+package tests
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+	"web/example/internal/domain"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/http/validation"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidator_PostText(t *testing.T) {
+	v := validation.New()
+
+	t.Run("text is cleaned before it is checked", func(t *testing.T) {
+		// e followed by a combining acute accent, one character once normalized
+		req := &handlermodel.CreatePostRequest{
+			UserEmail: "test@example.com",
+			Title:     "  Caf" + strings.Repeat("é", domain.MaxTitleLength-3) + "\n",
+			Content:   "    indented\n",
+		}
+
+		assert.NoError(t, v.ValidateStruct(req))
+		assert.Equal(t, "Caf"+strings.Repeat("é", domain.MaxTitleLength-3), req.Title)
+		assert.Equal(t, "    indented\n", req.Content)
+	})
+
+	t.Run("lengths are counted in characters", func(t *testing.T) {
+		req := &handlermodel.CreatePostRequest{
+			UserEmail: "test@example.com",
+			Title:     strings.Repeat("é", domain.MaxTitleLength),
+			Content:   strings.Repeat("ü", domain.MaxContentLength+1),
+		}
+
+		assert.Equal(t, []validation.FieldError{
+			{Field: "content", Rule: "max", Message: "must be at most 2048 characters"},
+		}, validation.Fields(v.ValidateStruct(req)))
+	})
+
+	t.Run("every failed field is listed", func(t *testing.T) {
+		req := &handlermodel.CreatePostRequest{
+			Title:      "Hello\tworld",
+			Content:    "bell\a",
+			Visibility: "everyone",
+		}
+
+		assert.Equal(t, []validation.FieldError{
+			{Field: "userEmail", Rule: "required", Message: "is required"},
+			{Field: "title", Rule: "singleline", Message: "must be a single line without control characters"},
+			{Field: "content", Rule: "nocontrol", Message: "must not contain control characters"},
+			{Field: "visibility", Rule: "oneof", Message: "must be one of public, unlisted, followers, private"},
+		}, validation.Fields(v.ValidateStruct(req)))
+	})
+
+	t.Run("blank text is missing", func(t *testing.T) {
+		req := &handlermodel.CreateUserRequest{Username: " \t ", Email: "test@example.com", Password: "Secret123"}
+
+		assert.Equal(t, []validation.FieldError{
+			{Field: "username", Rule: "required", Message: "is required"},
+		}, validation.Fields(v.ValidateStruct(req)))
+	})
+
+	t.Run("tags are lowercased and deduplicated", func(t *testing.T) {
+		req := &handlermodel.CreatePostRequest{UserEmail: "test@example.com", Title: "Hello", Content: "Hi", Tags: []string{" Go ", "go", "", "web-dev"}}
+
+		assert.NoError(t, v.ValidateStruct(req))
+		assert.Equal(t, []string{"go", "web-dev"}, req.Tags)
+
+		req.Tags = []string{"go", "web dev", "-go"}
+		assert.Equal(t, []validation.FieldError{
+			{Field: "tags[1]", Rule: "tag", Message: "must be 1 to 32 lowercase letters, digits or inner '-'"},
+			{Field: "tags[2]", Rule: "tag", Message: "must be 1 to 32 lowercase letters, digits or inner '-'"},
+		}, validation.Fields(v.ValidateStruct(req)))
+	})
+
+	t.Run("optional fields are cleaned when sent", func(t *testing.T) {
+		name, blank := "  Reading  ", "   "
+		req := &handlermodel.UpdateReadingListRequest{UserEmail: "test@example.com", Name: &name}
+
+		assert.NoError(t, v.ValidateStruct(req))
+		assert.Equal(t, "Reading", *req.Name)
+
+		req.Name = &blank
+		assert.Equal(t, []validation.FieldError{
+			{Field: "name", Rule: "min", Message: "must be at least 1 character"},
+		}, validation.Fields(v.ValidateStruct(req)))
+	})
+}
+
+func TestValidator_Users(t *testing.T) {
+	v := validation.New()
+
+	tests := []struct {
+		name  string
+		req   handlermodel.CreateUserRequest
+		rules []string
+	}{
+		{"valid", handlermodel.CreateUserRequest{Username: " ana.b-2 ", Email: "ana@example.com", Password: "Secret123"}, nil},
+		{"usernames can be mentioned", handlermodel.CreateUserRequest{Username: "ana b", Email: "ana@example.com", Password: "Secret123"}, []string{"username"}},
+		{"usernames are short", handlermodel.CreateUserRequest{Username: strings.Repeat("a", domain.MaxUsernameLength+1), Email: "ana@example.com", Password: "Secret123"}, []string{"username"}},
+		{"emails are bare addresses", handlermodel.CreateUserRequest{Username: "ana", Email: "Ana <ana@example.com>", Password: "Secret123"}, []string{"email"}},
+		{"passwords need a digit", handlermodel.CreateUserRequest{Username: "ana", Email: "ana@example.com", Password: "SecretSecret"}, []string{"password"}},
+		{"passwords fit bcrypt", handlermodel.CreateUserRequest{Username: "ana", Email: "ana@example.com", Password: "1" + strings.Repeat("a", domain.MaxPasswordLength)}, []string{"password"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rules []string
+			for _, f := range validation.Fields(v.ValidateStruct(&tt.req)) {
+				rules = append(rules, f.Rule)
+			}
+
+			assert.Equal(t, tt.rules, rules)
+		})
+	}
+}
+
+func TestValidator_Check(t *testing.T) {
+	v := validation.New()
+
+	t.Run("the handler models are valid", func(t *testing.T) {
+		assert.NoError(t, v.Check(handlermodel.Models...))
+	})
+
+	t.Run("every handler model is checked", func(t *testing.T) {
+		listed := map[string]bool{}
+		for _, m := range handlermodel.Models {
+			listed[reflect.TypeOf(m).Name()] = true
+		}
+
+		pkgs, err := parser.ParseDir(token.NewFileSet(), "../internal/http/handler_model", nil, 0)
+		assert.NoError(t, err)
+
+		for _, pkg := range pkgs {
+			for _, f := range pkg.Files {
+				for _, d := range f.Decls {
+					g, ok := d.(*ast.GenDecl)
+					if !ok || g.Tok != token.TYPE {
+						continue
+					}
+					for _, s := range g.Specs {
+						ts := s.(*ast.TypeSpec)
+						if _, ok := ts.Type.(*ast.StructType); ok {
+							assert.True(t, listed[ts.Name.Name], "%s is missing from handlermodel.Models", ts.Name.Name)
+						}
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("misspelled tags and rules are reported", func(t *testing.T) {
+		type request struct {
+			Email string `json:"email" binging:"required"`
+			Name  string `json:"name" binding:"requird"`
+			Title string `json:"title" clean:"upper"`
+		}
+
+		err := v.Check(request{})
+
+		assert.ErrorContains(t, err, `request.Email: unknown tag "binging"`)
+		assert.ErrorContains(t, err, "requird")
+		assert.ErrorContains(t, err, `request.Title: clean:"upper"`)
+	})
+}
+
+func TestValidator_JSON(t *testing.T) {
+	binding.Validator = validation.New()
+
+	tests := []struct {
+		name string
+		body string
+		err  string
+	}{
+		{"valid", `{"userEmail": "ana@example.com"} `, ""},
+		{"unknown fields are refused", `{"userEmail": "ana@example.com", "userEmial": "x"}`, `unknown field "userEmial"`},
+		{"trailing data is refused", `{"userEmail": "ana@example.com"}{}`, "unexpected data after the JSON value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req handlermodel.DeletePostBody
+
+			err := validation.JSON.BindBody([]byte(tt.body), &req)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
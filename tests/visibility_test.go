@@ -0,0 +1,259 @@
+package tests
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+	"web/example/internal/domain"
+	"web/example/internal/http/handler"
+	handlermodel "web/example/internal/http/handler_model"
+	"web/example/internal/http/middleware"
+	"web/example/internal/repository/mocks"
+	"web/example/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestPostService_Visibility reads a post of every visibility through every
+// read path as its owner, a follower, another user and anonymously
+func TestPostService_Visibility(t *testing.T) {
+	contentHTML := "<p>Content</p>\n"
+
+	owner := &domain.User{Id: 1, Email: "owner@example.com"}
+	follower := &domain.User{Id: 2, Email: "follower@example.com"}
+	other := &domain.User{Id: 3, Email: "other@example.com"}
+
+	type viewer struct {
+		name string
+		user *domain.User
+	}
+	viewers := []viewer{{"owner", owner}, {"follower", follower}, {"other", other}, {"anonymous", nil}}
+
+	// who reads the post by id or slug and who sees it in listings
+	tests := []struct {
+		visibility domain.PostVisibility
+		byId       []string
+		listed     []string
+	}{
+		{domain.VisibilityPublic, []string{"owner", "follower", "other", "anonymous"}, []string{"owner", "follower", "other", "anonymous"}},
+		{domain.VisibilityUnlisted, []string{"owner", "follower", "other", "anonymous"}, []string{"owner"}},
+		{domain.VisibilityFollowers, []string{"owner", "follower"}, []string{"owner", "follower"}},
+		{domain.VisibilityPrivate, []string{"owner"}, []string{"owner"}},
+	}
+
+	for _, tt := range tests {
+		for _, v := range viewers {
+			t.Run(string(tt.visibility)+" as "+v.name, func(t *testing.T) {
+				post := domain.Post{Id: 5, UserId: 1, Slug: "post", Status: domain.PostStatusPublished, Visibility: tt.visibility, ContentHTML: &contentHTML}
+
+				mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+				mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+				mockFollowRepo := mocks.NewMockFollowRepositoryInterface(t)
+				mockRevisionRepo := mocks.NewMockPostRevisionRepositoryInterface(t)
+				mockAttachmentRepo := mocks.NewMockAttachmentRepositoryInterface(t)
+
+				for _, u := range []*domain.User{owner, follower, other} {
+					mockUserRepo.EXPECT().ReadUser(u.Email).Return(u, nil).Maybe()
+				}
+				mockFollowRepo.EXPECT().IsFollowing(follower.Id, owner.Id).Return(true, nil).Maybe()
+				mockFollowRepo.EXPECT().IsFollowing(other.Id, owner.Id).Return(false, nil).Maybe()
+
+				mockPostRepo.EXPECT().ReadPost(5).RunAndReturn(func(int) (*domain.Post, error) { p := post; return &p, nil }).Maybe()
+				mockPostRepo.EXPECT().ReadPostBySlug("post").RunAndReturn(func(string) (*domain.Post, error) { p := post; return &p, nil }).Maybe()
+				mockPostRepo.EXPECT().ReadFeaturedPosts().Return(nil, nil).Maybe()
+				mockPostRepo.EXPECT().ReadAllPosts(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(int, time.Time, int, int) ([]domain.Post, error) { return []domain.Post{post}, nil }).Maybe()
+				mockRevisionRepo.EXPECT().ReadRevisions(5).Return([]domain.PostRevision{}, nil).Maybe()
+				mockRevisionRepo.EXPECT().ReadRevision(5, mock.Anything).Return(&domain.PostRevision{}, nil).Maybe()
+				mockAttachmentRepo.EXPECT().ReadAttachments(5).Return([]domain.Attachment{}, nil).Maybe()
+
+				service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo, RevisionRepo: mockRevisionRepo, FollowRepo: mockFollowRepo}
+				attachments := &services.AttachmentService{Posts: service, AttachmentRepo: mockAttachmentRepo}
+
+				email := ""
+				if v.user != nil {
+					email = v.user.Email
+				}
+
+				canRead := slices.Contains(tt.byId, v.name)
+
+				reads := map[string]error{}
+				_, reads["ReadPost"] = service.ReadPost(5, email)
+				_, reads["ReadPostBySlug"] = service.ReadPostBySlug("post", email)
+				_, reads["ReadRevisions"] = service.ReadRevisions(5, email)
+				_, reads["DiffRevisions"] = service.DiffRevisions(5, &handlermodel.DiffPostRevisionsRequest{From: 1, To: 1, UserEmail: email})
+				_, reads["ReadAttachments"] = attachments.ReadAttachments(5, email)
+
+				for name, err := range reads {
+					if canRead {
+						assert.NoError(t, err, name)
+					} else {
+						assert.ErrorIs(t, err, sql.ErrNoRows, name)
+					}
+				}
+
+				page, err := service.ReadAllPosts(email, "", 0)
+				assert.NoError(t, err)
+				assert.Equal(t, slices.Contains(tt.listed, v.name), len(page.Items) == 1, "ReadAllPosts")
+			})
+		}
+	}
+}
+
+// TestPostService_VisibilityListings checks the listings built by the
+// database, they are filtered again by the service
+func TestPostService_VisibilityListings(t *testing.T) {
+	contentHTML := "<p>Content</p>\n"
+	follower := &domain.User{Id: 2, Email: "follower@example.com"}
+
+	posts := func() []domain.Post {
+		var posts []domain.Post
+		for i, v := range []domain.PostVisibility{domain.VisibilityPublic, domain.VisibilityUnlisted, domain.VisibilityFollowers, domain.VisibilityPrivate} {
+			posts = append(posts, domain.Post{Id: 10 - i, UserId: 1, Status: domain.PostStatusPublished, Visibility: v, CreatedAt: "2025-08-23T12:00:00Z", ContentHTML: &contentHTML})
+		}
+		return posts
+	}
+
+	ids := func(posts []domain.Post) []int {
+		ids := []int{}
+		for _, p := range posts {
+			ids = append(ids, p.Id)
+		}
+		return ids
+	}
+
+	t.Run("timeline shows public and followers-only posts", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+		mockFollowRepo := mocks.NewMockFollowRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUser(follower.Email).Return(follower, nil)
+		mockFollowRepo.EXPECT().IsFollowing(follower.Id, 1).Return(true, nil).Once()
+		mockPostRepo.EXPECT().ReadTimeline(follower.Id, mock.Anything, 0, 4).Return(posts(), nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo, FollowRepo: mockFollowRepo}
+
+		page, err := service.ReadTimeline(follower.Email, "", 4)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{10, 8}, ids(page.Items))
+		assert.NotEmpty(t, page.NextCursor, "the page was full before filtering")
+	})
+
+	t.Run("feeds show public posts", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+
+		var feedPosts []domain.FeedPost
+		for _, p := range posts() {
+			feedPosts = append(feedPosts, domain.FeedPost{Post: p})
+		}
+		mockPostRepo.EXPECT().ReadFeedPosts(0, "", services.DefaultFeedLimit).Return(feedPosts, nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo}
+
+		_, listed, err := service.ReadFeed("", "", 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, listed, 1)
+		assert.Equal(t, 10, listed[0].Id)
+	})
+
+	t.Run("mentions show public posts", func(t *testing.T) {
+		mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+		mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+		mockUserRepo.EXPECT().ReadUsersByUsernames([]string{"ana"}).Return([]domain.User{{Id: 4, Username: "ana"}}, nil)
+		mockPostRepo.EXPECT().ReadMentioningPosts("ana", 0, services.DefaultPageSize).Return(posts(), nil)
+
+		service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo}
+
+		page, err := service.ReadMentions("ana", "", 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{10}, ids(page.Items))
+	})
+}
+
+// TestPostHandler_Visibility reads a post of every visibility over HTTP, the
+// reader named by userEmail only counts with the token
+func TestPostHandler_Visibility(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	contentHTML := "<p>Content</p>\n"
+
+	owner := &domain.User{Id: 1, Email: "owner@example.com"}
+	follower := &domain.User{Id: 2, Email: "follower@example.com"}
+
+	type read struct {
+		name  string
+		query string
+		token bool
+	}
+	reads := []read{
+		{"owner", "?userEmail=owner@example.com", true},
+		{"follower", "?userEmail=follower@example.com", true},
+		{"anonymous", "", false},
+		{"owner without the token", "?userEmail=owner@example.com", false},
+	}
+
+	tests := []struct {
+		name       string
+		status     domain.PostStatus
+		visibility domain.PostVisibility
+		codes      []int // of reads, in order
+	}{
+		{"public", domain.PostStatusPublished, domain.VisibilityPublic, []int{200, 200, 200, 401}},
+		{"unlisted", domain.PostStatusPublished, domain.VisibilityUnlisted, []int{200, 200, 200, 401}},
+		{"followers", domain.PostStatusPublished, domain.VisibilityFollowers, []int{200, 200, 404, 401}},
+		{"private", domain.PostStatusPublished, domain.VisibilityPrivate, []int{200, 404, 404, 401}},
+		{"draft", domain.PostStatusDraft, domain.VisibilityPublic, []int{200, 404, 404, 401}},
+	}
+
+	for _, tt := range tests {
+		for i, rd := range reads {
+			t.Run(tt.name+" as "+rd.name, func(t *testing.T) {
+				post := domain.Post{Id: 5, UserId: 1, Slug: "post", Status: tt.status, Visibility: tt.visibility, ContentHTML: &contentHTML}
+
+				mockPostRepo := mocks.NewMockPostRepositoryInterface(t)
+				mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+				mockFollowRepo := mocks.NewMockFollowRepositoryInterface(t)
+
+				for _, u := range []*domain.User{owner, follower} {
+					mockUserRepo.EXPECT().ReadUser(u.Email).Return(u, nil).Maybe()
+				}
+				mockFollowRepo.EXPECT().IsFollowing(follower.Id, owner.Id).Return(true, nil).Maybe()
+				mockPostRepo.EXPECT().ReadPost(5).Return(&post, nil).Maybe()
+
+				service := &services.PostService{PostRepo: mockPostRepo, UserRepo: mockUserRepo, FollowRepo: mockFollowRepo}
+
+				r := gin.New()
+				r.GET("/posts/:id", middleware.OptionalMockToken(), handler.NewPostHandler(service).ReadByRef)
+
+				req := httptest.NewRequest(http.MethodGet, "/posts/5"+rd.query, nil)
+				if rd.token {
+					req.Header.Set("Authorization", "Bearer "+middleware.MockValidJWT)
+				}
+				res := httptest.NewRecorder()
+				r.ServeHTTP(res, req)
+
+				assert.Equal(t, tt.codes[i], res.Code)
+			})
+		}
+	}
+
+	t.Run("a wrong token is refused", func(t *testing.T) {
+		r := gin.New()
+		r.GET("/posts/:id", middleware.OptionalMockToken(), handler.NewPostHandler(&services.PostService{}).ReadByRef)
+
+		req := httptest.NewRequest(http.MethodGet, "/posts/5", nil)
+		req.Header.Set("Authorization", "Bearer forged")
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+}